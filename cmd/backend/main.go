@@ -1,18 +1,30 @@
 package main
 
 import (
+	"context"
 	"io/ioutil"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	"github.com/labstack/echo/v4"
 	echolog "github.com/labstack/gommon/log"
 	"github.com/rs/zerolog/log"
 	"github.com/wolfeidau/exitus/pkg/api"
 	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/contenthtml"
 	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/export"
 	"github.com/wolfeidau/exitus/pkg/healthz"
+	"github.com/wolfeidau/exitus/pkg/jobs"
 	"github.com/wolfeidau/exitus/pkg/metrics"
 	"github.com/wolfeidau/exitus/pkg/middleware"
+	"github.com/wolfeidau/exitus/pkg/notifier"
+	"github.com/wolfeidau/exitus/pkg/reminders"
 	"github.com/wolfeidau/exitus/pkg/server"
+	"github.com/wolfeidau/exitus/pkg/sla"
 	"github.com/wolfeidau/exitus/pkg/store"
 )
 
@@ -40,6 +52,56 @@ func main() {
 		log.Fatal().Err(err).Msg("failed to connect to db")
 	}
 
+	// cancelled on SIGINT or SIGTERM to start a graceful shutdown
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	workers := &sync.WaitGroup{}
+
+	// email notifications are only sent when an SMTP server is configured
+	if cfg.SMTPAddr != "" {
+		ntf := notifier.NewNotifier(cfg, stores.Outbox, notifier.NewSMTPSender(cfg))
+
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			ntf.Run(ctx)
+		}()
+	}
+
+	runner := jobs.NewRunner(cfg, stores.Jobs)
+	export.Register(runner, stores.Exports)
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		runner.Run(ctx)
+	}()
+
+	checker := sla.NewChecker(cfg, stores.SLAPolicies)
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		checker.Run(ctx)
+	}()
+
+	sweeper := reminders.NewSweeper(cfg, stores.DueReminders)
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		sweeper.Run(ctx)
+	}()
+
+	refresher := contenthtml.NewRefresher(cfg, stores.ContentHTML)
+
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		refresher.Run(ctx)
+	}()
+
 	svr, err := server.NewServer(cfg, stores)
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to bind api")
@@ -65,7 +127,26 @@ func main() {
 
 	api.RegisterHandlers(g, svr)
 
-	log.Info().Str("addr", cfg.Addr).Msg("starting http listener")
-	err = e.Start(cfg.Addr)
-	log.Fatal().Err(err).Msg("Server failed")
+	go func() {
+		log.Info().Str("addr", cfg.Addr).Msg("starting http listener")
+		err := e.Start(cfg.Addr)
+		if err != nil && err != http.ErrServerClosed {
+			log.Fatal().Err(err).Msg("Server failed")
+		}
+	}()
+
+	<-ctx.Done()
+
+	log.Info().Msg("shutting down")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.JobShutdownTimeout)
+	defer cancel()
+
+	err = e.Shutdown(shutdownCtx)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to shutdown http listener")
+	}
+
+	// wait for running jobs, deliveries, checks and sweeps to finish or be interrupted
+	workers.Wait()
 }
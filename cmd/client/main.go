@@ -1,10 +1,18 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httputil"
+	"net/textproto"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/alecthomas/kingpin"
 	"github.com/motemen/go-loghttp"
@@ -30,6 +38,21 @@ var (
 
 	listProjects = app.Command("projects", "List projects.")
 
+	exportCmd       = app.Command("export", "Export the customer, or a single project, to a gzipped NDJSON archive.")
+	exportProjectID = exportCmd.Flag("project-id", "Only export this project.").String()
+	exportOutput    = exportCmd.Flag("output", "The file the archive is written to.").Default("exitus-export.ndjson.gz").String()
+	exportWait      = exportCmd.Flag("wait", "How long to wait for the export to finish.").Default("30m").Duration()
+
+	importCmd         = app.Command("import", "Import issues from a CSV file or a GitHub or Jira export.")
+	importFormat      = importCmd.Flag("format", "The format of the file.").Required().Enum("csv", "github", "jira_xml", "jira_csv")
+	importFile        = importCmd.Arg("file", "The file to import.").Required().ExistingFile()
+	importProjectID   = importCmd.Flag("project-id", "Import into this project.").String()
+	importProjectName = importCmd.Flag("project-name", "Import into the project with this name, creating it if needed.").String()
+	importOptions     = importCmd.Flag("options", "A JSON file of import options such as csv columns and mappings.").ExistingFile()
+	importUsers       = importCmd.Flag("user", "Map a user in the file to a user id, as name=id.").StringMap()
+	importLabels      = importCmd.Flag("label", "Map a label in the file to a label, as from=to.").StringMap()
+	importDryRun      = importCmd.Flag("dry-run", "Report what would be imported without creating anything.").Bool()
+
 	version = "unknown"
 )
 
@@ -56,7 +79,7 @@ func main() {
 		ProviderURL:  *authServer,
 		ClientID:     *clientID,
 		ClientSecret: *clientSecret,
-		Scopes:       []string{"exitus/project.read", "exitus/project.write"},
+		Scopes:       scopes(cmd),
 	})
 	if err != nil {
 		log.Fatal().Err(err).Msg("failed to connect to oidc endoint")
@@ -81,5 +104,258 @@ func main() {
 		if projectsRes.StatusCode() != 200 {
 			log.Fatal().Err(err).Msg("failed to list projects")
 		}
+
+	case exportCmd.FullCommand():
+
+		client := &api.Client{Server: *endpoint, Client: sess.Client(context.TODO())}
+
+		err := exportArchive(context.TODO(), client)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to export")
+		}
+
+	case importCmd.FullCommand():
+
+		client := &api.Client{Server: *endpoint, Client: sess.Client(context.TODO())}
+
+		err := importIssues(context.TODO(), client)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to import")
+		}
+	}
+}
+
+// scopes the scopes requested for the command.
+func scopes(cmd string) []string {
+	switch cmd {
+	case exportCmd.FullCommand():
+		return []string{"exitus/export.read", "exitus/export.write", "exitus/job.read"}
+	case importCmd.FullCommand():
+		return []string{"exitus/import.write"}
+	}
+
+	return []string{"exitus/project.read", "exitus/project.write"}
+}
+
+// exportArchive start an export, wait for the job to succeed and then download the archive.
+func exportArchive(ctx context.Context, client *api.Client) error {
+	newExport := api.StartExportJSONRequestBody{}
+	if *exportProjectID != "" {
+		newExport.ProjectId = exportProjectID
+	}
+
+	res, err := client.StartExport(ctx, newExport)
+	if err != nil {
+		return err
+	}
+
+	startRes, err := api.ParseStartExportResponse(res)
+	if err != nil {
+		return err
 	}
+
+	if startRes.StatusCode() != http.StatusAccepted {
+		return fmt.Errorf("failed to start export: %s", startRes.Status())
+	}
+
+	job := startRes.JSON202
+	log.Info().Str("id", job.Id).Msg("started export")
+
+	deadline := time.Now().Add(*exportWait)
+	for job.Status != api.JobStatusSucceeded {
+		if job.Status == api.JobStatusFailed {
+			return fmt.Errorf("export failed: %s", valueOf(job.LastError))
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("export %s is still %s", job.Id, job.Status)
+		}
+
+		time.Sleep(2 * time.Second)
+
+		res, err := client.GetJob(ctx, job.Id)
+		if err != nil {
+			return err
+		}
+
+		jobRes, err := api.ParseGetJobResponse(res)
+		if err != nil {
+			return err
+		}
+
+		if jobRes.StatusCode() != http.StatusOK {
+			return fmt.Errorf("failed to get export job: %s", jobRes.Status())
+		}
+
+		job = jobRes.JSON200
+	}
+
+	res, err = client.DownloadExport(ctx, job.Id)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download export: %s", res.Status)
+	}
+
+	f, err := os.Create(*exportOutput)
+	if err != nil {
+		return err
+	}
+
+	n, err := io.Copy(f, res.Body)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	log.Info().Str("output", *exportOutput).Int64("size", n).Msg("downloaded export")
+
+	return nil
+}
+
+// importIssues upload a file with its import options and print the report.
+func importIssues(ctx context.Context, client *api.Client) error {
+	opt := api.ImportOptions{}
+
+	if *importOptions != "" {
+		data, err := os.ReadFile(*importOptions)
+		if err != nil {
+			return err
+		}
+
+		if err := json.Unmarshal(data, &opt); err != nil {
+			return fmt.Errorf("failed to read import options: %w", err)
+		}
+	}
+
+	opt.Format = api.ImportOptionsFormat(*importFormat)
+
+	if *importProjectID != "" {
+		opt.ProjectId = importProjectID
+	}
+	if *importProjectName != "" {
+		opt.ProjectName = importProjectName
+	}
+	if *importDryRun {
+		opt.DryRun = importDryRun
+	}
+
+	opt.Users = mergeMapping(opt.Users, *importUsers)
+	opt.Labels = mergeMapping(opt.Labels, *importLabels)
+
+	body, contentType, err := importBody(&opt, *importFile)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.ImportIssuesWithBody(ctx, contentType, body)
+	if err != nil {
+		return err
+	}
+
+	importRes, err := api.ParseImportIssuesResponse(res)
+	if err != nil {
+		return err
+	}
+
+	if importRes.StatusCode() != http.StatusOK {
+		return fmt.Errorf("failed to import: %s %s", importRes.Status(), bytes.TrimSpace(importRes.Body))
+	}
+
+	report := importRes.JSON200
+
+	for _, issue := range report.Issues {
+		if issue.Status == api.ImportIssueResultStatusInvalid {
+			log.Warn().Str("external_id", issue.ExternalId).Str("error", valueOf(issue.Error)).Msg("invalid issue")
+		}
+	}
+
+	log.Info().
+		Bool("dry_run", report.DryRun).
+		Str("project_id", report.ProjectId).
+		Bool("project_created", report.ProjectCreated).
+		Int("created", report.Created).
+		Int("existing", report.Existing).
+		Int("invalid", report.Invalid).
+		Int("comments_created", report.CommentsCreated).
+		Strs("new_labels", report.NewLabels).
+		Strs("unmapped_users", report.UnmappedUsers).
+		Msg("imported issues")
+
+	return nil
+}
+
+// importBody the multipart body of an import with the options as a JSON part.
+func importBody(opt *api.ImportOptions, file string) (io.Reader, string, error) {
+	body := new(bytes.Buffer)
+	mw := multipart.NewWriter(body)
+
+	optionsPart, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Disposition": {`form-data; name="options"`},
+		"Content-Type":        {"application/json"},
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := json.NewEncoder(optionsPart).Encode(opt); err != nil {
+		return nil, "", err
+	}
+
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	filePart, err := mw.CreateFormFile("file", filepath.Base(file))
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err := io.Copy(filePart, f); err != nil {
+		return nil, "", err
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return body, mw.FormDataContentType(), nil
+}
+
+// mergeMapping add the values given as flags to a mapping from the options file.
+func mergeMapping(mapping *api.ImportMapping, values map[string]string) *api.ImportMapping {
+	if len(values) == 0 {
+		return mapping
+	}
+
+	if mapping == nil {
+		mapping = &api.ImportMapping{AdditionalProperties: map[string]string{}}
+	}
+
+	if mapping.AdditionalProperties == nil {
+		mapping.AdditionalProperties = map[string]string{}
+	}
+
+	for k, v := range values {
+		mapping.AdditionalProperties[k] = v
+	}
+
+	return mapping
+}
+
+func valueOf(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
 }
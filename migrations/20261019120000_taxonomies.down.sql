@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE projects DROP COLUMN IF EXISTS "categories";
+ALTER TABLE projects DROP COLUMN IF EXISTS "severities";
+
+ALTER TABLE customers DROP COLUMN IF EXISTS "categories";
+ALTER TABLE customers DROP COLUMN IF EXISTS "severities";
+
+COMMIT;
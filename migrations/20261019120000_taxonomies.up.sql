@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Severities are stored ordered by rank, most severe first, a NULL value on a project falls back
+-- to the customer, and a NULL value on both leaves the field unrestricted.
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS "severities" text[];
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS "categories" text[];
+
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS "severities" text[];
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS "categories" text[];
+
+COMMIT;
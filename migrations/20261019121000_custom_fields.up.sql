@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS custom_fields (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "project_id" uuid NOT NULL,
+    "name" text NOT NULL,
+    "description" text,
+    "type" text NOT NULL,       -- one of string, number, enum, date or user
+    "required" boolean NOT NULL DEFAULT false,
+    "default_value" text,
+    "options" text[] NOT NULL DEFAULT '{}'::text[],
+    "created_at" timestamp with time zone DEFAULT now(),
+    "updated_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id, project_id),
+    UNIQUE ("customer_id", "project_id", "name")
+    -- FOREIGN KEY (project_id, customer_id) REFERENCES projects (id, customer_id) ON DELETE RESTRICT
+);
+
+-- Custom field values are stored as text keyed by the field name, and validated against the
+-- field type when they are written.
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS "custom_fields" hstore NOT NULL DEFAULT ''::hstore;
+
+CREATE INDEX IF NOT EXISTS issues_custom_fields_idx ON issues USING gin ("custom_fields");
+
+COMMIT;
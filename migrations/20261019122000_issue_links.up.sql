@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Links are stored in both directions, each link has an inverse row with the source and target
+-- swapped, for example A blocks B is stored alongside B blocked_by A.
+CREATE TABLE IF NOT EXISTS issue_links (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "source_issue_id" uuid NOT NULL,
+    "target_issue_id" uuid NOT NULL,
+    "type" text NOT NULL,       -- one of blocks, blocked_by, duplicates, duplicated_by or relates_to
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id),
+    UNIQUE ("customer_id", "source_issue_id", "target_issue_id", "type")
+);
+
+CREATE INDEX IF NOT EXISTS issue_links_target_issue_id_idx ON issue_links ("customer_id", "target_issue_id");
+
+COMMIT;
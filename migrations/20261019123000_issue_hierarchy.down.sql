@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS issues_parent_id_idx;
+
+ALTER TABLE issues DROP COLUMN IF EXISTS "parent_id";
+
+COMMIT;
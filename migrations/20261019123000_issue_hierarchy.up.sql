@@ -0,0 +1,8 @@
+BEGIN;
+
+-- Issues may have a parent issue in the same project, the hierarchy is acyclic and limited in depth.
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS "parent_id" uuid;
+
+CREATE INDEX IF NOT EXISTS issues_parent_id_idx ON issues ("customer_id", "parent_id");
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE issues DROP CONSTRAINT IF EXISTS issues_customer_id_key_key;
+ALTER TABLE issues DROP COLUMN IF EXISTS "key";
+
+ALTER TABLE projects DROP CONSTRAINT IF EXISTS projects_customer_id_key_key;
+ALTER TABLE projects DROP COLUMN IF EXISTS "issue_seq";
+ALTER TABLE projects DROP COLUMN IF EXISTS "key";
+
+COMMIT;
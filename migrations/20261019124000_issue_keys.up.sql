@@ -0,0 +1,13 @@
+BEGIN;
+
+-- Projects have an optional key used as the prefix of issue keys such as API-123, issue_seq is the
+-- last number allocated to an issue in the project and is incremented under a row lock.
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS "key" text;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS "issue_seq" bigint NOT NULL DEFAULT 0;
+ALTER TABLE projects ADD CONSTRAINT projects_customer_id_key_key UNIQUE ("customer_id", "key");
+
+-- Issue keys are kept when an issue moves so they remain unique across all projects of a customer.
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS "key" text;
+ALTER TABLE issues ADD CONSTRAINT issues_customer_id_key_key UNIQUE ("customer_id", "key");
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS issue_activity;
+
+DROP TABLE IF EXISTS issue_redirects;
+
+COMMIT;
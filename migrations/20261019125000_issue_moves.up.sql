@@ -0,0 +1,30 @@
+BEGIN;
+
+-- When an issue moves to another project a redirect is kept for each project it has left so the
+-- old URLs still resolve, redirects are updated to point at the latest project.
+CREATE TABLE IF NOT EXISTS issue_redirects (
+    "customer_id" uuid NOT NULL,
+    "project_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "target_project_id" uuid NOT NULL,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, project_id, issue_id)
+);
+
+CREATE INDEX IF NOT EXISTS issue_redirects_issue_id_idx ON issue_redirects ("customer_id", "issue_id");
+
+-- An audit trail of changes made to issues, this follows the issue between projects.
+CREATE TABLE IF NOT EXISTS issue_activity (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "actor" uuid NOT NULL,      -- user identifier
+    "action" text NOT NULL,
+    "details" hstore NOT NULL DEFAULT ''::hstore,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id)
+);
+
+CREATE INDEX IF NOT EXISTS issue_activity_issue_id_idx ON issue_activity ("customer_id", "issue_id", "created_at");
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS comments_parent_id_idx;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS "deleted_at";
+ALTER TABLE comments DROP COLUMN IF EXISTS "parent_id";
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+-- Comments may reply to another comment on the same issue, deleted comments with replies are kept
+-- as placeholders with deleted_at set and their content removed.
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS "parent_id" uuid;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS "deleted_at" timestamp with time zone;
+
+CREATE INDEX IF NOT EXISTS comments_parent_id_idx ON comments ("customer_id", "parent_id");
+
+COMMIT;
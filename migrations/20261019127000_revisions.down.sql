@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS comment_revisions;
+
+DROP TABLE IF EXISTS issue_revisions;
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN;
+
+-- Every revision of the subject and content of an issue, starting with revision 1 when it was created.
+CREATE TABLE IF NOT EXISTS issue_revisions (
+    "customer_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "revision" integer NOT NULL,
+    "subject" citext NOT NULL,
+    "content" text,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, issue_id, revision)
+);
+
+-- Every revision of the content of a comment, starting with revision 1 when it was created.
+CREATE TABLE IF NOT EXISTS comment_revisions (
+    "customer_id" uuid NOT NULL,
+    "comment_id" uuid NOT NULL,
+    "revision" integer NOT NULL,
+    "content" text,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, comment_id, revision)
+);
+
+-- the current content of existing issues and comments is their first revision
+INSERT INTO issue_revisions (customer_id, issue_id, revision, subject, content, created_at)
+    SELECT customer_id, id, 1, subject, content, updated_at FROM issues
+    ON CONFLICT DO NOTHING;
+
+INSERT INTO comment_revisions (customer_id, comment_id, revision, content, created_at)
+    SELECT customer_id, id, 1, content, updated_at FROM comments WHERE deleted_at IS NULL
+    ON CONFLICT DO NOTHING;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS comment_reactions;
+
+ALTER TABLE issues DROP COLUMN IF EXISTS "votes";
+
+DROP TABLE IF EXISTS issue_votes;
+
+COMMIT;
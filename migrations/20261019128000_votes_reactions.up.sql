@@ -0,0 +1,24 @@
+BEGIN;
+
+-- One vote per user per issue, the number of votes is kept on the issue so lists can be sorted by it.
+CREATE TABLE IF NOT EXISTS issue_votes (
+    "customer_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "user_id" text NOT NULL,    -- subject of the authenticated user
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, issue_id, user_id)
+);
+
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS "votes" integer NOT NULL DEFAULT 0;
+
+-- Each user may add each reaction to a comment once.
+CREATE TABLE IF NOT EXISTS comment_reactions (
+    "customer_id" uuid NOT NULL,
+    "comment_id" uuid NOT NULL,
+    "user_id" text NOT NULL,    -- subject of the authenticated user
+    "reaction" text NOT NULL,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, comment_id, user_id, reaction)
+);
+
+COMMIT;
@@ -0,0 +1,28 @@
+BEGIN;
+
+-- Users subscribed to an issue, this is the recipient list for notifications so it is keyed by
+-- issue and isn't changed when the issue is moved between projects.
+CREATE TABLE IF NOT EXISTS issue_watchers (
+    "customer_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "user_id" text NOT NULL,    -- user identifier
+    "reason" text NOT NULL,     -- manual, reporter, assignee or commenter
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, issue_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS issue_watchers_user_id_idx ON issue_watchers (customer_id, user_id, created_at);
+
+INSERT INTO issue_watchers(customer_id, issue_id, user_id, reason)
+    SELECT customer_id, id, reporter::text, 'reporter' FROM issues
+    ON CONFLICT DO NOTHING;
+
+INSERT INTO issue_watchers(customer_id, issue_id, user_id, reason)
+    SELECT customer_id, id, assignee::text, 'assignee' FROM issues WHERE assignee IS NOT NULL
+    ON CONFLICT DO NOTHING;
+
+INSERT INTO issue_watchers(customer_id, issue_id, user_id, reason)
+    SELECT customer_id, issue_id, author::text, 'commenter' FROM comments
+    ON CONFLICT DO NOTHING;
+
+COMMIT;
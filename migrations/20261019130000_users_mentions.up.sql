@@ -0,0 +1,33 @@
+BEGIN;
+
+-- Users of a customer, the login is used to resolve @mentions.
+CREATE TABLE IF NOT EXISTS users (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "login" citext NOT NULL,
+    "name" text NOT NULL,
+    "email" text NOT NULL,
+    "created_at" timestamp with time zone DEFAULT now(),
+    "updated_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id),
+    UNIQUE (customer_id, login)
+);
+
+-- Users mentioned in the content of an issue or comment, the source is the issue or comment
+-- containing the mention so edits can remove mentions which are no longer present.
+CREATE TABLE IF NOT EXISTS mentions (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "source_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "comment_id" uuid NULL,
+    "user_id" text NOT NULL,        -- user identifier
+    "mentioned_by" text NOT NULL,   -- user identifier
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id),
+    UNIQUE (customer_id, source_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS mentions_user_id_idx ON mentions (customer_id, user_id, created_at);
+
+COMMIT;
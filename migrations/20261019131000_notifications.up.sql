@@ -0,0 +1,33 @@
+BEGIN;
+
+-- Notifications of issue and comment events for a user, these are written in the same
+-- transaction as the change which caused them.
+CREATE TABLE IF NOT EXISTS notifications (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "user_id" text NOT NULL,    -- user identifier
+    "type" text NOT NULL,       -- assigned, mentioned, state_changed or commented
+    "issue_id" uuid NOT NULL,
+    "comment_id" uuid NULL,
+    "actor" text NULL,          -- user identifier, NULL when not known
+    "details" hstore NOT NULL DEFAULT ''::hstore,
+    "read_at" timestamp with time zone NULL,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id)
+);
+
+CREATE INDEX IF NOT EXISTS notifications_user_id_idx ON notifications (customer_id, user_id, created_at);
+
+CREATE INDEX IF NOT EXISTS notifications_unread_idx ON notifications (customer_id, user_id) WHERE read_at IS NULL;
+
+-- Types of notification a user has turned off, every type is recorded unless disabled here.
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    "customer_id" uuid NOT NULL,
+    "user_id" text NOT NULL,    -- user identifier
+    "type" text NOT NULL,
+    "enabled" boolean NOT NULL,
+    "updated_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, user_id, type)
+);
+
+COMMIT;
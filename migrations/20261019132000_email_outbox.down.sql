@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS email_outbox;
+
+DROP TABLE IF EXISTS email_preferences;
+
+COMMIT;
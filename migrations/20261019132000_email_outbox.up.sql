@@ -0,0 +1,32 @@
+BEGIN;
+
+-- How each user receives notifications by email, users without a row receive them immediately.
+CREATE TABLE IF NOT EXISTS email_preferences (
+    "customer_id" uuid NOT NULL,
+    "user_id" text NOT NULL,    -- user identifier
+    "mode" text NOT NULL,       -- off, immediate or daily
+    "updated_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, user_id)
+);
+
+-- Notifications waiting to be emailed, rows are written in the same transaction as the
+-- notification so nothing is lost on restart. Senders lease rows by moving next_attempt_at
+-- forward so several replicas can deliver mail without sending twice.
+CREATE TABLE IF NOT EXISTS email_outbox (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "notification_id" uuid NOT NULL,
+    "user_id" text NOT NULL,            -- user identifier
+    "mode" text NOT NULL,               -- immediate or daily
+    "status" text NOT NULL DEFAULT 'pending',
+    "attempts" integer NOT NULL DEFAULT 0,
+    "last_error" text NULL,
+    "next_attempt_at" timestamp with time zone NOT NULL DEFAULT now(),
+    "sent_at" timestamp with time zone NULL,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS email_outbox_pending_idx ON email_outbox (next_attempt_at) WHERE status = 'pending';
+
+COMMIT;
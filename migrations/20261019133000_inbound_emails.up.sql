@@ -0,0 +1,18 @@
+BEGIN;
+
+-- Emails received from the mail relay, the message id of each email is kept so replies can be
+-- threaded onto the issue it created and so a redelivered email isn't processed twice.
+CREATE TABLE IF NOT EXISTS inbound_emails (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "message_id" text NOT NULL,
+    "project_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "comment_id" uuid NULL,
+    "from_address" text NOT NULL,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id),
+    UNIQUE (customer_id, message_id)
+);
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+-- Files attached to an issue or one of its comments, the content is kept in blob storage under
+-- the storage key and verified with the SHA-256 checksum when it is uploaded.
+CREATE TABLE IF NOT EXISTS attachments (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "comment_id" uuid NULL,
+    "filename" text NOT NULL,
+    "content_type" text NOT NULL,
+    "size" bigint NOT NULL,
+    "sha256" text NOT NULL,
+    "storage_key" text NOT NULL,
+    "uploaded_by" text NOT NULL,    -- user identifier
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id)
+);
+
+CREATE INDEX IF NOT EXISTS attachments_issue_id_idx ON attachments (customer_id, issue_id, created_at);
+
+COMMIT;
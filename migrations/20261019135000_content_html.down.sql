@@ -0,0 +1,12 @@
+BEGIN;
+
+DROP INDEX IF EXISTS comments_content_html_version_idx;
+DROP INDEX IF EXISTS issues_content_html_version_idx;
+
+ALTER TABLE comments DROP COLUMN IF EXISTS "content_html_version";
+ALTER TABLE comments DROP COLUMN IF EXISTS "content_html";
+
+ALTER TABLE issues DROP COLUMN IF EXISTS "content_html_version";
+ALTER TABLE issues DROP COLUMN IF EXISTS "content_html";
+
+COMMIT;
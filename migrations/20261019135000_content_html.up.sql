@@ -0,0 +1,16 @@
+BEGIN;
+
+-- The content of issues and comments rendered to html, this is a cache which is rendered again
+-- when the content changes or in the background when the version of the renderer which produced
+-- it is out of date.
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS "content_html" text NULL;
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS "content_html_version" integer NULL;
+
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS "content_html" text NULL;
+ALTER TABLE comments ADD COLUMN IF NOT EXISTS "content_html_version" integer NULL;
+
+-- The html rendered by older versions of the renderer is found by version and refreshed in the background.
+CREATE INDEX IF NOT EXISTS issues_content_html_version_idx ON issues (content_html_version);
+CREATE INDEX IF NOT EXISTS comments_content_html_version_idx ON comments (content_html_version);
+
+COMMIT;
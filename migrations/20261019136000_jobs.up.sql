@@ -0,0 +1,29 @@
+BEGIN;
+
+-- Background jobs, workers claim due jobs with FOR UPDATE SKIP LOCKED and hold a lease on them
+-- while they run. A job whose lease expires is claimed again, so a job is retried if the worker
+-- running it stops.
+CREATE TABLE IF NOT EXISTS jobs (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "type" text NOT NULL,
+    "payload" jsonb NOT NULL DEFAULT '{}'::jsonb,
+    "status" text NOT NULL DEFAULT 'queued',    -- queued, running, succeeded or failed
+    "attempts" integer NOT NULL DEFAULT 0,
+    "max_attempts" integer NOT NULL,
+    "run_at" timestamp with time zone NOT NULL DEFAULT now(),
+    "locked_until" timestamp with time zone NULL,
+    "last_error" text NULL,
+    "result" jsonb NULL,
+    "created_by" text NULL,     -- user identifier
+    "created_at" timestamp with time zone DEFAULT now(),
+    "updated_at" timestamp with time zone DEFAULT now(),
+    "finished_at" timestamp with time zone NULL,
+    PRIMARY KEY (id, customer_id)
+);
+
+CREATE INDEX IF NOT EXISTS jobs_due_idx ON jobs (run_at) WHERE status IN ('queued', 'running');
+
+CREATE INDEX IF NOT EXISTS jobs_customer_id_idx ON jobs (customer_id, created_at);
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- The records created by imports keyed by their identifier in the source they were imported from,
+-- an import claims the external id in the transaction which creates the record so running an import
+-- again skips the records it already created.
+CREATE TABLE IF NOT EXISTS import_external_ids (
+    "customer_id" uuid NOT NULL,
+    "project_id" uuid NOT NULL,
+    "source" text NOT NULL,         -- csv, github or jira
+    "kind" text NOT NULL,           -- issue or comment
+    "external_id" text NOT NULL,
+    "entity_id" uuid NULL,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, project_id, source, kind, external_id)
+);
+
+COMMIT;
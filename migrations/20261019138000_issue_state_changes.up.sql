@@ -0,0 +1,21 @@
+BEGIN;
+
+-- Every change to the state of an issue, used to report how issues flow through the workflow.
+-- Issues start in created at their created_at so the creation isn't recorded.
+CREATE TABLE IF NOT EXISTS issue_state_changes (
+    "customer_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "from_state" text NOT NULL,
+    "to_state" text NOT NULL,
+    "created_at" timestamp with time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS issue_state_changes_issue_idx ON issue_state_changes (customer_id, issue_id, created_at);
+CREATE INDEX IF NOT EXISTS issue_state_changes_created_at_idx ON issue_state_changes (customer_id, created_at);
+
+-- the history of existing issues isn't known, issues which aren't in created are recorded as
+-- moving straight to their current state when they were last updated
+INSERT INTO issue_state_changes (customer_id, issue_id, from_state, to_state, created_at)
+    SELECT customer_id, id, 'created', state, updated_at FROM issues WHERE state != 'created';
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS issue_sla;
+DROP TABLE IF EXISTS sla_policies;
+
+COMMIT;
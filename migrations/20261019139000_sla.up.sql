@@ -0,0 +1,50 @@
+BEGIN;
+
+-- SLA policies set the first response and resolution targets of issues by severity, measured
+-- in the business hours of the calendar. A policy without a project is the customer default.
+CREATE TABLE IF NOT EXISTS sla_policies (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "project_id" uuid,
+    "name" text NOT NULL,
+    "calendar" jsonb NOT NULL DEFAULT '{}'::jsonb,
+    "targets" jsonb NOT NULL DEFAULT '[]'::jsonb,
+    "at_risk_percent" integer NOT NULL DEFAULT 75,
+    "created_at" timestamp with time zone DEFAULT now(),
+    "updated_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS sla_policies_project_idx ON sla_policies (customer_id, project_id) WHERE project_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS sla_policies_default_idx ON sla_policies (customer_id) WHERE project_id IS NULL;
+
+-- The SLA clocks of each issue, the due and at risk times are computed when the issue changes
+-- so the checker only has to compare them with the current time. Status is one of ok, at_risk,
+-- breached or met.
+CREATE TABLE IF NOT EXISTS issue_sla (
+    "customer_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "policy_id" uuid NOT NULL,
+    "first_response_target_minutes" integer,
+    "first_response_status" text,
+    "first_response_due_at" timestamp with time zone,
+    "first_response_at_risk_at" timestamp with time zone,
+    "first_response_completed_at" timestamp with time zone,
+    "first_response_breached_at" timestamp with time zone,
+    "resolution_target_minutes" integer,
+    "resolution_status" text,
+    "resolution_due_at" timestamp with time zone,
+    "resolution_at_risk_at" timestamp with time zone,
+    "resolution_completed_at" timestamp with time zone,
+    "resolution_breached_at" timestamp with time zone,
+    "updated_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, issue_id)
+);
+
+CREATE INDEX IF NOT EXISTS issue_sla_first_response_due_idx ON issue_sla (first_response_due_at) WHERE first_response_status IN ('ok', 'at_risk');
+CREATE INDEX IF NOT EXISTS issue_sla_first_response_at_risk_idx ON issue_sla (first_response_at_risk_at) WHERE first_response_status = 'ok';
+CREATE INDEX IF NOT EXISTS issue_sla_resolution_due_idx ON issue_sla (resolution_due_at) WHERE resolution_status IN ('ok', 'at_risk');
+CREATE INDEX IF NOT EXISTS issue_sla_resolution_at_risk_idx ON issue_sla (resolution_at_risk_at) WHERE resolution_status = 'ok';
+CREATE INDEX IF NOT EXISTS issue_sla_policy_idx ON issue_sla (customer_id, policy_id);
+
+COMMIT;
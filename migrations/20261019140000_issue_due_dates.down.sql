@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS issue_due_reminders;
+
+DROP INDEX IF EXISTS issues_due_at_idx;
+
+ALTER TABLE issues DROP COLUMN IF EXISTS "due_at";
+
+COMMIT;
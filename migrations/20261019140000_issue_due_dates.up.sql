@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS "due_at" timestamp with time zone;
+
+-- the reminder sweep looks for issues of every customer which are due soon
+CREATE INDEX IF NOT EXISTS issues_due_at_idx ON issues (due_at) WHERE due_at IS NOT NULL;
+
+-- The reminders sent for the due date of an issue, kind is one of due_soon or overdue. A reminder
+-- is recorded in the same transaction as its notifications so each one is only sent once, changing
+-- the due date of an issue allows new reminders to be sent.
+CREATE TABLE IF NOT EXISTS issue_due_reminders (
+    "customer_id" uuid NOT NULL,
+    "issue_id" uuid NOT NULL,
+    "kind" text NOT NULL,
+    "due_at" timestamp with time zone NOT NULL,
+    "created_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (customer_id, issue_id, kind, due_at)
+);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS issues_milestone_id_idx;
+
+ALTER TABLE issues DROP COLUMN IF EXISTS "milestone_id";
+
+DROP TABLE IF EXISTS milestones;
+
+COMMIT;
@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS milestones (
+    "id" uuid DEFAULT uuid_generate_v4(),
+    "customer_id" uuid NOT NULL,
+    "project_id" uuid NOT NULL,
+    "title" text NOT NULL,
+    "description" text,
+    "due_at" timestamp with time zone,
+    "state" text NOT NULL DEFAULT 'open', -- one of open or closed
+    "closed_at" timestamp with time zone,
+    "created_at" timestamp with time zone DEFAULT now(),
+    "updated_at" timestamp with time zone DEFAULT now(),
+    PRIMARY KEY (id, customer_id, project_id),
+    UNIQUE ("customer_id", "project_id", "title")
+    -- FOREIGN KEY (project_id, customer_id) REFERENCES projects (id, customer_id) ON DELETE RESTRICT
+);
+
+-- Issues in a milestone are counted by state to report the progress of the milestone.
+ALTER TABLE issues ADD COLUMN IF NOT EXISTS "milestone_id" uuid;
+
+CREATE INDEX IF NOT EXISTS issues_milestone_id_idx ON issues (customer_id, milestone_id) WHERE milestone_id IS NOT NULL;
+
+COMMIT;
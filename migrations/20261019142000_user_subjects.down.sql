@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP INDEX IF EXISTS users_customer_id_subject_key;
+
+ALTER TABLE users DROP COLUMN IF EXISTS "subject";
+
+COMMIT;
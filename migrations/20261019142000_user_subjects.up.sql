@@ -0,0 +1,9 @@
+BEGIN;
+
+-- The subject of the access token of a user, this links the authenticated user to their user so
+-- issues, comments, mentions, notifications and watchers are all keyed by the user identifier.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS "subject" text NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_customer_id_subject_key ON users (customer_id, subject) WHERE subject IS NOT NULL;
+
+COMMIT;
@@ -27,6 +27,249 @@ const (
 	OpenIdScopes = "OpenId.Scopes"
 )
 
+// Defines values for ImportIssueResultStatus.
+const (
+	ImportIssueResultStatusCreated ImportIssueResultStatus = "created"
+
+	ImportIssueResultStatusExists ImportIssueResultStatus = "exists"
+
+	ImportIssueResultStatusInvalid ImportIssueResultStatus = "invalid"
+)
+
+// Defines values for ImportOptionsFormat.
+const (
+	ImportOptionsFormatCsv ImportOptionsFormat = "csv"
+
+	ImportOptionsFormatGithub ImportOptionsFormat = "github"
+
+	ImportOptionsFormatJiraCsv ImportOptionsFormat = "jira_csv"
+
+	ImportOptionsFormatJiraXml ImportOptionsFormat = "jira_xml"
+)
+
+// Defines values for IssueBulkChangesState.
+const (
+	IssueBulkChangesStateClosed IssueBulkChangesState = "closed"
+
+	IssueBulkChangesStateInProgress IssueBulkChangesState = "in_progress"
+
+	IssueBulkChangesStateOpen IssueBulkChangesState = "open"
+
+	IssueBulkChangesStateResolved IssueBulkChangesState = "resolved"
+)
+
+// Defines values for IssueBulkResultErrorCode.
+const (
+	IssueBulkResultErrorCodeInvalid IssueBulkResultErrorCode = "invalid"
+
+	IssueBulkResultErrorCodeInvalidTransition IssueBulkResultErrorCode = "invalid_transition"
+
+	IssueBulkResultErrorCodeNotFound IssueBulkResultErrorCode = "not_found"
+
+	IssueBulkResultErrorCodeVersionConflict IssueBulkResultErrorCode = "version_conflict"
+)
+
+// Defines values for IssueBulkResultStatus.
+const (
+	IssueBulkResultStatusFailed IssueBulkResultStatus = "failed"
+
+	IssueBulkResultStatusRolledBack IssueBulkResultStatus = "rolled_back"
+
+	IssueBulkResultStatusUnchanged IssueBulkResultStatus = "unchanged"
+
+	IssueBulkResultStatusUpdated IssueBulkResultStatus = "updated"
+)
+
+// Defines values for IssueTransitionState.
+const (
+	IssueTransitionStateClosed IssueTransitionState = "closed"
+
+	IssueTransitionStateInProgress IssueTransitionState = "in_progress"
+
+	IssueTransitionStateOpen IssueTransitionState = "open"
+
+	IssueTransitionStateResolved IssueTransitionState = "resolved"
+)
+
+// Defines values for JobStatus.
+const (
+	JobStatusFailed JobStatus = "failed"
+
+	JobStatusQueued JobStatus = "queued"
+
+	JobStatusRunning JobStatus = "running"
+
+	JobStatusSucceeded JobStatus = "succeeded"
+)
+
+// Defines values for MilestoneState.
+const (
+	MilestoneStateClosed MilestoneState = "closed"
+
+	MilestoneStateOpen MilestoneState = "open"
+)
+
+// Defines values for MilestoneTransitionState.
+const (
+	MilestoneTransitionStateClosed MilestoneTransitionState = "closed"
+
+	MilestoneTransitionStateOpen MilestoneTransitionState = "open"
+)
+
+// Defines values for NewCustomFieldType.
+const (
+	NewCustomFieldTypeDate NewCustomFieldType = "date"
+
+	NewCustomFieldTypeEnum NewCustomFieldType = "enum"
+
+	NewCustomFieldTypeNumber NewCustomFieldType = "number"
+
+	NewCustomFieldTypeString NewCustomFieldType = "string"
+
+	NewCustomFieldTypeUser NewCustomFieldType = "user"
+)
+
+// Defines values for NewIssueLinkType.
+const (
+	NewIssueLinkTypeBlockedBy NewIssueLinkType = "blocked_by"
+
+	NewIssueLinkTypeBlocks NewIssueLinkType = "blocks"
+
+	NewIssueLinkTypeDuplicatedBy NewIssueLinkType = "duplicated_by"
+
+	NewIssueLinkTypeDuplicates NewIssueLinkType = "duplicates"
+
+	NewIssueLinkTypeRelatesTo NewIssueLinkType = "relates_to"
+)
+
+// Defines values for NotificationType.
+const (
+	NotificationTypeAssigned NotificationType = "assigned"
+
+	NotificationTypeCommented NotificationType = "commented"
+
+	NotificationTypeDue NotificationType = "due"
+
+	NotificationTypeMentioned NotificationType = "mentioned"
+
+	NotificationTypeSla NotificationType = "sla"
+
+	NotificationTypeStateChanged NotificationType = "state_changed"
+)
+
+// Defines values for NotificationPreferencesEmail.
+const (
+	NotificationPreferencesEmailDaily NotificationPreferencesEmail = "daily"
+
+	NotificationPreferencesEmailImmediate NotificationPreferencesEmail = "immediate"
+
+	NotificationPreferencesEmailOff NotificationPreferencesEmail = "off"
+)
+
+// Defines values for SlaBusinessHoursDay.
+const (
+	SlaBusinessHoursDayFriday SlaBusinessHoursDay = "friday"
+
+	SlaBusinessHoursDayMonday SlaBusinessHoursDay = "monday"
+
+	SlaBusinessHoursDaySaturday SlaBusinessHoursDay = "saturday"
+
+	SlaBusinessHoursDaySunday SlaBusinessHoursDay = "sunday"
+
+	SlaBusinessHoursDayThursday SlaBusinessHoursDay = "thursday"
+
+	SlaBusinessHoursDayTuesday SlaBusinessHoursDay = "tuesday"
+
+	SlaBusinessHoursDayWednesday SlaBusinessHoursDay = "wednesday"
+)
+
+// Defines values for SlaClockStatus.
+const (
+	SlaClockStatusAtRisk SlaClockStatus = "at_risk"
+
+	SlaClockStatusBreached SlaClockStatus = "breached"
+
+	SlaClockStatusMet SlaClockStatus = "met"
+
+	SlaClockStatusOk SlaClockStatus = "ok"
+)
+
+// Defines values for WatcherReason.
+const (
+	WatcherReasonAssignee WatcherReason = "assignee"
+
+	WatcherReasonCommenter WatcherReason = "commenter"
+
+	WatcherReasonManual WatcherReason = "manual"
+
+	WatcherReasonMentioned WatcherReason = "mentioned"
+
+	WatcherReasonReporter WatcherReason = "reporter"
+)
+
+// Issue activity response.
+type Activity struct {
+	// The change made to the issue.
+	Action string `json:"action"`
+
+	// Identifier of the user who made the change.
+	Actor string `json:"actor"`
+
+	// The timestamp the change was made
+	CreatedAt time.Time `json:"created_at"`
+
+	// Details of the change, such as the previous and new values.
+	Details ActivityDetails `json:"details"`
+
+	// Activity identifier.
+	Id string `json:"id"`
+}
+
+// Details of the change, such as the previous and new values.
+type ActivityDetails struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Activity page response.
+type ActivityPage struct {
+	Activity []Activity `json:"activity"`
+}
+
+// Attachment response.
+type Attachment struct {
+	// Identifier of the comment when the file is attached to a comment.
+	CommentId *string `json:"comment_id,omitempty"`
+
+	// The content type of the file.
+	ContentType string `json:"content_type"`
+
+	// The timestamp the Attachment was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The name of the file.
+	Filename string `json:"filename"`
+
+	// Attachment identifier.
+	Id string `json:"id"`
+
+	// Identifier of the issue.
+	IssueId string `json:"issue_id"`
+
+	// The hex encoded SHA-256 checksum of the file.
+	Sha256 string `json:"sha256"`
+
+	// The size of the file in bytes.
+	Size int64 `json:"size"`
+
+	// Identifier of the user who uploaded the file.
+	UploadedBy string `json:"uploaded_by"`
+}
+
+// Attachments page response.
+type AttachmentsPage struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
 // Comment response.
 type Comment struct {
 	// User response.
@@ -35,21 +278,96 @@ type Comment struct {
 	// The content associated with the Comment.
 	Content string `json:"content"`
 
+	// The content rendered from markdown to sanitised HTML, task lists are rendered as disabled checkboxes and issue keys link to /issues/{key}.
+	ContentHtml *string `json:"content_html,omitempty"`
+
 	// The timestamp the Comment was created.
 	CreatedAt time.Time `json:"created_at"`
 
+	// The comment was deleted and remains as a placeholder for its replies.
+	Deleted bool `json:"deleted"`
+
+	// The depth of the comment in the thread, top level comments are 0.
+	Depth int `json:"depth"`
+
+	// The content of the comment has been changed since it was created.
+	Edited bool `json:"edited"`
+
 	// Comment identifier.
 	Id string `json:"id"`
 
+	// Identifier of the comment this replies to.
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Counts of each reaction to the comment, in the order they were first used.
+	Reactions []Reaction `json:"reactions"`
+
 	// The timestamp the Comment was last updated.
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// Comment revision response.
+type CommentRevision struct {
+	// The content of the comment at this revision.
+	Content string `json:"content"`
+
+	// The timestamp the revision was made.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The revision number, starting at 1 when the comment was created.
+	Revision int `json:"revision"`
+}
+
+// Comment revisions page response.
+type CommentRevisionsPage struct {
+	Revisions []CommentRevision `json:"revisions"`
+}
+
 // Comments page response.
 type CommentsPage struct {
 	Comments []Comment `json:"comments"`
 }
 
+// Custom field response.
+type CustomField struct {
+	// The timestamp the custom field was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// A default value used when an issue doesn't provide one.
+	Default *string `json:"default,omitempty"`
+
+	// A description of the field.
+	Description *string `json:"description,omitempty"`
+
+	// Custom field identifier.
+	Id string `json:"id"`
+
+	// The name of the field.
+	Name string `json:"name"`
+
+	// The allowed values of an enum field.
+	Options []string `json:"options"`
+
+	// Whether a value is required on every issue.
+	Required bool `json:"required"`
+
+	// The type of the field.
+	Type string `json:"type"`
+
+	// The timestamp the custom field was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Custom field values keyed by field name, numbers and dates (YYYY-MM-DD) are encoded as strings.
+type CustomFieldValues struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Custom fields page response.
+type CustomFieldsPage struct {
+	Fields []CustomField `json:"fields"`
+}
+
 // Customer response.
 type Customer struct {
 	// The timestamp the customer was created
@@ -76,35 +394,216 @@ type CustomersPage struct {
 	Customers []Customer `json:"customers"`
 }
 
+// The CSV column headers holding each field of an issue, only subject is required. Labels are
+// separated by commas, and users are matched by login or email.
+type ImportColumns struct {
+	Assignee *string `json:"assignee,omitempty"`
+	Category *string `json:"category,omitempty"`
+	Content  *string `json:"content,omitempty"`
+
+	// The column with the id of the issue in the source, the row number is used when omitted.
+	ExternalId *string `json:"external_id,omitempty"`
+	Labels     *string `json:"labels,omitempty"`
+	Reporter   *string `json:"reporter,omitempty"`
+	Severity   *string `json:"severity,omitempty"`
+	State      *string `json:"state,omitempty"`
+	Subject    string  `json:"subject"`
+}
+
+// The result of importing an issue.
+type ImportIssueResult struct {
+	// The number of comments created on the issue.
+	Comments int `json:"comments"`
+
+	// Why the issue couldn't be imported.
+	Error *string `json:"error,omitempty"`
+
+	// The id of the issue in the source.
+	ExternalId string  `json:"external_id"`
+	IssueId    *string `json:"issue_id,omitempty"`
+	Key        *string `json:"key,omitempty"`
+
+	// Whether the issue was created, was imported before or couldn't be imported.
+	Status ImportIssueResultStatus `json:"status"`
+
+	// Values which were replaced by a default.
+	Warnings *[]string `json:"warnings,omitempty"`
+}
+
+// Whether the issue was created, was imported before or couldn't be imported.
+type ImportIssueResultStatus string
+
+// Maps values in the source to values in exitus. Users map to user identifiers and labels mapped
+// to an empty string are dropped, values which aren't mapped are imported as they are, users are
+// matched by login or email.
+type ImportMapping struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Import options.
+type ImportOptions struct {
+	// Maps values in the source to values in exitus. Users map to user identifiers and labels mapped
+	// to an empty string are dropped, values which aren't mapped are imported as they are, users are
+	// matched by login or email.
+	Categories *ImportMapping `json:"categories,omitempty"`
+
+	// The CSV column headers holding each field of an issue, only subject is required. Labels are
+	// separated by commas, and users are matched by login or email.
+	Columns *ImportColumns `json:"columns,omitempty"`
+
+	// The category of issues without one, or whose category isn't allowed by the project.
+	DefaultCategory *string `json:"default_category,omitempty"`
+
+	// The severity of issues without one, or whose severity isn't allowed by the project.
+	DefaultSeverity *string `json:"default_severity,omitempty"`
+
+	// Report what would be imported without importing it.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// The format of the file.
+	Format ImportOptionsFormat `json:"format"`
+
+	// Maps values in the source to values in exitus. Users map to user identifiers and labels mapped
+	// to an empty string are dropped, values which aren't mapped are imported as they are, users are
+	// matched by login or email.
+	Labels *ImportMapping `json:"labels,omitempty"`
+
+	// The project to import into.
+	ProjectId *string `json:"project_id,omitempty"`
+
+	// The name of the project to import into when project_id isn't given, it is created if it doesn't exist.
+	ProjectName *string `json:"project_name,omitempty"`
+
+	// Maps values in the source to values in exitus. Users map to user identifiers and labels mapped
+	// to an empty string are dropped, values which aren't mapped are imported as they are, users are
+	// matched by login or email.
+	Severities *ImportMapping `json:"severities,omitempty"`
+
+	// Maps values in the source to values in exitus. Users map to user identifiers and labels mapped
+	// to an empty string are dropped, values which aren't mapped are imported as they are, users are
+	// matched by login or email.
+	States *ImportMapping `json:"states,omitempty"`
+
+	// Maps values in the source to values in exitus. Users map to user identifiers and labels mapped
+	// to an empty string are dropped, values which aren't mapped are imported as they are, users are
+	// matched by login or email.
+	Users *ImportMapping `json:"users,omitempty"`
+}
+
+// The format of the file.
+type ImportOptionsFormat string
+
+// Import report response.
+type ImportReport struct {
+	// The number of comments created, including new comments on existing issues.
+	CommentsCreated int `json:"comments_created"`
+
+	// The number of issues created.
+	Created int `json:"created"`
+
+	// True if nothing was imported.
+	DryRun bool `json:"dry_run"`
+
+	// The number of issues skipped as they were imported before.
+	Existing int `json:"existing"`
+
+	// The number of issues which couldn't be imported.
+	Invalid int                 `json:"invalid"`
+	Issues  []ImportIssueResult `json:"issues"`
+
+	// Labels added to the project.
+	NewLabels []string `json:"new_labels"`
+
+	// True if the project was, or would be, created.
+	ProjectCreated bool `json:"project_created"`
+
+	// The project imported into, empty when a dry run would create it.
+	ProjectId string `json:"project_id"`
+
+	// Users in the source which didn't match a user, their issues and comments are created by the importing user.
+	UnmappedUsers []string `json:"unmapped_users"`
+}
+
+// Inbound email response.
+type InboundEmail struct {
+	// Identifier of the comment created when the email was a reply.
+	CommentId *string `json:"comment_id,omitempty"`
+
+	// The timestamp the email was received.
+	CreatedAt time.Time `json:"created_at"`
+
+	// The address the email was sent from.
+	From string `json:"from"`
+
+	// Identifier of the issue created by the email, or which it replied to.
+	IssueId string `json:"issue_id"`
+
+	// The Message-ID of the email without angle brackets.
+	MessageId string `json:"message_id"`
+
+	// Identifier of the project the email was routed to.
+	ProjectId string `json:"project_id"`
+}
+
 // Issue response.
 type Issue struct {
 	// User response.
 	Assignee *User `json:"assignee,omitempty"`
 
+	// Identifier of the user the Issue is assigned to.
+	AssigneeId *string `json:"assignee_id,omitempty"`
+
 	// The category of the Issue.
 	Category string `json:"category"`
 
+	// Counts of all the descendants of an issue by state.
+	Children IssueChildCounts `json:"children"`
+
 	// Comments page response.
 	Comments *CommentsPage `json:"comments,omitempty"`
 
 	// The content associated with the Issue, any background, or details required to help resolve it.
 	Content *string `json:"content,omitempty"`
 
+	// The content rendered from markdown to sanitised HTML, task lists are rendered as disabled checkboxes and issue keys link to /issues/{key}.
+	ContentHtml *string `json:"content_html,omitempty"`
+
 	// The timestamp the Issue was created
 	CreatedAt time.Time `json:"created_at"`
 
+	// Custom field values keyed by field name, numbers and dates (YYYY-MM-DD) are encoded as strings.
+	CustomFields CustomFieldValues `json:"custom_fields"`
+
+	// When the Issue is due.
+	DueAt *time.Time `json:"due_at,omitempty"`
+
 	// Issue identifier.
 	Id string `json:"id"`
 
+	// A human friendly key allocated from the project key when the issue was created, this doesn't change when the issue is moved.
+	Key *string `json:"key,omitempty"`
+
 	// Labels assigned to an entity.
 	Labels []string `json:"labels"`
 
+	// Identifier of the milestone the Issue is assigned to.
+	MilestoneId *string `json:"milestone_id,omitempty"`
+
+	// Identifier of the parent issue.
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// Identifier of the project the Issue belongs to.
+	ProjectId string `json:"project_id"`
+
 	// User response.
 	Reporter *User `json:"reporter,omitempty"`
 
 	// The severity of the Issue.
 	Severity string `json:"severity"`
 
+	// The SLA clocks of an issue.
+	Sla *IssueSla `json:"sla,omitempty"`
+
 	// The state of the Issue.
 	State string `json:"state"`
 
@@ -113,2815 +612,13254 @@ type Issue struct {
 
 	// The timestamp the Issue was last updated
 	UpdatedAt time.Time `json:"updated_at"`
-}
 
-// Issue page response.
-type IssuesPage struct {
-	Issues []Issue `json:"issues"`
+	// The number of users who have voted for the Issue.
+	Votes int `json:"votes"`
 }
 
-// New Comment request.
-type NewComment struct {
-	// The content associated with the comment.
-	Content string `json:"content"`
-}
+// The changes applied to each issue, at least one change must be provided.
+type IssueBulkChanges struct {
+	// Labels to add to the issues.
+	AddLabels *[]string `json:"add_labels,omitempty"`
 
-// New Customer request.
-type NewCustomer struct {
-	// A description of the customer, with some background.
-	Description *string `json:"description,omitempty"`
+	// Identifier of the user to assign the issues to, an empty string unassigns them.
+	AssigneeId *string `json:"assignee_id,omitempty"`
 
-	// Labels assigned to an entity.
-	Labels []string `json:"labels"`
+	// Labels to remove from the issues.
+	RemoveLabels *[]string `json:"remove_labels,omitempty"`
 
-	// The name of the customer.
-	Name string `json:"name"`
+	// The severity to set.
+	Severity *string `json:"severity,omitempty"`
+
+	// The state to move the issues to, following the workflow.
+	State *IssueBulkChangesState `json:"state,omitempty"`
 }
 
-// New issue request.
-type NewIssue struct {
-	// A category of the Issue.
-	Category string `json:"category"`
+// The state to move the issues to, following the workflow.
+type IssueBulkChangesState string
 
-	// The content associated with the issue, any background, or details required to help resolve it.
-	Content string `json:"content"`
+// The result of a change to an issue in a bulk request.
+type IssueBulkResult struct {
+	// A description of the failure.
+	Error *string `json:"error,omitempty"`
 
-	// Labels assigned to an entity.
-	Labels []string `json:"labels"`
+	// Why the change failed.
+	ErrorCode *IssueBulkResultErrorCode `json:"error_code,omitempty"`
 
-	// A severity of the Issue.
-	Severity string `json:"severity"`
+	// Identifier or key of the issue as requested.
+	Id string `json:"id"`
 
-	// A subject of the issue
-	Subject string `json:"subject"`
+	// The key of the issue.
+	Key *string `json:"key,omitempty"`
+
+	// updated or unchanged when the change was applied, failed when it wasn't and rolled_back when it was undone because another issue in an atomic request failed.
+	Status IssueBulkResultStatus `json:"status"`
+
+	// The timestamp the issue was last updated, after the change.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
-// New Project request.
-type NewProject struct {
-	// A description of the project, with some background.
-	Description *string `json:"description,omitempty"`
+// Why the change failed.
+type IssueBulkResultErrorCode string
 
-	// Labels assigned to an entity.
-	Labels []string `json:"labels"`
+// updated or unchanged when the change was applied, failed when it wasn't and rolled_back when it was undone because another issue in an atomic request failed.
+type IssueBulkResultStatus string
 
-	// The name of the project.
-	Name string `json:"name"`
+// Bulk issue change response.
+type IssueBulkResults struct {
+	// The changes were saved, this is false when an atomic request had failures.
+	Committed bool `json:"committed"`
+
+	// The number of issues which couldn't be changed.
+	Failed int `json:"failed"`
+
+	// The result for each issue, in the order they were requested.
+	Results []IssueBulkResult `json:"results"`
+
+	// The number of issues which were changed.
+	Updated int `json:"updated"`
 }
 
-// Project response.
-type Project struct {
-	// The timestamp the Project was created
-	CreatedAt time.Time `json:"created_at"`
+// An issue to change in a bulk request.
+type IssueBulkTarget struct {
+	// Identifier or key of the issue.
+	Id string `json:"id"`
 
-	// A description of the project, with some background.
-	Description *string `json:"description,omitempty"`
+	// Only change the issue if it was last updated at this time.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
 
-	// Project identifier.
-	Id string `json:"id"`
+// Bulk issue change request, either issues or filter must be provided.
+type IssueBulkUpdate struct {
+	// Change none of the issues if any of them can't be changed.
+	Atomic *bool `json:"atomic,omitempty"`
 
-	// Labels assigned to an entity.
-	Labels []string `json:"labels"`
+	// The changes applied to each issue, at least one change must be provided.
+	Changes IssueBulkChanges `json:"changes"`
 
-	// The name of the Project.
-	Name string `json:"name"`
+	// Change the issues matching all the filters, each filter is in the form field:value as used to filter a list of issues.
+	Filter *[]string `json:"filter,omitempty"`
 
-	// The timestamp the Project was last updated
-	UpdatedAt time.Time `json:"updated_at"`
+	// The issues to change.
+	Issues *[]IssueBulkTarget `json:"issues,omitempty"`
 }
 
-// Project page response.
-type ProjectsPage struct {
-	Projects []Project `json:"projects"`
+// Counts of all the descendants of an issue by state.
+type IssueChildCounts struct {
+	Closed     int `json:"closed"`
+	Created    int `json:"created"`
+	InProgress int `json:"in_progress"`
+	Open       int `json:"open"`
+	Resolved   int `json:"resolved"`
+	Total      int `json:"total"`
 }
 
-// UpdatedComment defines model for UpdatedComment.
-type UpdatedComment struct {
-	// Embedded struct due to allOf(#/components/schemas/NewComment)
-	NewComment `yaml:",inline"`
-	// Embedded fields due to inline allOf schema
-	Version int64 `json:"version"`
-}
+// Issue link response.
+type IssueLink struct {
+	// The timestamp the link was created
+	CreatedAt time.Time `json:"created_at"`
 
-// UpdatedCustomer defines model for UpdatedCustomer.
-type UpdatedCustomer struct {
-	// Embedded struct due to allOf(#/components/schemas/NewCustomer)
-	NewCustomer `yaml:",inline"`
-	// Embedded fields due to inline allOf schema
-	Version int64 `json:"version"`
-}
+	// Issue link identifier.
+	Id string `json:"id"`
 
-// UpdatedIssue defines model for UpdatedIssue.
-type UpdatedIssue struct {
-	// Embedded struct due to allOf(#/components/schemas/NewIssue)
-	NewIssue `yaml:",inline"`
-	// Embedded fields due to inline allOf schema
-	Version int64 `json:"version"`
+	// Identifier of the linked issue.
+	IssueId string `json:"issue_id"`
+
+	// Identifier of the project of the linked issue.
+	ProjectId string `json:"project_id"`
+
+	// The state of the linked issue.
+	State string `json:"state"`
+
+	// The subject of the linked issue.
+	Subject string `json:"subject"`
+
+	// The relationship of this issue to the linked issue.
+	Type string `json:"type"`
 }
 
-// UpdatedProject defines model for UpdatedProject.
-type UpdatedProject struct {
-	// Embedded struct due to allOf(#/components/schemas/NewProject)
-	NewProject `yaml:",inline"`
-	// Embedded fields due to inline allOf schema
-	Version int64 `json:"version"`
+// Issue links page response.
+type IssueLinksPage struct {
+	Links []IssueLink `json:"links"`
 }
 
-// User response.
-type User struct {
-	// The timestamp the User was created.
-	CreatedAt time.Time `json:"created_at"`
+// Issue move request.
+type IssueMove struct {
+	// Identifier of the project to move the issue to.
+	ProjectId string `json:"project_id"`
+}
 
-	// Email of the User.
-	Email string `json:"email"`
+// Issue revision response.
+type IssueRevision struct {
+	// The content of the issue at this revision.
+	Content *string `json:"content,omitempty"`
 
-	// User identifier.
-	Id string `json:"id"`
+	// The timestamp the revision was made.
+	CreatedAt time.Time `json:"created_at"`
 
-	// Name of the User.
-	Name string `json:"name"`
+	// The revision number, starting at 1 when the issue was created.
+	Revision int `json:"revision"`
 
-	// The timestamp the User was last updated.
-	UpdatedAt time.Time `json:"updated_at"`
+	// The subject of the issue at this revision.
+	Subject string `json:"subject"`
 }
 
-// User page response.
-type UsersPage struct {
-	Users []User `json:"users"`
+// Issue revisions page response.
+type IssueRevisionsPage struct {
+	Revisions []IssueRevision `json:"revisions"`
 }
 
-// Limit defines model for limit.
-type Limit int64
+// The SLA clocks of an issue.
+type IssueSla struct {
+	// The progress of an issue against an SLA target.
+	FirstResponse *SlaClock `json:"first_response,omitempty"`
 
-// Offset defines model for offset.
-type Offset int64
+	// Identifier of the SLA policy the clocks were computed with.
+	PolicyId string `json:"policy_id"`
 
-// Q defines model for q.
-type Q string
+	// The progress of an issue against an SLA target.
+	Resolution *SlaClock `json:"resolution,omitempty"`
+}
 
-// CustomersParams defines parameters for Customers.
-type CustomersParams struct {
-	// Used to query by name in a list operation.
-	Q *Q `json:"q,omitempty"`
+// Issue state change request.
+type IssueTransition struct {
+	// The state to move the issue to.
+	State IssueTransitionState `json:"state"`
+}
 
-	// Used to request the next page in a list operation.
-	Offset *Offset `json:"offset,omitempty"`
+// The state to move the issue to.
+type IssueTransitionState string
 
-	// Used to specify the maximum number of records which are returned in the next page.
-	Limit *Limit `json:"limit,omitempty"`
+// Issue page response.
+type IssuesPage struct {
+	Issues []Issue `json:"issues"`
 }
 
-// NewCustomerJSONBody defines parameters for NewCustomer.
-type NewCustomerJSONBody NewCustomer
+// Background job response.
+type Job struct {
+	// The number of times the job has been started.
+	Attempts int `json:"attempts"`
 
-// UpdateCustomerJSONBody defines parameters for UpdateCustomer.
-type UpdateCustomerJSONBody UpdatedCustomer
+	// The timestamp the job was created.
+	CreatedAt time.Time `json:"created_at"`
 
-// ProjectsParams defines parameters for Projects.
-type ProjectsParams struct {
-	// Used to query by name in a list operation.
-	Q *Q `json:"q,omitempty"`
+	// Identifier of the user who started the job.
+	CreatedBy *string `json:"created_by,omitempty"`
 
-	// Used to request the next page in a list operation.
-	Offset *Offset `json:"offset,omitempty"`
+	// The timestamp the job succeeded or failed.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
 
-	// Used to specify the maximum number of records which are returned in the next page.
-	Limit *Limit `json:"limit,omitempty"`
-}
+	// Job identifier.
+	Id string `json:"id"`
 
-// NewProjectJSONBody defines parameters for NewProject.
-type NewProjectJSONBody NewProject
+	// The error of the last failed attempt.
+	LastError *string `json:"last_error,omitempty"`
 
-// UpdateProjectJSONBody defines parameters for UpdateProject.
-type UpdateProjectJSONBody UpdatedProject
+	// The number of times the job is started before it fails.
+	MaxAttempts int `json:"max_attempts"`
 
-// IssuesParams defines parameters for Issues.
-type IssuesParams struct {
-	// Used to query by name in a list operation.
-	Q *Q `json:"q,omitempty"`
+	// The result of a job which has succeeded, this depends on the type of job.
+	Result *map[string]interface{} `json:"result,omitempty"`
 
-	// Used to request the next page in a list operation.
-	Offset *Offset `json:"offset,omitempty"`
+	// The time the job is due to run, or was last started.
+	RunAt time.Time `json:"run_at"`
 
-	// Used to specify the maximum number of records which are returned in the next page.
-	Limit *Limit `json:"limit,omitempty"`
-}
+	// The status of the job, failed jobs are retried with a backoff while they are queued and are only failed once they have been attempted max_attempts times.
+	Status JobStatus `json:"status"`
 
-// NewIssueJSONBody defines parameters for NewIssue.
-type NewIssueJSONBody NewIssue
+	// The type of the job.
+	Type string `json:"type"`
 
-// CommentsParams defines parameters for Comments.
-type CommentsParams struct {
-	// Used to query by name in a list operation.
-	Q *Q `json:"q,omitempty"`
+	// The timestamp the job was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
 
-	// Used to request the next page in a list operation.
-	Offset *Offset `json:"offset,omitempty"`
+// The status of the job, failed jobs are retried with a backoff while they are queued and are only failed once they have been attempted max_attempts times.
+type JobStatus string
 
-	// Used to specify the maximum number of records which are returned in the next page.
-	Limit *Limit `json:"limit,omitempty"`
+// Job page response.
+type JobsPage struct {
+	Jobs []Job `json:"jobs"`
 }
 
-// NewCommentJSONBody defines parameters for NewComment.
-type NewCommentJSONBody NewComment
+// Mention response.
+type Mention struct {
+	// Identifier of the comment, this is omitted when the mention is in the issue.
+	CommentId *string `json:"comment_id,omitempty"`
 
-// UsersParams defines parameters for Users.
-type UsersParams struct {
-	// Used to query by name in a list operation.
-	Q *Q `json:"q,omitempty"`
+	// The timestamp the user was first mentioned.
+	CreatedAt time.Time `json:"created_at"`
 
-	// Used to request the next page in a list operation.
-	Offset *Offset `json:"offset,omitempty"`
+	// Mention identifier.
+	Id string `json:"id"`
 
-	// Used to specify the maximum number of records which are returned in the next page.
-	Limit *Limit `json:"limit,omitempty"`
+	// Identifier of the issue.
+	IssueId string `json:"issue_id"`
+
+	// Identifier of the user who wrote the mention.
+	MentionedBy string `json:"mentioned_by"`
+
+	// Identifier of the project the issue belongs to.
+	ProjectId string `json:"project_id"`
 }
 
-// NewCustomerJSONRequestBody defines body for NewCustomer for application/json ContentType.
-type NewCustomerJSONRequestBody NewCustomerJSONBody
+// Mention page response.
+type MentionsPage struct {
+	Mentions []Mention `json:"mentions"`
+}
 
-// UpdateCustomerJSONRequestBody defines body for UpdateCustomer for application/json ContentType.
-type UpdateCustomerJSONRequestBody UpdateCustomerJSONBody
+// Milestone response.
+type Milestone struct {
+	// The timestamp the milestone was last closed.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
 
-// NewProjectJSONRequestBody defines body for NewProject for application/json ContentType.
-type NewProjectJSONRequestBody NewProjectJSONBody
+	// The timestamp the milestone was created
+	CreatedAt time.Time `json:"created_at"`
 
-// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
-type UpdateProjectJSONRequestBody UpdateProjectJSONBody
+	// A description of the milestone.
+	Description *string `json:"description,omitempty"`
 
-// NewIssueJSONRequestBody defines body for NewIssue for application/json ContentType.
-type NewIssueJSONRequestBody NewIssueJSONBody
+	// When the milestone is due.
+	DueAt *time.Time `json:"due_at,omitempty"`
 
-// NewCommentJSONRequestBody defines body for NewComment for application/json ContentType.
-type NewCommentJSONRequestBody NewCommentJSONBody
+	// Milestone identifier.
+	Id string `json:"id"`
 
-// RequestEditorFn  is the function signature for the RequestEditor callback function
-type RequestEditorFn func(ctx context.Context, req *http.Request) error
+	// Counts of the issues in a milestone, resolved and closed issues are closed.
+	Progress MilestoneProgress `json:"progress"`
 
-// Doer performs HTTP requests.
-//
-// The standard http.Client implements this interface.
-type HttpRequestDoer interface {
-	Do(req *http.Request) (*http.Response, error)
-}
+	// Identifier of the project the milestone belongs to.
+	ProjectId string `json:"project_id"`
 
-// Client which conforms to the OpenAPI3 specification for this service.
-type Client struct {
-	// The endpoint of the server conforming to this interface, with scheme,
-	// https://api.deepmap.com for example. This can contain a path relative
-	// to the server, such as https://api.deepmap.com/dev-test, and all the
-	// paths in the swagger spec will be appended to the server.
-	Server string
+	// The state of the milestone.
+	State MilestoneState `json:"state"`
 
-	// Doer for performing requests, typically a *http.Client with any
-	// customized settings, such as certificate chains.
-	Client HttpRequestDoer
+	// The title of the milestone.
+	Title string `json:"title"`
 
-	// A list of callbacks for modifying requests which are generated before sending over
-	// the network.
-	RequestEditors []RequestEditorFn
+	// The timestamp the milestone was last updated
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-// ClientOption allows setting custom parameters during construction
-type ClientOption func(*Client) error
+// The state of the milestone.
+type MilestoneState string
 
-// Creates a new Client, with reasonable defaults
-func NewClient(server string, opts ...ClientOption) (*Client, error) {
-	// create a client with sane default values
-	client := Client{
-		Server: server,
-	}
-	// mutate client and add all optional params
-	for _, o := range opts {
-		if err := o(&client); err != nil {
-			return nil, err
-		}
-	}
-	// ensure the server URL always has a trailing slash
-	if !strings.HasSuffix(client.Server, "/") {
-		client.Server += "/"
-	}
-	// create httpClient, if not already present
-	if client.Client == nil {
-		client.Client = &http.Client{}
-	}
-	return &client, nil
+// Counts of the issues in a milestone, resolved and closed issues are closed.
+type MilestoneProgress struct {
+	ClosedIssues int `json:"closed_issues"`
+	OpenIssues   int `json:"open_issues"`
 }
 
-// WithHTTPClient allows overriding the default Doer, which is
-// automatically created using http.Client. This is useful for tests.
-func WithHTTPClient(doer HttpRequestDoer) ClientOption {
-	return func(c *Client) error {
-		c.Client = doer
-		return nil
-	}
-}
+// Milestone state change request.
+type MilestoneTransition struct {
+	// Identifier of the open milestone the open issues are moved to when closing.
+	MoveOpenIssuesTo *string `json:"move_open_issues_to,omitempty"`
 
-// WithRequestEditorFn allows setting up a callback function, which will be
-// called right before sending the request. This can be used to mutate the request.
-func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
-	return func(c *Client) error {
-		c.RequestEditors = append(c.RequestEditors, fn)
-		return nil
-	}
+	// The state to move the milestone to.
+	State MilestoneTransitionState `json:"state"`
 }
 
-// The interface specification for the client above.
-type ClientInterface interface {
-	// Customers request
-	Customers(ctx context.Context, params *CustomersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+// The state to move the milestone to.
+type MilestoneTransitionState string
 
-	// NewCustomer request with any body
-	NewCustomerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// Milestones page response.
+type MilestonesPage struct {
+	Milestones []Milestone `json:"milestones"`
+}
 
-	NewCustomer(ctx context.Context, body NewCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// New Comment request.
+type NewComment struct {
+	// The content associated with the comment.
+	Content string `json:"content"`
 
-	// GetCustomer request
-	GetCustomer(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Identifier of the comment being replied to.
+	ParentId *string `json:"parent_id,omitempty"`
+}
 
-	// UpdateCustomer request with any body
-	UpdateCustomerWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// New custom field request.
+type NewCustomField struct {
+	// A default value used when an issue doesn't provide one.
+	Default *string `json:"default,omitempty"`
 
-	UpdateCustomer(ctx context.Context, id string, body UpdateCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// A description of the field.
+	Description *string `json:"description,omitempty"`
 
-	// Projects request
-	Projects(ctx context.Context, params *ProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// The name of the field, which may contain letters, digits, - and _.
+	Name string `json:"name"`
 
-	// NewProject request with any body
-	NewProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// The allowed values of an enum field.
+	Options *[]string `json:"options,omitempty"`
 
-	NewProject(ctx context.Context, body NewProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// Whether a value is required on every issue.
+	Required bool `json:"required"`
 
-	// GetProject request
-	GetProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// The type of the field.
+	Type NewCustomFieldType `json:"type"`
+}
 
-	// UpdateProject request with any body
-	UpdateProjectWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+// The type of the field.
+type NewCustomFieldType string
 
-	UpdateProject(ctx context.Context, id string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+// New Customer request.
+type NewCustomer struct {
+	// A description of the customer, with some background.
+	Description *string `json:"description,omitempty"`
 
-	// Issues request
+	// Labels assigned to an entity.
+	Labels []string `json:"labels"`
+
+	// The name of the customer.
+	Name string `json:"name"`
+}
+
+// New Export request.
+type NewExport struct {
+	// The project to export, all the projects of the customer are exported when omitted.
+	ProjectId *string `json:"project_id,omitempty"`
+}
+
+// New issue request.
+type NewIssue struct {
+	// Identifier of the user the issue is assigned to, when updating an empty value removes the assignee and omitting it leaves the assignee unchanged.
+	AssigneeId *string `json:"assignee_id,omitempty"`
+
+	// A category of the Issue.
+	Category string `json:"category"`
+
+	// The content associated with the issue, any background, or details required to help resolve it.
+	Content string `json:"content"`
+
+	// Custom field values keyed by field name, numbers and dates (YYYY-MM-DD) are encoded as strings.
+	CustomFields *CustomFieldValues `json:"custom_fields,omitempty"`
+
+	// When the issue is due as an RFC 3339 timestamp, which must be in the future when it is set or changed. When updating an empty value removes the due date and omitting it leaves the due date unchanged.
+	DueAt *string `json:"due_at,omitempty"`
+
+	// Labels assigned to an entity.
+	Labels []string `json:"labels"`
+
+	// Identifier of an open milestone in the same project, when updating an empty value removes the issue from its milestone and omitting it leaves the milestone unchanged.
+	MilestoneId *string `json:"milestone_id,omitempty"`
+
+	// Identifier of the parent issue in the same project, when updating an empty value removes the parent and omitting it leaves the parent unchanged.
+	ParentId *string `json:"parent_id,omitempty"`
+
+	// A severity of the Issue.
+	Severity string `json:"severity"`
+
+	// A subject of the issue
+	Subject string `json:"subject"`
+}
+
+// New issue link request.
+type NewIssueLink struct {
+	// Close this issue when it duplicates the linked issue.
+	Close *bool `json:"close,omitempty"`
+
+	// Identifier or key of the issue to link to.
+	IssueId string `json:"issue_id"`
+
+	// The relationship of this issue to the linked issue.
+	Type NewIssueLinkType `json:"type"`
+}
+
+// The relationship of this issue to the linked issue.
+type NewIssueLinkType string
+
+// New milestone request.
+type NewMilestone struct {
+	// A description of the milestone.
+	Description *string `json:"description,omitempty"`
+
+	// When the milestone is due.
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// The title of the milestone, which is unique in the project.
+	Title string `json:"title"`
+}
+
+// New Project request.
+type NewProject struct {
+	// A description of the project, with some background.
+	Description *string `json:"description,omitempty"`
+
+	// A short prefix used to build issue keys such as API-123, between one and ten upper case letters or digits starting with a letter. Once set the key can't be changed.
+	Key *string `json:"key,omitempty"`
+
+	// Labels assigned to an entity.
+	Labels []string `json:"labels"`
+
+	// The name of the project.
+	Name string `json:"name"`
+}
+
+// New SLA policy request.
+type NewSlaPolicy struct {
+	// The percentage of a target which can elapse before an issue is at risk.
+	AtRiskPercent *int `json:"at_risk_percent,omitempty"`
+
+	// The business hours SLA targets are measured in, time outside the hours and on holidays
+	// doesn't count. A calendar without hours is open all the time.
+	Calendar *SlaCalendar `json:"calendar,omitempty"`
+
+	// The name of the policy.
+	Name string `json:"name"`
+
+	// Identifier of the project the policy applies to, the customer default when empty.
+	ProjectId *string `json:"project_id,omitempty"`
+
+	// The targets by severity, issues with other severities aren't tracked.
+	Targets []SlaTarget `json:"targets"`
+}
+
+// New User request.
+type NewUser struct {
+	// Email of the User.
+	Email string `json:"email"`
+
+	// The login of the user, unique to the customer and used in @mentions.
+	Login string `json:"login"`
+
+	// Name of the User.
+	Name string `json:"name"`
+
+	// The subject of the access token of the User, this links the authenticated user to the User.
+	Subject *string `json:"subject,omitempty"`
+}
+
+// Notification response.
+type Notification struct {
+	// Identifier of the user who caused the event, when they are known.
+	Actor *string `json:"actor,omitempty"`
+
+	// Identifier of the comment, this is omitted for events which aren't about a comment.
+	CommentId *string `json:"comment_id,omitempty"`
+
+	// The timestamp of the event.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Details of the event, such as the previous and new state.
+	Details NotificationDetails `json:"details"`
+
+	// Notification identifier.
+	Id string `json:"id"`
+
+	// Identifier of the issue.
+	IssueId string `json:"issue_id"`
+
+	// Identifier of the project the issue belongs to.
+	ProjectId string `json:"project_id"`
+
+	// Whether the notification has been read.
+	Read bool `json:"read"`
+
+	// The type of event.
+	Type NotificationType `json:"type"`
+}
+
+// The type of event.
+type NotificationType string
+
+// Details of the event, such as the previous and new state.
+type NotificationDetails struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// Which types of event are recorded as notifications, every type is recorded by default.
+type NotificationPreferences struct {
+	// Notify when an issue is assigned to the user.
+	Assigned bool `json:"assigned"`
+
+	// Notify when a watched issue is commented on.
+	Commented bool `json:"commented"`
+
+	// Notify when an assigned or watched issue is due soon or overdue, omitting this when updating leaves it unchanged.
+	Due *bool `json:"due,omitempty"`
+
+	// How notifications are emailed, off, immediate or in a daily digest. Users are emailed immediately by default, omitting this when updating leaves it unchanged.
+	Email *NotificationPreferencesEmail `json:"email,omitempty"`
+
+	// Notify when the user is mentioned.
+	Mentioned bool `json:"mentioned"`
+
+	// Notify when a watched issue is at risk of breaching or breaches an SLA target, omitting this when updating leaves it unchanged.
+	Sla *bool `json:"sla,omitempty"`
+
+	// Notify when the state of a watched issue changes.
+	StateChanged bool `json:"state_changed"`
+}
+
+// How notifications are emailed, off, immediate or in a daily digest. Users are emailed immediately by default, omitting this when updating leaves it unchanged.
+type NotificationPreferencesEmail string
+
+// Notification page response.
+type NotificationsPage struct {
+	Notifications []Notification `json:"notifications"`
+
+	// The number of unread notifications.
+	Unread int `json:"unread"`
+}
+
+// Project response.
+type Project struct {
+	// The timestamp the Project was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// A description of the project, with some background.
+	Description *string `json:"description,omitempty"`
+
+	// Project identifier.
+	Id string `json:"id"`
+
+	// A short prefix used to build issue keys.
+	Key *string `json:"key,omitempty"`
+
+	// Labels assigned to an entity.
+	Labels []string `json:"labels"`
+
+	// The name of the Project.
+	Name string `json:"name"`
+
+	// The timestamp the Project was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Project flow report response.
+type ProjectReport struct {
+	// Open issues by how long ago they were created, youngest first.
+	Ageing []ReportAgeingBucket `json:"ageing"`
+
+	// The start of the report.
+	From time.Time `json:"from"`
+
+	// The length of each period.
+	Interval string `json:"interval"`
+
+	// Counts of open issues, which are issues that aren't resolved or closed.
+	Open ReportOpenIssues `json:"open"`
+
+	// Identifier of the project.
+	ProjectId string `json:"project_id"`
+
+	// How long issues first resolved in the report took to resolve, in seconds.
+	Resolution ReportResolution `json:"resolution"`
+
+	// Issues created and resolved in each period, oldest first.
+	Throughput []ReportPeriod `json:"throughput"`
+
+	// The end of the report.
+	To time.Time `json:"to"`
+}
+
+// Project page response.
+type ProjectsPage struct {
+	Projects []Project `json:"projects"`
+}
+
+// Reaction count response.
+type Reaction struct {
+	Count    int    `json:"count"`
+	Reaction string `json:"reaction"`
+}
+
+// Open issues created within a range of days.
+type ReportAgeingBucket struct {
+	// The number of open issues.
+	Count int `json:"count"`
+
+	// The issues are younger than this many days, the oldest bucket has no limit.
+	MaxDays *int `json:"max_days,omitempty"`
+
+	// The issues are at least this many days old.
+	MinDays int `json:"min_days"`
+}
+
+// A count of issues with a value.
+type ReportCount struct {
+	// The number of issues.
+	Count int `json:"count"`
+
+	// The value counted.
+	Value *string `json:"value,omitempty"`
+}
+
+// Counts of open issues, which are issues that aren't resolved or closed.
+type ReportOpenIssues struct {
+	// Counts by assignee identifier, unassigned issues have no value.
+	ByAssignee []ReportCount `json:"by_assignee"`
+	ByCategory []ReportCount `json:"by_category"`
+	BySeverity []ReportCount `json:"by_severity"`
+	ByState    []ReportCount `json:"by_state"`
+
+	// The number of open issues.
+	Total int `json:"total"`
+}
+
+// Issues created and resolved in a period.
+type ReportPeriod struct {
+	// The number of issues created.
+	Created int `json:"created"`
+
+	// The number of times an issue moved to resolved or closed from an open state.
+	Resolved int `json:"resolved"`
+
+	// The start of the period, the first period may start before from.
+	Start time.Time `json:"start"`
+}
+
+// How long issues first resolved in the report took to resolve, in seconds.
+type ReportResolution struct {
+	// The mean seconds from first starting an issue to first resolving it.
+	MeanCycleTime *float64 `json:"mean_cycle_time,omitempty"`
+
+	// The mean seconds from creating an issue to first resolving it.
+	MeanTimeToResolve *float64 `json:"mean_time_to_resolve,omitempty"`
+
+	// The median seconds from first starting an issue to first resolving it.
+	MedianCycleTime *float64 `json:"median_cycle_time,omitempty"`
+
+	// The median seconds from creating an issue to first resolving it.
+	MedianTimeToResolve *float64 `json:"median_time_to_resolve,omitempty"`
+
+	// The number of issues first resolved or closed in the report.
+	Resolved int `json:"resolved"`
+
+	// The number of resolved issues which were in progress before they were resolved.
+	Started int `json:"started"`
+}
+
+// Severity response.
+type Severity struct {
+	// The name of the severity.
+	Name string `json:"name"`
+
+	// The rank of the severity, starting at 1 for the most severe.
+	Rank int `json:"rank"`
+}
+
+// The business hours of a day of the week, a day may have more than one range.
+type SlaBusinessHours struct {
+	Day SlaBusinessHoursDay `json:"day"`
+
+	// The time the business closes formatted as HH:MM, 24:00 is the end of the day.
+	End string `json:"end"`
+
+	// The time the business opens formatted as HH:MM.
+	Start string `json:"start"`
+}
+
+// SlaBusinessHoursDay defines model for SlaBusinessHours.Day.
+type SlaBusinessHoursDay string
+
+// The business hours SLA targets are measured in, time outside the hours and on holidays
+// doesn't count. A calendar without hours is open all the time.
+type SlaCalendar struct {
+	// Dates (YYYY-MM-DD) which are excluded from business hours.
+	Holidays []string           `json:"holidays"`
+	Hours    []SlaBusinessHours `json:"hours"`
+
+	// The IANA timezone of the hours and holidays.
+	Timezone string `json:"timezone"`
+}
+
+// The progress of an issue against an SLA target.
+type SlaClock struct {
+	// When the target was breached.
+	BreachedAt *time.Time `json:"breached_at,omitempty"`
+
+	// When the clock was stopped by the first response or resolving the issue.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+
+	// When the target is breached if the clock keeps running.
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// The status of the clock, at_risk once the at risk percentage of the target has
+	// elapsed and met when it was completed before the target.
+	Status SlaClockStatus `json:"status"`
+
+	// The target in business minutes.
+	TargetMinutes int `json:"target_minutes"`
+}
+
+// The status of the clock, at_risk once the at risk percentage of the target has
+// elapsed and met when it was completed before the target.
+type SlaClockStatus string
+
+// SLA policies page response.
+type SlaPoliciesPage struct {
+	SlaPolicies []SlaPolicy `json:"sla_policies"`
+}
+
+// SLA policy response.
+type SlaPolicy struct {
+	// The percentage of a target which can elapse before an issue is at risk.
+	AtRiskPercent int `json:"at_risk_percent"`
+
+	// The business hours SLA targets are measured in, time outside the hours and on holidays
+	// doesn't count. A calendar without hours is open all the time.
+	Calendar SlaCalendar `json:"calendar"`
+
+	// The timestamp the SLA policy was created
+	CreatedAt time.Time `json:"created_at"`
+
+	// SLA policy identifier.
+	Id string `json:"id"`
+
+	// The name of the policy.
+	Name string `json:"name"`
+
+	// Identifier of the project the policy applies to, the customer default when empty.
+	ProjectId *string `json:"project_id,omitempty"`
+
+	// The targets by severity.
+	Targets []SlaTarget `json:"targets"`
+
+	// The timestamp the SLA policy was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Project SLA report response.
+type SlaReport struct {
+	// Counts of the issues with an SLA clock by status.
+	FirstResponse SlaStatusCounts `json:"first_response"`
+
+	// Open issues which are at risk or breached, the soonest due first.
+	Issues []SlaReportIssue `json:"issues"`
+
+	// Identifier of the project.
+	ProjectId string `json:"project_id"`
+
+	// Counts of the issues with an SLA clock by status.
+	Resolution SlaStatusCounts `json:"resolution"`
+}
+
+// An issue which is at risk or has breached an SLA target.
+type SlaReportIssue struct {
+	// Identifier of the user the issue is assigned to.
+	AssigneeId *string `json:"assignee_id,omitempty"`
+
+	// Identifier of the issue.
+	IssueId string `json:"issue_id"`
+
+	// The key of the issue.
+	Key *string `json:"key,omitempty"`
+
+	// The severity of the issue.
+	Severity *string `json:"severity,omitempty"`
+
+	// The SLA clocks of an issue.
+	Sla IssueSla `json:"sla"`
+
+	// The state of the issue.
+	State string `json:"state"`
+
+	// The subject of the issue.
+	Subject string `json:"subject"`
+}
+
+// Counts of the issues with an SLA clock by status.
+type SlaStatusCounts struct {
+	AtRisk   int `json:"at_risk"`
+	Breached int `json:"breached"`
+	Met      int `json:"met"`
+	Ok       int `json:"ok"`
+}
+
+// The SLA targets of issues with a severity, in business minutes.
+type SlaTarget struct {
+	// The time to the first comment by someone other than the reporter, resolving the issue also stops the clock.
+	FirstResponseMinutes *int `json:"first_response_minutes,omitempty"`
+
+	// The time to resolve the issue, the clock stops while it is resolved or closed.
+	ResolutionMinutes *int   `json:"resolution_minutes,omitempty"`
+	Severity          string `json:"severity"`
+}
+
+// Taxonomy response.
+type Taxonomy struct {
+	// The allowed categories, empty if categories aren't restricted.
+	Categories []string `json:"categories"`
+
+	// The allowed severities ordered by rank, empty if severities aren't restricted.
+	Severities []Severity `json:"severities"`
+}
+
+// UpdatedComment defines model for UpdatedComment.
+type UpdatedComment struct {
+	// Embedded struct due to allOf(#/components/schemas/NewComment)
+	NewComment `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Version int64 `json:"version"`
+}
+
+// UpdatedCustomField defines model for UpdatedCustomField.
+type UpdatedCustomField struct {
+	// Embedded struct due to allOf(#/components/schemas/NewCustomField)
+	NewCustomField `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Version int64 `json:"version"`
+}
+
+// UpdatedCustomer defines model for UpdatedCustomer.
+type UpdatedCustomer struct {
+	// Embedded struct due to allOf(#/components/schemas/NewCustomer)
+	NewCustomer `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Version int64 `json:"version"`
+}
+
+// UpdatedIssue defines model for UpdatedIssue.
+type UpdatedIssue struct {
+	// Embedded struct due to allOf(#/components/schemas/NewIssue)
+	NewIssue `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Version int64 `json:"version"`
+}
+
+// UpdatedMilestone defines model for UpdatedMilestone.
+type UpdatedMilestone struct {
+	// Embedded struct due to allOf(#/components/schemas/NewMilestone)
+	NewMilestone `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Version int64 `json:"version"`
+}
+
+// UpdatedProject defines model for UpdatedProject.
+type UpdatedProject struct {
+	// Embedded struct due to allOf(#/components/schemas/NewProject)
+	NewProject `yaml:",inline"`
+	// Embedded fields due to inline allOf schema
+	Version int64 `json:"version"`
+}
+
+// Update SLA policy request, the project of a policy can't be changed.
+type UpdatedSlaPolicy struct {
+	// The percentage of a target which can elapse before an issue is at risk.
+	AtRiskPercent *int `json:"at_risk_percent,omitempty"`
+
+	// The business hours SLA targets are measured in, time outside the hours and on holidays
+	// doesn't count. A calendar without hours is open all the time.
+	Calendar *SlaCalendar `json:"calendar,omitempty"`
+
+	// The name of the policy.
+	Name string `json:"name"`
+
+	// The targets by severity, issues with other severities aren't tracked.
+	Targets []SlaTarget `json:"targets"`
+}
+
+// Update taxonomy request.
+type UpdatedTaxonomy struct {
+	// The allowed categories.
+	Categories *[]string `json:"categories,omitempty"`
+
+	// The allowed severities, ordered from most to least severe.
+	Severities *[]string `json:"severities,omitempty"`
+}
+
+// User response.
+type User struct {
+	// The timestamp the User was created.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Email of the User.
+	Email string `json:"email"`
+
+	// User identifier.
+	Id string `json:"id"`
+
+	// The login of the User, used in @mentions.
+	Login string `json:"login"`
+
+	// Name of the User.
+	Name string `json:"name"`
+
+	// The subject of the access token of the User.
+	Subject *string `json:"subject,omitempty"`
+
+	// The timestamp the User was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// User page response.
+type UsersPage struct {
+	Users []User `json:"users"`
+}
+
+// Issue watcher response.
+type Watcher struct {
+	// The timestamp the user started watching.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Why the user is watching, one of manual, reporter, assignee, commenter or mentioned.
+	Reason WatcherReason `json:"reason"`
+
+	// Identifier of the user.
+	UserId string `json:"user_id"`
+}
+
+// Why the user is watching, one of manual, reporter, assignee, commenter or mentioned.
+type WatcherReason string
+
+// Issue watchers page response.
+type WatchersPage struct {
+	Watchers []Watcher `json:"watchers"`
+}
+
+// FilterIssues defines model for filterIssues.
+type FilterIssues []string
+
+// IssueColumns defines model for issueColumns.
+type IssueColumns string
+
+// Limit defines model for limit.
+type Limit int64
+
+// Offset defines model for offset.
+type Offset int64
+
+// Q defines model for q.
+type Q string
+
+// SortIssues defines model for sortIssues.
+type SortIssues string
+
+// CustomersParams defines parameters for Customers.
+type CustomersParams struct {
+	// Used to query by name in a list operation.
+	Q *Q `json:"q,omitempty"`
+
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// NewCustomerJSONBody defines parameters for NewCustomer.
+type NewCustomerJSONBody NewCustomer
+
+// UpdateCustomerJSONBody defines parameters for UpdateCustomer.
+type UpdateCustomerJSONBody UpdatedCustomer
+
+// UpdateCustomerTaxonomyJSONBody defines parameters for UpdateCustomerTaxonomy.
+type UpdateCustomerTaxonomyJSONBody UpdatedTaxonomy
+
+// StartExportJSONBody defines parameters for StartExport.
+type StartExportJSONBody NewExport
+
+// JobsParams defines parameters for Jobs.
+type JobsParams struct {
+	// Only return jobs of this type.
+	Type *string `json:"type,omitempty"`
+
+	// Only return jobs with this status.
+	Status *JobsParamsStatus `json:"status,omitempty"`
+
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// JobsParamsStatus defines parameters for Jobs.
+type JobsParamsStatus string
+
+// MentionsParams defines parameters for Mentions.
+type MentionsParams struct {
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// UpdateNotificationPreferencesJSONBody defines parameters for UpdateNotificationPreferences.
+type UpdateNotificationPreferencesJSONBody NotificationPreferences
+
+// NotificationsParams defines parameters for Notifications.
+type NotificationsParams struct {
+	// Only return notifications which haven't been read.
+	Unread *bool `json:"unread,omitempty"`
+
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// WatchedIssuesParams defines parameters for WatchedIssues.
+type WatchedIssuesParams struct {
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// ProjectsParams defines parameters for Projects.
+type ProjectsParams struct {
+	// Used to query by name in a list operation.
+	Q *Q `json:"q,omitempty"`
+
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// NewProjectJSONBody defines parameters for NewProject.
+type NewProjectJSONBody NewProject
+
+// UpdateProjectJSONBody defines parameters for UpdateProject.
+type UpdateProjectJSONBody UpdatedProject
+
+// UpdateProjectTaxonomyJSONBody defines parameters for UpdateProjectTaxonomy.
+type UpdateProjectTaxonomyJSONBody UpdatedTaxonomy
+
+// NewCustomFieldJSONBody defines parameters for NewCustomField.
+type NewCustomFieldJSONBody NewCustomField
+
+// UpdateCustomFieldJSONBody defines parameters for UpdateCustomField.
+type UpdateCustomFieldJSONBody UpdatedCustomField
+
+// IssuesParams defines parameters for Issues.
+type IssuesParams struct {
+	// Used to query by name in a list operation.
+	Q *Q `json:"q,omitempty"`
+
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+
+	// Used to order issues in a list operation, one of created_at, updated_at, due_at, subject,
+	// severity, votes or cf.name for a custom field, prefix with - to sort descending.
+	// Severity is sorted by rank so -severity lists the most severe issues first, issues
+	// without a due date are last.
+	Sort *SortIssues `json:"sort,omitempty"`
+
+	// Used to filter issues in a list operation, each filter is in the form field:value where
+	// field is one of state, severity, category, label, milestone_id or cf.name for a
+	// custom field.
+	Filter *FilterIssues `json:"filter,omitempty"`
+
+	// Used to list issues which are due before a time.
+	DueBefore *time.Time `json:"due_before,omitempty"`
+
+	// Used to list open issues which are past their due date.
+	Overdue *bool `json:"overdue,omitempty"`
+
+	// Comma separated columns of a csv or ndjson list of issues, any of id, key, project_id,
+	// parent_id, subject, state, severity, category, labels, assignee_id, due_at,
+	// milestone_id, votes, content, created_at, updated_at or cf.name for a custom field.
+	// Defaults to key, subject, state, severity, category, labels, assignee_id, created_at
+	// and updated_at.
+	Columns *IssueColumns `json:"columns,omitempty"`
+}
+
+// NewIssueJSONBody defines parameters for NewIssue.
+type NewIssueJSONBody NewIssue
+
+// NewChildIssueJSONBody defines parameters for NewChildIssue.
+type NewChildIssueJSONBody NewIssue
+
+// NewIssueLinkJSONBody defines parameters for NewIssueLink.
+type NewIssueLinkJSONBody NewIssueLink
+
+// MoveIssueJSONBody defines parameters for MoveIssue.
+type MoveIssueJSONBody IssueMove
+
+// TransitionIssueJSONBody defines parameters for TransitionIssue.
+type TransitionIssueJSONBody IssueTransition
+
+// AttachmentsParams defines parameters for Attachments.
+type AttachmentsParams struct {
+	// Only list the attachments of a comment.
+	CommentId *string `json:"comment_id,omitempty"`
+}
+
+// CommentsParams defines parameters for Comments.
+type CommentsParams struct {
+	// Used to query by name in a list operation.
+	Q *Q `json:"q,omitempty"`
+
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// NewCommentJSONBody defines parameters for NewComment.
+type NewCommentJSONBody NewComment
+
+// BulkUpdateIssuesJSONBody defines parameters for BulkUpdateIssues.
+type BulkUpdateIssuesJSONBody IssueBulkUpdate
+
+// MilestonesParams defines parameters for Milestones.
+type MilestonesParams struct {
+	// Used to list only the open or closed milestones.
+	State *MilestonesParamsState `json:"state,omitempty"`
+}
+
+// MilestonesParamsState defines parameters for Milestones.
+type MilestonesParamsState string
+
+// NewMilestoneJSONBody defines parameters for NewMilestone.
+type NewMilestoneJSONBody NewMilestone
+
+// UpdateMilestoneJSONBody defines parameters for UpdateMilestone.
+type UpdateMilestoneJSONBody UpdatedMilestone
+
+// TransitionMilestoneJSONBody defines parameters for TransitionMilestone.
+type TransitionMilestoneJSONBody MilestoneTransition
+
+// GetProjectReportParams defines parameters for GetProjectReport.
+type GetProjectReportParams struct {
+	// The start of the report, defaults to 30 days before to.
+	From *time.Time `json:"from,omitempty"`
+
+	// The end of the report, defaults to the end of the current period.
+	To *time.Time `json:"to,omitempty"`
+
+	// The length of each period in UTC, weeks start on Monday.
+	Interval *GetProjectReportParamsInterval `json:"interval,omitempty"`
+
+	// The ETag of a cached report.
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
+}
+
+// GetProjectReportParamsInterval defines parameters for GetProjectReport.
+type GetProjectReportParamsInterval string
+
+// NewSlaPolicyJSONBody defines parameters for NewSlaPolicy.
+type NewSlaPolicyJSONBody NewSlaPolicy
+
+// UpdateSlaPolicyJSONBody defines parameters for UpdateSlaPolicy.
+type UpdateSlaPolicyJSONBody UpdatedSlaPolicy
+
+// UsersParams defines parameters for Users.
+type UsersParams struct {
+	// Used to query by name in a list operation.
+	Q *Q `json:"q,omitempty"`
+
+	// Used to request the next page in a list operation.
+	Offset *Offset `json:"offset,omitempty"`
+
+	// Used to specify the maximum number of records which are returned in the next page.
+	Limit *Limit `json:"limit,omitempty"`
+}
+
+// NewUserJSONBody defines parameters for NewUser.
+type NewUserJSONBody NewUser
+
+// NewCustomerJSONRequestBody defines body for NewCustomer for application/json ContentType.
+type NewCustomerJSONRequestBody NewCustomerJSONBody
+
+// UpdateCustomerJSONRequestBody defines body for UpdateCustomer for application/json ContentType.
+type UpdateCustomerJSONRequestBody UpdateCustomerJSONBody
+
+// UpdateCustomerTaxonomyJSONRequestBody defines body for UpdateCustomerTaxonomy for application/json ContentType.
+type UpdateCustomerTaxonomyJSONRequestBody UpdateCustomerTaxonomyJSONBody
+
+// StartExportJSONRequestBody defines body for StartExport for application/json ContentType.
+type StartExportJSONRequestBody StartExportJSONBody
+
+// UpdateNotificationPreferencesJSONRequestBody defines body for UpdateNotificationPreferences for application/json ContentType.
+type UpdateNotificationPreferencesJSONRequestBody UpdateNotificationPreferencesJSONBody
+
+// NewProjectJSONRequestBody defines body for NewProject for application/json ContentType.
+type NewProjectJSONRequestBody NewProjectJSONBody
+
+// UpdateProjectJSONRequestBody defines body for UpdateProject for application/json ContentType.
+type UpdateProjectJSONRequestBody UpdateProjectJSONBody
+
+// UpdateProjectTaxonomyJSONRequestBody defines body for UpdateProjectTaxonomy for application/json ContentType.
+type UpdateProjectTaxonomyJSONRequestBody UpdateProjectTaxonomyJSONBody
+
+// NewCustomFieldJSONRequestBody defines body for NewCustomField for application/json ContentType.
+type NewCustomFieldJSONRequestBody NewCustomFieldJSONBody
+
+// UpdateCustomFieldJSONRequestBody defines body for UpdateCustomField for application/json ContentType.
+type UpdateCustomFieldJSONRequestBody UpdateCustomFieldJSONBody
+
+// NewIssueJSONRequestBody defines body for NewIssue for application/json ContentType.
+type NewIssueJSONRequestBody NewIssueJSONBody
+
+// NewChildIssueJSONRequestBody defines body for NewChildIssue for application/json ContentType.
+type NewChildIssueJSONRequestBody NewChildIssueJSONBody
+
+// NewIssueLinkJSONRequestBody defines body for NewIssueLink for application/json ContentType.
+type NewIssueLinkJSONRequestBody NewIssueLinkJSONBody
+
+// MoveIssueJSONRequestBody defines body for MoveIssue for application/json ContentType.
+type MoveIssueJSONRequestBody MoveIssueJSONBody
+
+// TransitionIssueJSONRequestBody defines body for TransitionIssue for application/json ContentType.
+type TransitionIssueJSONRequestBody TransitionIssueJSONBody
+
+// NewCommentJSONRequestBody defines body for NewComment for application/json ContentType.
+type NewCommentJSONRequestBody NewCommentJSONBody
+
+// BulkUpdateIssuesJSONRequestBody defines body for BulkUpdateIssues for application/json ContentType.
+type BulkUpdateIssuesJSONRequestBody BulkUpdateIssuesJSONBody
+
+// NewMilestoneJSONRequestBody defines body for NewMilestone for application/json ContentType.
+type NewMilestoneJSONRequestBody NewMilestoneJSONBody
+
+// UpdateMilestoneJSONRequestBody defines body for UpdateMilestone for application/json ContentType.
+type UpdateMilestoneJSONRequestBody UpdateMilestoneJSONBody
+
+// TransitionMilestoneJSONRequestBody defines body for TransitionMilestone for application/json ContentType.
+type TransitionMilestoneJSONRequestBody TransitionMilestoneJSONBody
+
+// NewSlaPolicyJSONRequestBody defines body for NewSlaPolicy for application/json ContentType.
+type NewSlaPolicyJSONRequestBody NewSlaPolicyJSONBody
+
+// UpdateSlaPolicyJSONRequestBody defines body for UpdateSlaPolicy for application/json ContentType.
+type UpdateSlaPolicyJSONRequestBody UpdateSlaPolicyJSONBody
+
+// NewUserJSONRequestBody defines body for NewUser for application/json ContentType.
+type NewUserJSONRequestBody NewUserJSONBody
+
+// Getter for additional properties for ActivityDetails. Returns the specified
+// element and whether it was found
+func (a ActivityDetails) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ActivityDetails
+func (a *ActivityDetails) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ActivityDetails to handle AdditionalProperties
+func (a *ActivityDetails) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ActivityDetails to handle AdditionalProperties
+func (a ActivityDetails) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for CustomFieldValues. Returns the specified
+// element and whether it was found
+func (a CustomFieldValues) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for CustomFieldValues
+func (a *CustomFieldValues) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for CustomFieldValues to handle AdditionalProperties
+func (a *CustomFieldValues) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for CustomFieldValues to handle AdditionalProperties
+func (a CustomFieldValues) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for ImportMapping. Returns the specified
+// element and whether it was found
+func (a ImportMapping) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ImportMapping
+func (a *ImportMapping) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ImportMapping to handle AdditionalProperties
+func (a *ImportMapping) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ImportMapping to handle AdditionalProperties
+func (a ImportMapping) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for NotificationDetails. Returns the specified
+// element and whether it was found
+func (a NotificationDetails) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for NotificationDetails
+func (a *NotificationDetails) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for NotificationDetails to handle AdditionalProperties
+func (a *NotificationDetails) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for NotificationDetails to handle AdditionalProperties
+func (a NotificationDetails) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// Customers request
+	Customers(ctx context.Context, params *CustomersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewCustomer request with any body
+	NewCustomerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewCustomer(ctx context.Context, body NewCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCustomer request
+	GetCustomer(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCustomer request with any body
+	UpdateCustomerWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCustomer(ctx context.Context, id string, body UpdateCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCustomerTaxonomy request
+	GetCustomerTaxonomy(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCustomerTaxonomy request with any body
+	UpdateCustomerTaxonomyWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCustomerTaxonomy(ctx context.Context, id string, body UpdateCustomerTaxonomyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StartExport request with any body
+	StartExportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	StartExport(ctx context.Context, body StartExportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadExport request
+	DownloadExport(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ImportIssues request with any body
+	ImportIssuesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReceiveEmail request with any body
+	ReceiveEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Jobs request
+	Jobs(ctx context.Context, params *JobsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetJob request
+	GetJob(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Mentions request
+	Mentions(ctx context.Context, params *MentionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NotificationPreferences request
+	NotificationPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateNotificationPreferences request with any body
+	UpdateNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateNotificationPreferences(ctx context.Context, body UpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Notifications request
+	Notifications(ctx context.Context, params *NotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReadAllNotifications request
+	ReadAllNotifications(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnreadNotification request
+	UnreadNotification(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ReadNotification request
+	ReadNotification(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WatchedIssues request
+	WatchedIssues(ctx context.Context, params *WatchedIssuesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Projects request
+	Projects(ctx context.Context, params *ProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewProject request with any body
+	NewProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewProject(ctx context.Context, body NewProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProject request
+	GetProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProject request with any body
+	UpdateProjectWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProject(ctx context.Context, id string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectTaxonomy request
+	GetProjectTaxonomy(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateProjectTaxonomy request with any body
+	UpdateProjectTaxonomyWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateProjectTaxonomy(ctx context.Context, id string, body UpdateProjectTaxonomyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CustomFields request
+	CustomFields(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewCustomField request with any body
+	NewCustomFieldWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewCustomField(ctx context.Context, projectId string, body NewCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCustomField request
+	GetCustomField(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCustomField request with any body
+	UpdateCustomFieldWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCustomField(ctx context.Context, projectId string, id string, body UpdateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Issues request
 	Issues(ctx context.Context, projectId string, params *IssuesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	// NewIssue request with any body
-	NewIssueWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// NewIssue request with any body
+	NewIssueWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewIssue(ctx context.Context, projectId string, body NewIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetIssue request
+	GetIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateIssue request
+	UpdateIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IssueActivity request
+	IssueActivity(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IssueChildren request
+	IssueChildren(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewChildIssue request with any body
+	NewChildIssueWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewChildIssue(ctx context.Context, projectId string, id string, body NewChildIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IssueLinks request
+	IssueLinks(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewIssueLink request with any body
+	NewIssueLinkWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewIssueLink(ctx context.Context, projectId string, id string, body NewIssueLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteIssueLink request
+	DeleteIssueLink(ctx context.Context, projectId string, id string, linkId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MoveIssue request with any body
+	MoveIssueWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveIssue(ctx context.Context, projectId string, id string, body MoveIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IssueRevisions request
+	IssueRevisions(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransitionIssue request with any body
+	TransitionIssueWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransitionIssue(ctx context.Context, projectId string, id string, body TransitionIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnvoteIssue request
+	UnvoteIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// VoteIssue request
+	VoteIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UnwatchIssue request
+	UnwatchIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// WatchIssue request
+	WatchIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// IssueWatchers request
+	IssueWatchers(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Attachments request
+	Attachments(ctx context.Context, projectId string, issueId string, params *AttachmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadAttachment request with any body
+	UploadAttachmentWithBody(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAttachment request
+	DeleteAttachment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAttachment request
+	GetAttachment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadAttachment request
+	DownloadAttachment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Comments request
+	Comments(ctx context.Context, projectId string, issueId string, params *CommentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewComment request with any body
+	NewCommentWithBody(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewComment(ctx context.Context, projectId string, issueId string, body NewCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteComment request
+	DeleteComment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetComment request
+	GetComment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateComment request
+	UpdateComment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RemoveReaction request
+	RemoveReaction(ctx context.Context, projectId string, issueId string, id string, reaction string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// AddReaction request
+	AddReaction(ctx context.Context, projectId string, issueId string, id string, reaction string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CommentRevisions request
+	CommentRevisions(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// BulkUpdateIssues request with any body
+	BulkUpdateIssuesWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	BulkUpdateIssues(ctx context.Context, projectId string, body BulkUpdateIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Milestones request
+	Milestones(ctx context.Context, projectId string, params *MilestonesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewMilestone request with any body
+	NewMilestoneWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewMilestone(ctx context.Context, projectId string, body NewMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetMilestone request
+	GetMilestone(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateMilestone request with any body
+	UpdateMilestoneWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateMilestone(ctx context.Context, projectId string, id string, body UpdateMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// TransitionMilestone request with any body
+	TransitionMilestoneWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	TransitionMilestone(ctx context.Context, projectId string, id string, body TransitionMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetProjectReport request
+	GetProjectReport(ctx context.Context, projectId string, params *GetProjectReportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSlaReport request
+	GetSlaReport(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SlaPolicies request
+	SlaPolicies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewSlaPolicy request with any body
+	NewSlaPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewSlaPolicy(ctx context.Context, body NewSlaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteSlaPolicy request
+	DeleteSlaPolicy(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetSlaPolicy request
+	GetSlaPolicy(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateSlaPolicy request with any body
+	UpdateSlaPolicyWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateSlaPolicy(ctx context.Context, id string, body UpdateSlaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Users request
+	Users(ctx context.Context, params *UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// NewUser request with any body
+	NewUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	NewUser(ctx context.Context, body NewUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUser request
+	GetUser(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) Customers(ctx context.Context, params *CustomersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewCustomerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewCustomerRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewCustomer(ctx context.Context, body NewCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewCustomerRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCustomer(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCustomerRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCustomerWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCustomerRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCustomer(ctx context.Context, id string, body UpdateCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCustomerRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCustomerTaxonomy(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCustomerTaxonomyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCustomerTaxonomyWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCustomerTaxonomyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCustomerTaxonomy(ctx context.Context, id string, body UpdateCustomerTaxonomyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCustomerTaxonomyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartExportWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartExportRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) StartExport(ctx context.Context, body StartExportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStartExportRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DownloadExport(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadExportRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ImportIssuesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportIssuesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReceiveEmailWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReceiveEmailRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Jobs(ctx context.Context, params *JobsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewJobsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetJob(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetJobRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Mentions(ctx context.Context, params *MentionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMentionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NotificationPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationPreferencesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateNotificationPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNotificationPreferencesRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateNotificationPreferences(ctx context.Context, body UpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateNotificationPreferencesRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Notifications(ctx context.Context, params *NotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNotificationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReadAllNotifications(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadAllNotificationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnreadNotification(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnreadNotificationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ReadNotification(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewReadNotificationRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WatchedIssues(ctx context.Context, params *WatchedIssuesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWatchedIssuesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Projects(ctx context.Context, params *ProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewProjectsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewProjectRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewProject(ctx context.Context, body NewProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewProjectRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProjectWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProject(ctx context.Context, id string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectTaxonomy(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectTaxonomyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProjectTaxonomyWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectTaxonomyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateProjectTaxonomy(ctx context.Context, id string, body UpdateProjectTaxonomyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateProjectTaxonomyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomFields(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomFieldsRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewCustomFieldWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewCustomFieldRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewCustomField(ctx context.Context, projectId string, body NewCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewCustomFieldRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCustomField(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCustomFieldRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCustomFieldWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCustomFieldRequestWithBody(c.Server, projectId, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCustomField(ctx context.Context, projectId string, id string, body UpdateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCustomFieldRequest(c.Server, projectId, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Issues(ctx context.Context, projectId string, params *IssuesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssuesRequest(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewIssueWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewIssueRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewIssue(ctx context.Context, projectId string, body NewIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewIssueRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetIssueRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateIssueRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueActivity(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueActivityRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueChildren(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueChildrenRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewChildIssueWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewChildIssueRequestWithBody(c.Server, projectId, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewChildIssue(ctx context.Context, projectId string, id string, body NewChildIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewChildIssueRequest(c.Server, projectId, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueLinks(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueLinksRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewIssueLinkWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewIssueLinkRequestWithBody(c.Server, projectId, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewIssueLink(ctx context.Context, projectId string, id string, body NewIssueLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewIssueLinkRequest(c.Server, projectId, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteIssueLink(ctx context.Context, projectId string, id string, linkId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteIssueLinkRequest(c.Server, projectId, id, linkId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveIssueWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveIssueRequestWithBody(c.Server, projectId, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveIssue(ctx context.Context, projectId string, id string, body MoveIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveIssueRequest(c.Server, projectId, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueRevisions(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueRevisionsRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransitionIssueWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransitionIssueRequestWithBody(c.Server, projectId, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransitionIssue(ctx context.Context, projectId string, id string, body TransitionIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransitionIssueRequest(c.Server, projectId, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnvoteIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnvoteIssueRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) VoteIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewVoteIssueRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UnwatchIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUnwatchIssueRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) WatchIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewWatchIssueRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) IssueWatchers(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewIssueWatchersRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Attachments(ctx context.Context, projectId string, issueId string, params *AttachmentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAttachmentsRequest(c.Server, projectId, issueId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UploadAttachmentWithBody(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadAttachmentRequestWithBody(c.Server, projectId, issueId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAttachment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAttachmentRequest(c.Server, projectId, issueId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAttachment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAttachmentRequest(c.Server, projectId, issueId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DownloadAttachment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadAttachmentRequest(c.Server, projectId, issueId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Comments(ctx context.Context, projectId string, issueId string, params *CommentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommentsRequest(c.Server, projectId, issueId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewCommentWithBody(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewCommentRequestWithBody(c.Server, projectId, issueId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewComment(ctx context.Context, projectId string, issueId string, body NewCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewCommentRequest(c.Server, projectId, issueId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteComment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCommentRequest(c.Server, projectId, issueId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetComment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCommentRequest(c.Server, projectId, issueId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateComment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCommentRequest(c.Server, projectId, issueId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RemoveReaction(ctx context.Context, projectId string, issueId string, id string, reaction string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRemoveReactionRequest(c.Server, projectId, issueId, id, reaction)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) AddReaction(ctx context.Context, projectId string, issueId string, id string, reaction string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAddReactionRequest(c.Server, projectId, issueId, id, reaction)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CommentRevisions(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCommentRevisionsRequest(c.Server, projectId, issueId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkUpdateIssuesWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkUpdateIssuesRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) BulkUpdateIssues(ctx context.Context, projectId string, body BulkUpdateIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewBulkUpdateIssuesRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Milestones(ctx context.Context, projectId string, params *MilestonesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMilestonesRequest(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewMilestoneWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewMilestoneRequestWithBody(c.Server, projectId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewMilestone(ctx context.Context, projectId string, body NewMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewMilestoneRequest(c.Server, projectId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetMilestone(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMilestoneRequest(c.Server, projectId, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMilestoneWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMilestoneRequestWithBody(c.Server, projectId, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateMilestone(ctx context.Context, projectId string, id string, body UpdateMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMilestoneRequest(c.Server, projectId, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransitionMilestoneWithBody(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransitionMilestoneRequestWithBody(c.Server, projectId, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) TransitionMilestone(ctx context.Context, projectId string, id string, body TransitionMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTransitionMilestoneRequest(c.Server, projectId, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetProjectReport(ctx context.Context, projectId string, params *GetProjectReportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetProjectReportRequest(c.Server, projectId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSlaReport(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSlaReportRequest(c.Server, projectId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) SlaPolicies(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSlaPoliciesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewSlaPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewSlaPolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewSlaPolicy(ctx context.Context, body NewSlaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewSlaPolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteSlaPolicy(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteSlaPolicyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetSlaPolicy(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetSlaPolicyRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSlaPolicyWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSlaPolicyRequestWithBody(c.Server, id, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateSlaPolicy(ctx context.Context, id string, body UpdateSlaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateSlaPolicyRequest(c.Server, id, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Users(ctx context.Context, params *UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUsersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewUserWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewUserRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) NewUser(ctx context.Context, body NewUserJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewNewUserRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUser(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewCustomersRequest generates requests for Customers
+func NewCustomersRequest(server string, params *CustomersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/customers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Q != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewCustomerRequest calls the generic NewCustomer builder with application/json body
+func NewNewCustomerRequest(server string, body NewCustomerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewCustomerRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNewCustomerRequestWithBody generates requests for NewCustomer with any type of body
+func NewNewCustomerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/customers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCustomerRequest generates requests for GetCustomer
+func NewGetCustomerRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/customers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCustomerRequest calls the generic UpdateCustomer builder with application/json body
+func NewUpdateCustomerRequest(server string, id string, body UpdateCustomerJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCustomerRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateCustomerRequestWithBody generates requests for UpdateCustomer with any type of body
+func NewUpdateCustomerRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/customers/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCustomerTaxonomyRequest generates requests for GetCustomerTaxonomy
+func NewGetCustomerTaxonomyRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/customers/%s/taxonomy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCustomerTaxonomyRequest calls the generic UpdateCustomerTaxonomy builder with application/json body
+func NewUpdateCustomerTaxonomyRequest(server string, id string, body UpdateCustomerTaxonomyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCustomerTaxonomyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateCustomerTaxonomyRequestWithBody generates requests for UpdateCustomerTaxonomy with any type of body
+func NewUpdateCustomerTaxonomyRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/customers/%s/taxonomy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewStartExportRequest calls the generic StartExport builder with application/json body
+func NewStartExportRequest(server string, body StartExportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewStartExportRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewStartExportRequestWithBody generates requests for StartExport with any type of body
+func NewStartExportRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/exports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDownloadExportRequest generates requests for DownloadExport
+func NewDownloadExportRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/exports/%s/archive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportIssuesRequestWithBody generates requests for ImportIssues with any type of body
+func NewImportIssuesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/imports")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewReceiveEmailRequestWithBody generates requests for ReceiveEmail with any type of body
+func NewReceiveEmailRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/inbound/email")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewJobsRequest generates requests for Jobs
+func NewJobsRequest(server string, params *JobsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Type != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Status != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "status", runtime.ParamLocationQuery, *params.Status); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetJobRequest generates requests for GetJob
+func NewGetJobRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/jobs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMentionsRequest generates requests for Mentions
+func NewMentionsRequest(server string, params *MentionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/mentions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNotificationPreferencesRequest generates requests for NotificationPreferences
+func NewNotificationPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/notification-preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateNotificationPreferencesRequest calls the generic UpdateNotificationPreferences builder with application/json body
+func NewUpdateNotificationPreferencesRequest(server string, body UpdateNotificationPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateNotificationPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateNotificationPreferencesRequestWithBody generates requests for UpdateNotificationPreferences with any type of body
+func NewUpdateNotificationPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/notification-preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewNotificationsRequest generates requests for Notifications
+func NewNotificationsRequest(server string, params *NotificationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/notifications")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Unread != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread", runtime.ParamLocationQuery, *params.Unread); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadAllNotificationsRequest generates requests for ReadAllNotifications
+func NewReadAllNotificationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/notifications/read")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnreadNotificationRequest generates requests for UnreadNotification
+func NewUnreadNotificationRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewReadNotificationRequest generates requests for ReadNotification
+func NewReadNotificationRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWatchedIssuesRequest generates requests for WatchedIssues
+func NewWatchedIssuesRequest(server string, params *WatchedIssuesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/me/watching")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewProjectsRequest generates requests for Projects
+func NewProjectsRequest(server string, params *ProjectsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Q != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewProjectRequest calls the generic NewProject builder with application/json body
+func NewNewProjectRequest(server string, body NewProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewProjectRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNewProjectRequestWithBody generates requests for NewProject with any type of body
+func NewNewProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProjectRequest generates requests for GetProject
+func NewGetProjectRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectRequest calls the generic UpdateProject builder with application/json body
+func NewUpdateProjectRequest(server string, id string, body UpdateProjectJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateProjectRequestWithBody generates requests for UpdateProject with any type of body
+func NewUpdateProjectRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProjectTaxonomyRequest generates requests for GetProjectTaxonomy
+func NewGetProjectTaxonomyRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/taxonomy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateProjectTaxonomyRequest calls the generic UpdateProjectTaxonomy builder with application/json body
+func NewUpdateProjectTaxonomyRequest(server string, id string, body UpdateProjectTaxonomyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateProjectTaxonomyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateProjectTaxonomyRequestWithBody generates requests for UpdateProjectTaxonomy with any type of body
+func NewUpdateProjectTaxonomyRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/taxonomy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCustomFieldsRequest generates requests for CustomFields
+func NewCustomFieldsRequest(server string, projectId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/fields", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewCustomFieldRequest calls the generic NewCustomField builder with application/json body
+func NewNewCustomFieldRequest(server string, projectId string, body NewCustomFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewCustomFieldRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewNewCustomFieldRequestWithBody generates requests for NewCustomField with any type of body
+func NewNewCustomFieldRequestWithBody(server string, projectId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/fields", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetCustomFieldRequest generates requests for GetCustomField
+func NewGetCustomFieldRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/fields/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCustomFieldRequest calls the generic UpdateCustomField builder with application/json body
+func NewUpdateCustomFieldRequest(server string, projectId string, id string, body UpdateCustomFieldJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCustomFieldRequestWithBody(server, projectId, id, "application/json", bodyReader)
+}
+
+// NewUpdateCustomFieldRequestWithBody generates requests for UpdateCustomField with any type of body
+func NewUpdateCustomFieldRequestWithBody(server string, projectId string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/fields/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewIssuesRequest generates requests for Issues
+func NewIssuesRequest(server string, projectId string, params *IssuesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Q != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Sort != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sort", runtime.ParamLocationQuery, *params.Sort); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Filter != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("pipeDelimited", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.DueBefore != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "due_before", runtime.ParamLocationQuery, *params.DueBefore); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Overdue != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "overdue", runtime.ParamLocationQuery, *params.Overdue); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Columns != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "columns", runtime.ParamLocationQuery, *params.Columns); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewIssueRequest calls the generic NewIssue builder with application/json body
+func NewNewIssueRequest(server string, projectId string, body NewIssueJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewIssueRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewNewIssueRequestWithBody generates requests for NewIssue with any type of body
+func NewNewIssueRequestWithBody(server string, projectId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetIssueRequest generates requests for GetIssue
+func NewGetIssueRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateIssueRequest generates requests for UpdateIssue
+func NewUpdateIssueRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewIssueActivityRequest generates requests for IssueActivity
+func NewIssueActivityRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/activity", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewIssueChildrenRequest generates requests for IssueChildren
+func NewIssueChildrenRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/children", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewChildIssueRequest calls the generic NewChildIssue builder with application/json body
+func NewNewChildIssueRequest(server string, projectId string, id string, body NewChildIssueJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewChildIssueRequestWithBody(server, projectId, id, "application/json", bodyReader)
+}
+
+// NewNewChildIssueRequestWithBody generates requests for NewChildIssue with any type of body
+func NewNewChildIssueRequestWithBody(server string, projectId string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/children", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewIssueLinksRequest generates requests for IssueLinks
+func NewIssueLinksRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/links", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewIssueLinkRequest calls the generic NewIssueLink builder with application/json body
+func NewNewIssueLinkRequest(server string, projectId string, id string, body NewIssueLinkJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewIssueLinkRequestWithBody(server, projectId, id, "application/json", bodyReader)
+}
+
+// NewNewIssueLinkRequestWithBody generates requests for NewIssueLink with any type of body
+func NewNewIssueLinkRequestWithBody(server string, projectId string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/links", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteIssueLinkRequest generates requests for DeleteIssueLink
+func NewDeleteIssueLinkRequest(server string, projectId string, id string, linkId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "link_id", runtime.ParamLocationPath, linkId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/links/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMoveIssueRequest calls the generic MoveIssue builder with application/json body
+func NewMoveIssueRequest(server string, projectId string, id string, body MoveIssueJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveIssueRequestWithBody(server, projectId, id, "application/json", bodyReader)
+}
+
+// NewMoveIssueRequestWithBody generates requests for MoveIssue with any type of body
+func NewMoveIssueRequestWithBody(server string, projectId string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/move", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewIssueRevisionsRequest generates requests for IssueRevisions
+func NewIssueRevisionsRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/revisions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTransitionIssueRequest calls the generic TransitionIssue builder with application/json body
+func NewTransitionIssueRequest(server string, projectId string, id string, body TransitionIssueJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTransitionIssueRequestWithBody(server, projectId, id, "application/json", bodyReader)
+}
+
+// NewTransitionIssueRequestWithBody generates requests for TransitionIssue with any type of body
+func NewTransitionIssueRequestWithBody(server string, projectId string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/state", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUnvoteIssueRequest generates requests for UnvoteIssue
+func NewUnvoteIssueRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/vote", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewVoteIssueRequest generates requests for VoteIssue
+func NewVoteIssueRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/vote", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUnwatchIssueRequest generates requests for UnwatchIssue
+func NewUnwatchIssueRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/watch", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewWatchIssueRequest generates requests for WatchIssue
+func NewWatchIssueRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/watch", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewIssueWatchersRequest generates requests for IssueWatchers
+func NewIssueWatchersRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/watchers", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAttachmentsRequest generates requests for Attachments
+func NewAttachmentsRequest(server string, projectId string, issueId string, params *AttachmentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/attachments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.CommentId != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "comment_id", runtime.ParamLocationQuery, *params.CommentId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUploadAttachmentRequestWithBody generates requests for UploadAttachment with any type of body
+func NewUploadAttachmentRequestWithBody(server string, projectId string, issueId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/attachments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAttachmentRequest generates requests for DeleteAttachment
+func NewDeleteAttachmentRequest(server string, projectId string, issueId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/attachments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAttachmentRequest generates requests for GetAttachment
+func NewGetAttachmentRequest(server string, projectId string, issueId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/attachments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDownloadAttachmentRequest generates requests for DownloadAttachment
+func NewDownloadAttachmentRequest(server string, projectId string, issueId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/attachments/%s/content", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCommentsRequest generates requests for Comments
+func NewCommentsRequest(server string, projectId string, issueId string, params *CommentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Q != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewCommentRequest calls the generic NewComment builder with application/json body
+func NewNewCommentRequest(server string, projectId string, issueId string, body NewCommentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewCommentRequestWithBody(server, projectId, issueId, "application/json", bodyReader)
+}
+
+// NewNewCommentRequestWithBody generates requests for NewComment with any type of body
+func NewNewCommentRequestWithBody(server string, projectId string, issueId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteCommentRequest generates requests for DeleteComment
+func NewDeleteCommentRequest(server string, projectId string, issueId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetCommentRequest generates requests for GetComment
+func NewGetCommentRequest(server string, projectId string, issueId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateCommentRequest generates requests for UpdateComment
+func NewUpdateCommentRequest(server string, projectId string, issueId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRemoveReactionRequest generates requests for RemoveReaction
+func NewRemoveReactionRequest(server string, projectId string, issueId string, id string, reaction string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "reaction", runtime.ParamLocationPath, reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments/%s/reactions/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAddReactionRequest generates requests for AddReaction
+func NewAddReactionRequest(server string, projectId string, issueId string, id string, reaction string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam3 string
+
+	pathParam3, err = runtime.StyleParamWithLocation("simple", false, "reaction", runtime.ParamLocationPath, reaction)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments/%s/reactions/%s", pathParam0, pathParam1, pathParam2, pathParam3)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCommentRevisionsRequest generates requests for CommentRevisions
+func NewCommentRevisionsRequest(server string, projectId string, issueId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments/%s/revisions", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewBulkUpdateIssuesRequest calls the generic BulkUpdateIssues builder with application/json body
+func NewBulkUpdateIssuesRequest(server string, projectId string, body BulkUpdateIssuesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewBulkUpdateIssuesRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewBulkUpdateIssuesRequestWithBody generates requests for BulkUpdateIssues with any type of body
+func NewBulkUpdateIssuesRequestWithBody(server string, projectId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/issues:bulk", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewMilestonesRequest generates requests for Milestones
+func NewMilestonesRequest(server string, projectId string, params *MilestonesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/milestones", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.State != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewMilestoneRequest calls the generic NewMilestone builder with application/json body
+func NewNewMilestoneRequest(server string, projectId string, body NewMilestoneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewMilestoneRequestWithBody(server, projectId, "application/json", bodyReader)
+}
+
+// NewNewMilestoneRequestWithBody generates requests for NewMilestone with any type of body
+func NewNewMilestoneRequestWithBody(server string, projectId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/milestones", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMilestoneRequest generates requests for GetMilestone
+func NewGetMilestoneRequest(server string, projectId string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/milestones/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMilestoneRequest calls the generic UpdateMilestone builder with application/json body
+func NewUpdateMilestoneRequest(server string, projectId string, id string, body UpdateMilestoneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMilestoneRequestWithBody(server, projectId, id, "application/json", bodyReader)
+}
+
+// NewUpdateMilestoneRequestWithBody generates requests for UpdateMilestone with any type of body
+func NewUpdateMilestoneRequestWithBody(server string, projectId string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/milestones/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTransitionMilestoneRequest calls the generic TransitionMilestone builder with application/json body
+func NewTransitionMilestoneRequest(server string, projectId string, id string, body TransitionMilestoneJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTransitionMilestoneRequestWithBody(server, projectId, id, "application/json", bodyReader)
+}
+
+// NewTransitionMilestoneRequestWithBody generates requests for TransitionMilestone with any type of body
+func NewTransitionMilestoneRequestWithBody(server string, projectId string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/milestones/%s/state", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetProjectReportRequest generates requests for GetProjectReport
+func NewGetProjectReportRequest(server string, projectId string, params *GetProjectReportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/reports", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.From != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.To != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Interval != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "interval", runtime.ParamLocationQuery, *params.Interval); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.IfNoneMatch != nil {
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("If-None-Match", headerParam0)
+	}
+
+	return req, nil
+}
+
+// NewGetSlaReportRequest generates requests for GetSlaReport
+func NewGetSlaReportRequest(server string, projectId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/projects/%s/reports/sla", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSlaPoliciesRequest generates requests for SlaPolicies
+func NewSlaPoliciesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sla-policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewSlaPolicyRequest calls the generic NewSlaPolicy builder with application/json body
+func NewNewSlaPolicyRequest(server string, body NewSlaPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewSlaPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNewSlaPolicyRequestWithBody generates requests for NewSlaPolicy with any type of body
+func NewNewSlaPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sla-policies")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteSlaPolicyRequest generates requests for DeleteSlaPolicy
+func NewDeleteSlaPolicyRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sla-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetSlaPolicyRequest generates requests for GetSlaPolicy
+func NewGetSlaPolicyRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sla-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateSlaPolicyRequest calls the generic UpdateSlaPolicy builder with application/json body
+func NewUpdateSlaPolicyRequest(server string, id string, body UpdateSlaPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateSlaPolicyRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateSlaPolicyRequestWithBody generates requests for UpdateSlaPolicy with any type of body
+func NewUpdateSlaPolicyRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/sla-policies/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUsersRequest generates requests for Users
+func NewUsersRequest(server string, params *UsersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queryValues := queryURL.Query()
+
+	if params.Q != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Offset != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	if params.Limit != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewNewUserRequest calls the generic NewUser builder with application/json body
+func NewNewUserRequest(server string, body NewUserJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewNewUserRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewNewUserRequestWithBody generates requests for NewUser with any type of body
+func NewNewUserRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserRequest generates requests for GetUser
+func NewGetUserRequest(server string, id string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// Customers request
+	CustomersWithResponse(ctx context.Context, params *CustomersParams, reqEditors ...RequestEditorFn) (*CustomersResponse, error)
+
+	// NewCustomer request with any body
+	NewCustomerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCustomerResponse, error)
+
+	NewCustomerWithResponse(ctx context.Context, body NewCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCustomerResponse, error)
+
+	// GetCustomer request
+	GetCustomerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetCustomerResponse, error)
+
+	// UpdateCustomer request with any body
+	UpdateCustomerWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomerResponse, error)
+
+	UpdateCustomerWithResponse(ctx context.Context, id string, body UpdateCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomerResponse, error)
+
+	// GetCustomerTaxonomy request
+	GetCustomerTaxonomyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetCustomerTaxonomyResponse, error)
+
+	// UpdateCustomerTaxonomy request with any body
+	UpdateCustomerTaxonomyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomerTaxonomyResponse, error)
+
+	UpdateCustomerTaxonomyWithResponse(ctx context.Context, id string, body UpdateCustomerTaxonomyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomerTaxonomyResponse, error)
+
+	// StartExport request with any body
+	StartExportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartExportResponse, error)
+
+	StartExportWithResponse(ctx context.Context, body StartExportJSONRequestBody, reqEditors ...RequestEditorFn) (*StartExportResponse, error)
+
+	// DownloadExport request
+	DownloadExportWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DownloadExportResponse, error)
+
+	// ImportIssues request with any body
+	ImportIssuesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportIssuesResponse, error)
+
+	// ReceiveEmail request with any body
+	ReceiveEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReceiveEmailResponse, error)
+
+	// Jobs request
+	JobsWithResponse(ctx context.Context, params *JobsParams, reqEditors ...RequestEditorFn) (*JobsResponse, error)
+
+	// GetJob request
+	GetJobWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetJobResponse, error)
+
+	// Mentions request
+	MentionsWithResponse(ctx context.Context, params *MentionsParams, reqEditors ...RequestEditorFn) (*MentionsResponse, error)
+
+	// NotificationPreferences request
+	NotificationPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationPreferencesResponse, error)
+
+	// UpdateNotificationPreferences request with any body
+	UpdateNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNotificationPreferencesResponse, error)
+
+	UpdateNotificationPreferencesWithResponse(ctx context.Context, body UpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNotificationPreferencesResponse, error)
+
+	// Notifications request
+	NotificationsWithResponse(ctx context.Context, params *NotificationsParams, reqEditors ...RequestEditorFn) (*NotificationsResponse, error)
+
+	// ReadAllNotifications request
+	ReadAllNotificationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadAllNotificationsResponse, error)
+
+	// UnreadNotification request
+	UnreadNotificationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UnreadNotificationResponse, error)
+
+	// ReadNotification request
+	ReadNotificationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ReadNotificationResponse, error)
+
+	// WatchedIssues request
+	WatchedIssuesWithResponse(ctx context.Context, params *WatchedIssuesParams, reqEditors ...RequestEditorFn) (*WatchedIssuesResponse, error)
+
+	// Projects request
+	ProjectsWithResponse(ctx context.Context, params *ProjectsParams, reqEditors ...RequestEditorFn) (*ProjectsResponse, error)
+
+	// NewProject request with any body
+	NewProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewProjectResponse, error)
+
+	NewProjectWithResponse(ctx context.Context, body NewProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*NewProjectResponse, error)
+
+	// GetProject request
+	GetProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+
+	// UpdateProject request with any body
+	UpdateProjectWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+
+	UpdateProjectWithResponse(ctx context.Context, id string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+
+	// GetProjectTaxonomy request
+	GetProjectTaxonomyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetProjectTaxonomyResponse, error)
+
+	// UpdateProjectTaxonomy request with any body
+	UpdateProjectTaxonomyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectTaxonomyResponse, error)
+
+	UpdateProjectTaxonomyWithResponse(ctx context.Context, id string, body UpdateProjectTaxonomyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectTaxonomyResponse, error)
+
+	// CustomFields request
+	CustomFieldsWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*CustomFieldsResponse, error)
+
+	// NewCustomField request with any body
+	NewCustomFieldWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCustomFieldResponse, error)
+
+	NewCustomFieldWithResponse(ctx context.Context, projectId string, body NewCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCustomFieldResponse, error)
+
+	// GetCustomField request
+	GetCustomFieldWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*GetCustomFieldResponse, error)
+
+	// UpdateCustomField request with any body
+	UpdateCustomFieldWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomFieldResponse, error)
+
+	UpdateCustomFieldWithResponse(ctx context.Context, projectId string, id string, body UpdateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomFieldResponse, error)
+
+	// Issues request
+	IssuesWithResponse(ctx context.Context, projectId string, params *IssuesParams, reqEditors ...RequestEditorFn) (*IssuesResponse, error)
+
+	// NewIssue request with any body
+	NewIssueWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewIssueResponse, error)
+
+	NewIssueWithResponse(ctx context.Context, projectId string, body NewIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*NewIssueResponse, error)
+
+	// GetIssue request
+	GetIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*GetIssueResponse, error)
+
+	// UpdateIssue request
+	UpdateIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*UpdateIssueResponse, error)
+
+	// IssueActivity request
+	IssueActivityWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueActivityResponse, error)
+
+	// IssueChildren request
+	IssueChildrenWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueChildrenResponse, error)
+
+	// NewChildIssue request with any body
+	NewChildIssueWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewChildIssueResponse, error)
+
+	NewChildIssueWithResponse(ctx context.Context, projectId string, id string, body NewChildIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*NewChildIssueResponse, error)
+
+	// IssueLinks request
+	IssueLinksWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueLinksResponse, error)
+
+	// NewIssueLink request with any body
+	NewIssueLinkWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewIssueLinkResponse, error)
+
+	NewIssueLinkWithResponse(ctx context.Context, projectId string, id string, body NewIssueLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*NewIssueLinkResponse, error)
+
+	// DeleteIssueLink request
+	DeleteIssueLinkWithResponse(ctx context.Context, projectId string, id string, linkId string, reqEditors ...RequestEditorFn) (*DeleteIssueLinkResponse, error)
+
+	// MoveIssue request with any body
+	MoveIssueWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveIssueResponse, error)
+
+	MoveIssueWithResponse(ctx context.Context, projectId string, id string, body MoveIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveIssueResponse, error)
+
+	// IssueRevisions request
+	IssueRevisionsWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueRevisionsResponse, error)
+
+	// TransitionIssue request with any body
+	TransitionIssueWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransitionIssueResponse, error)
+
+	TransitionIssueWithResponse(ctx context.Context, projectId string, id string, body TransitionIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*TransitionIssueResponse, error)
+
+	// UnvoteIssue request
+	UnvoteIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*UnvoteIssueResponse, error)
+
+	// VoteIssue request
+	VoteIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*VoteIssueResponse, error)
+
+	// UnwatchIssue request
+	UnwatchIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*UnwatchIssueResponse, error)
+
+	// WatchIssue request
+	WatchIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*WatchIssueResponse, error)
+
+	// IssueWatchers request
+	IssueWatchersWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueWatchersResponse, error)
+
+	// Attachments request
+	AttachmentsWithResponse(ctx context.Context, projectId string, issueId string, params *AttachmentsParams, reqEditors ...RequestEditorFn) (*AttachmentsResponse, error)
+
+	// UploadAttachment request with any body
+	UploadAttachmentWithBodyWithResponse(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAttachmentResponse, error)
+
+	// DeleteAttachment request
+	DeleteAttachmentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*DeleteAttachmentResponse, error)
+
+	// GetAttachment request
+	GetAttachmentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*GetAttachmentResponse, error)
+
+	// DownloadAttachment request
+	DownloadAttachmentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*DownloadAttachmentResponse, error)
+
+	// Comments request
+	CommentsWithResponse(ctx context.Context, projectId string, issueId string, params *CommentsParams, reqEditors ...RequestEditorFn) (*CommentsResponse, error)
+
+	// NewComment request with any body
+	NewCommentWithBodyWithResponse(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCommentResponse, error)
+
+	NewCommentWithResponse(ctx context.Context, projectId string, issueId string, body NewCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCommentResponse, error)
+
+	// DeleteComment request
+	DeleteCommentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*DeleteCommentResponse, error)
+
+	// GetComment request
+	GetCommentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*GetCommentResponse, error)
+
+	// UpdateComment request
+	UpdateCommentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*UpdateCommentResponse, error)
+
+	// RemoveReaction request
+	RemoveReactionWithResponse(ctx context.Context, projectId string, issueId string, id string, reaction string, reqEditors ...RequestEditorFn) (*RemoveReactionResponse, error)
+
+	// AddReaction request
+	AddReactionWithResponse(ctx context.Context, projectId string, issueId string, id string, reaction string, reqEditors ...RequestEditorFn) (*AddReactionResponse, error)
+
+	// CommentRevisions request
+	CommentRevisionsWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*CommentRevisionsResponse, error)
+
+	// BulkUpdateIssues request with any body
+	BulkUpdateIssuesWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkUpdateIssuesResponse, error)
+
+	BulkUpdateIssuesWithResponse(ctx context.Context, projectId string, body BulkUpdateIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkUpdateIssuesResponse, error)
+
+	// Milestones request
+	MilestonesWithResponse(ctx context.Context, projectId string, params *MilestonesParams, reqEditors ...RequestEditorFn) (*MilestonesResponse, error)
+
+	// NewMilestone request with any body
+	NewMilestoneWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewMilestoneResponse, error)
+
+	NewMilestoneWithResponse(ctx context.Context, projectId string, body NewMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*NewMilestoneResponse, error)
+
+	// GetMilestone request
+	GetMilestoneWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*GetMilestoneResponse, error)
+
+	// UpdateMilestone request with any body
+	UpdateMilestoneWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMilestoneResponse, error)
+
+	UpdateMilestoneWithResponse(ctx context.Context, projectId string, id string, body UpdateMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMilestoneResponse, error)
+
+	// TransitionMilestone request with any body
+	TransitionMilestoneWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransitionMilestoneResponse, error)
+
+	TransitionMilestoneWithResponse(ctx context.Context, projectId string, id string, body TransitionMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*TransitionMilestoneResponse, error)
+
+	// GetProjectReport request
+	GetProjectReportWithResponse(ctx context.Context, projectId string, params *GetProjectReportParams, reqEditors ...RequestEditorFn) (*GetProjectReportResponse, error)
+
+	// GetSlaReport request
+	GetSlaReportWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetSlaReportResponse, error)
+
+	// SlaPolicies request
+	SlaPoliciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SlaPoliciesResponse, error)
+
+	// NewSlaPolicy request with any body
+	NewSlaPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewSlaPolicyResponse, error)
+
+	NewSlaPolicyWithResponse(ctx context.Context, body NewSlaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*NewSlaPolicyResponse, error)
+
+	// DeleteSlaPolicy request
+	DeleteSlaPolicyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteSlaPolicyResponse, error)
+
+	// GetSlaPolicy request
+	GetSlaPolicyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetSlaPolicyResponse, error)
+
+	// UpdateSlaPolicy request with any body
+	UpdateSlaPolicyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSlaPolicyResponse, error)
+
+	UpdateSlaPolicyWithResponse(ctx context.Context, id string, body UpdateSlaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSlaPolicyResponse, error)
+
+	// Users request
+	UsersWithResponse(ctx context.Context, params *UsersParams, reqEditors ...RequestEditorFn) (*UsersResponse, error)
+
+	// NewUser request with any body
+	NewUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewUserResponse, error)
+
+	NewUserWithResponse(ctx context.Context, body NewUserJSONRequestBody, reqEditors ...RequestEditorFn) (*NewUserResponse, error)
+
+	// GetUser request
+	GetUserWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+}
+
+type CustomersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomersPage
+}
+
+// Status returns HTTPResponse.Status
+func (r CustomersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CustomersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewCustomerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Customer
+}
+
+// Status returns HTTPResponse.Status
+func (r NewCustomerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewCustomerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCustomerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Customer
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCustomerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCustomerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCustomerResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Customer
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCustomerResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCustomerResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCustomerTaxonomyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Taxonomy
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCustomerTaxonomyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCustomerTaxonomyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCustomerTaxonomyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Taxonomy
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCustomerTaxonomyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCustomerTaxonomyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StartExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *Job
+}
+
+// Status returns HTTPResponse.Status
+func (r StartExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StartExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportIssuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImportReport
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportIssuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportIssuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReceiveEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InboundEmail
+	JSON201      *InboundEmail
+}
+
+// Status returns HTTPResponse.Status
+func (r ReceiveEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReceiveEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type JobsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JobsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r JobsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r JobsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetJobResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Job
+}
+
+// Status returns HTTPResponse.Status
+func (r GetJobResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetJobResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MentionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MentionsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r MentionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MentionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationPreferences
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateNotificationPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationPreferences
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateNotificationPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateNotificationPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r NotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadAllNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadAllNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadAllNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnreadNotificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UnreadNotificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnreadNotificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ReadNotificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r ReadNotificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ReadNotificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WatchedIssuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssuesPage
+}
+
+// Status returns HTTPResponse.Status
+func (r WatchedIssuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WatchedIssuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ProjectsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r ProjectsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ProjectsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r NewProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProjectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Project
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectTaxonomyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Taxonomy
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectTaxonomyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectTaxonomyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateProjectTaxonomyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Taxonomy
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateProjectTaxonomyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateProjectTaxonomyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CustomFieldsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomFieldsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r CustomFieldsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CustomFieldsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewCustomFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CustomField
+}
+
+// Status returns HTTPResponse.Status
+func (r NewCustomFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewCustomFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCustomFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomField
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCustomFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCustomFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCustomFieldResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CustomField
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCustomFieldResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCustomFieldResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssuesPage
+}
+
+// Status returns HTTPResponse.Status
+func (r IssuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Issue
+}
+
+// Status returns HTTPResponse.Status
+func (r NewIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Issue
+}
+
+// Status returns HTTPResponse.Status
+func (r GetIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdatedIssue
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueActivityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActivityPage
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueChildrenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssuesPage
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueChildrenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueChildrenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewChildIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Issue
+}
+
+// Status returns HTTPResponse.Status
+func (r NewChildIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewChildIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueLinksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssueLinksPage
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueLinksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueLinksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewIssueLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *IssueLink
+}
+
+// Status returns HTTPResponse.Status
+func (r NewIssueLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewIssueLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteIssueLinkResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteIssueLinkResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteIssueLinkResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Issue
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssueRevisionsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransitionIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Issue
+}
+
+// Status returns HTTPResponse.Status
+func (r TransitionIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransitionIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnvoteIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Issue
+}
+
+// Status returns HTTPResponse.Status
+func (r UnvoteIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnvoteIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type VoteIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Issue
+}
+
+// Status returns HTTPResponse.Status
+func (r VoteIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r VoteIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UnwatchIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UnwatchIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UnwatchIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type WatchIssueResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r WatchIssueResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r WatchIssueResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type IssueWatchersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WatchersPage
+}
+
+// Status returns HTTPResponse.Status
+func (r IssueWatchersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r IssueWatchersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AttachmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AttachmentsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r AttachmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AttachmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Attachment
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Attachment
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadAttachmentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadAttachmentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadAttachmentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CommentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CommentsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r CommentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CommentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Comment
+}
+
+// Status returns HTTPResponse.Status
+func (r NewCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Comment
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCommentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UpdatedComment
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCommentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCommentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RemoveReactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Comment
+}
+
+// Status returns HTTPResponse.Status
+func (r RemoveReactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RemoveReactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AddReactionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Comment
+}
+
+// Status returns HTTPResponse.Status
+func (r AddReactionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AddReactionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CommentRevisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CommentRevisionsPage
+}
+
+// Status returns HTTPResponse.Status
+func (r CommentRevisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CommentRevisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BulkUpdateIssuesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *IssueBulkResults
+}
+
+// Status returns HTTPResponse.Status
+func (r BulkUpdateIssuesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BulkUpdateIssuesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MilestonesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MilestonesPage
+}
+
+// Status returns HTTPResponse.Status
+func (r MilestonesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MilestonesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewMilestoneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Milestone
+}
+
+// Status returns HTTPResponse.Status
+func (r NewMilestoneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewMilestoneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMilestoneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Milestone
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMilestoneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMilestoneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMilestoneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Milestone
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMilestoneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMilestoneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TransitionMilestoneResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Milestone
+}
+
+// Status returns HTTPResponse.Status
+func (r TransitionMilestoneResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TransitionMilestoneResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetProjectReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ProjectReport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetProjectReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetProjectReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSlaReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SlaReport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSlaReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSlaReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SlaPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SlaPoliciesPage
+}
+
+// Status returns HTTPResponse.Status
+func (r SlaPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SlaPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewSlaPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SlaPolicy
+}
+
+// Status returns HTTPResponse.Status
+func (r NewSlaPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewSlaPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteSlaPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteSlaPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteSlaPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetSlaPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SlaPolicy
+}
+
+// Status returns HTTPResponse.Status
+func (r GetSlaPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetSlaPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateSlaPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SlaPolicy
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateSlaPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateSlaPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UsersPage
+}
+
+// Status returns HTTPResponse.Status
+func (r UsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type NewUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r NewUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r NewUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// CustomersWithResponse request returning *CustomersResponse
+func (c *ClientWithResponses) CustomersWithResponse(ctx context.Context, params *CustomersParams, reqEditors ...RequestEditorFn) (*CustomersResponse, error) {
+	rsp, err := c.Customers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomersResponse(rsp)
+}
+
+// NewCustomerWithBodyWithResponse request with arbitrary body returning *NewCustomerResponse
+func (c *ClientWithResponses) NewCustomerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCustomerResponse, error) {
+	rsp, err := c.NewCustomerWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewCustomerResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewCustomerWithResponse(ctx context.Context, body NewCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCustomerResponse, error) {
+	rsp, err := c.NewCustomer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewCustomerResponse(rsp)
+}
+
+// GetCustomerWithResponse request returning *GetCustomerResponse
+func (c *ClientWithResponses) GetCustomerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetCustomerResponse, error) {
+	rsp, err := c.GetCustomer(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCustomerResponse(rsp)
+}
+
+// UpdateCustomerWithBodyWithResponse request with arbitrary body returning *UpdateCustomerResponse
+func (c *ClientWithResponses) UpdateCustomerWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomerResponse, error) {
+	rsp, err := c.UpdateCustomerWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCustomerResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCustomerWithResponse(ctx context.Context, id string, body UpdateCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomerResponse, error) {
+	rsp, err := c.UpdateCustomer(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCustomerResponse(rsp)
+}
+
+// GetCustomerTaxonomyWithResponse request returning *GetCustomerTaxonomyResponse
+func (c *ClientWithResponses) GetCustomerTaxonomyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetCustomerTaxonomyResponse, error) {
+	rsp, err := c.GetCustomerTaxonomy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCustomerTaxonomyResponse(rsp)
+}
+
+// UpdateCustomerTaxonomyWithBodyWithResponse request with arbitrary body returning *UpdateCustomerTaxonomyResponse
+func (c *ClientWithResponses) UpdateCustomerTaxonomyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomerTaxonomyResponse, error) {
+	rsp, err := c.UpdateCustomerTaxonomyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCustomerTaxonomyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCustomerTaxonomyWithResponse(ctx context.Context, id string, body UpdateCustomerTaxonomyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomerTaxonomyResponse, error) {
+	rsp, err := c.UpdateCustomerTaxonomy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCustomerTaxonomyResponse(rsp)
+}
+
+// StartExportWithBodyWithResponse request with arbitrary body returning *StartExportResponse
+func (c *ClientWithResponses) StartExportWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*StartExportResponse, error) {
+	rsp, err := c.StartExportWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartExportResponse(rsp)
+}
+
+func (c *ClientWithResponses) StartExportWithResponse(ctx context.Context, body StartExportJSONRequestBody, reqEditors ...RequestEditorFn) (*StartExportResponse, error) {
+	rsp, err := c.StartExport(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStartExportResponse(rsp)
+}
+
+// DownloadExportWithResponse request returning *DownloadExportResponse
+func (c *ClientWithResponses) DownloadExportWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DownloadExportResponse, error) {
+	rsp, err := c.DownloadExport(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadExportResponse(rsp)
+}
+
+// ImportIssuesWithBodyWithResponse request with arbitrary body returning *ImportIssuesResponse
+func (c *ClientWithResponses) ImportIssuesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportIssuesResponse, error) {
+	rsp, err := c.ImportIssuesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportIssuesResponse(rsp)
+}
+
+// ReceiveEmailWithBodyWithResponse request with arbitrary body returning *ReceiveEmailResponse
+func (c *ClientWithResponses) ReceiveEmailWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ReceiveEmailResponse, error) {
+	rsp, err := c.ReceiveEmailWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReceiveEmailResponse(rsp)
+}
+
+// JobsWithResponse request returning *JobsResponse
+func (c *ClientWithResponses) JobsWithResponse(ctx context.Context, params *JobsParams, reqEditors ...RequestEditorFn) (*JobsResponse, error) {
+	rsp, err := c.Jobs(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseJobsResponse(rsp)
+}
+
+// GetJobWithResponse request returning *GetJobResponse
+func (c *ClientWithResponses) GetJobWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetJobResponse, error) {
+	rsp, err := c.GetJob(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetJobResponse(rsp)
+}
+
+// MentionsWithResponse request returning *MentionsResponse
+func (c *ClientWithResponses) MentionsWithResponse(ctx context.Context, params *MentionsParams, reqEditors ...RequestEditorFn) (*MentionsResponse, error) {
+	rsp, err := c.Mentions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMentionsResponse(rsp)
+}
+
+// NotificationPreferencesWithResponse request returning *NotificationPreferencesResponse
+func (c *ClientWithResponses) NotificationPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*NotificationPreferencesResponse, error) {
+	rsp, err := c.NotificationPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationPreferencesResponse(rsp)
+}
+
+// UpdateNotificationPreferencesWithBodyWithResponse request with arbitrary body returning *UpdateNotificationPreferencesResponse
+func (c *ClientWithResponses) UpdateNotificationPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNotificationPreferencesResponse, error) {
+	rsp, err := c.UpdateNotificationPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNotificationPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNotificationPreferencesWithResponse(ctx context.Context, body UpdateNotificationPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNotificationPreferencesResponse, error) {
+	rsp, err := c.UpdateNotificationPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNotificationPreferencesResponse(rsp)
+}
+
+// NotificationsWithResponse request returning *NotificationsResponse
+func (c *ClientWithResponses) NotificationsWithResponse(ctx context.Context, params *NotificationsParams, reqEditors ...RequestEditorFn) (*NotificationsResponse, error) {
+	rsp, err := c.Notifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNotificationsResponse(rsp)
+}
+
+// ReadAllNotificationsWithResponse request returning *ReadAllNotificationsResponse
+func (c *ClientWithResponses) ReadAllNotificationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ReadAllNotificationsResponse, error) {
+	rsp, err := c.ReadAllNotifications(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadAllNotificationsResponse(rsp)
+}
+
+// UnreadNotificationWithResponse request returning *UnreadNotificationResponse
+func (c *ClientWithResponses) UnreadNotificationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*UnreadNotificationResponse, error) {
+	rsp, err := c.UnreadNotification(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnreadNotificationResponse(rsp)
+}
+
+// ReadNotificationWithResponse request returning *ReadNotificationResponse
+func (c *ClientWithResponses) ReadNotificationWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*ReadNotificationResponse, error) {
+	rsp, err := c.ReadNotification(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseReadNotificationResponse(rsp)
+}
+
+// WatchedIssuesWithResponse request returning *WatchedIssuesResponse
+func (c *ClientWithResponses) WatchedIssuesWithResponse(ctx context.Context, params *WatchedIssuesParams, reqEditors ...RequestEditorFn) (*WatchedIssuesResponse, error) {
+	rsp, err := c.WatchedIssues(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWatchedIssuesResponse(rsp)
+}
+
+// ProjectsWithResponse request returning *ProjectsResponse
+func (c *ClientWithResponses) ProjectsWithResponse(ctx context.Context, params *ProjectsParams, reqEditors ...RequestEditorFn) (*ProjectsResponse, error) {
+	rsp, err := c.Projects(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProjectsResponse(rsp)
+}
+
+// NewProjectWithBodyWithResponse request with arbitrary body returning *NewProjectResponse
+func (c *ClientWithResponses) NewProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewProjectResponse, error) {
+	rsp, err := c.NewProjectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewProjectWithResponse(ctx context.Context, body NewProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*NewProjectResponse, error) {
+	rsp, err := c.NewProject(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewProjectResponse(rsp)
+}
+
+// GetProjectWithResponse request returning *GetProjectResponse
+func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
+	rsp, err := c.GetProject(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectResponse(rsp)
+}
+
+// UpdateProjectWithBodyWithResponse request with arbitrary body returning *UpdateProjectResponse
+func (c *ClientWithResponses) UpdateProjectWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProjectWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectWithResponse(ctx context.Context, id string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
+	rsp, err := c.UpdateProject(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectResponse(rsp)
+}
+
+// GetProjectTaxonomyWithResponse request returning *GetProjectTaxonomyResponse
+func (c *ClientWithResponses) GetProjectTaxonomyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetProjectTaxonomyResponse, error) {
+	rsp, err := c.GetProjectTaxonomy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectTaxonomyResponse(rsp)
+}
+
+// UpdateProjectTaxonomyWithBodyWithResponse request with arbitrary body returning *UpdateProjectTaxonomyResponse
+func (c *ClientWithResponses) UpdateProjectTaxonomyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectTaxonomyResponse, error) {
+	rsp, err := c.UpdateProjectTaxonomyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectTaxonomyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateProjectTaxonomyWithResponse(ctx context.Context, id string, body UpdateProjectTaxonomyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectTaxonomyResponse, error) {
+	rsp, err := c.UpdateProjectTaxonomy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateProjectTaxonomyResponse(rsp)
+}
+
+// CustomFieldsWithResponse request returning *CustomFieldsResponse
+func (c *ClientWithResponses) CustomFieldsWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*CustomFieldsResponse, error) {
+	rsp, err := c.CustomFields(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomFieldsResponse(rsp)
+}
+
+// NewCustomFieldWithBodyWithResponse request with arbitrary body returning *NewCustomFieldResponse
+func (c *ClientWithResponses) NewCustomFieldWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCustomFieldResponse, error) {
+	rsp, err := c.NewCustomFieldWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewCustomFieldResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewCustomFieldWithResponse(ctx context.Context, projectId string, body NewCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCustomFieldResponse, error) {
+	rsp, err := c.NewCustomField(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewCustomFieldResponse(rsp)
+}
+
+// GetCustomFieldWithResponse request returning *GetCustomFieldResponse
+func (c *ClientWithResponses) GetCustomFieldWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*GetCustomFieldResponse, error) {
+	rsp, err := c.GetCustomField(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCustomFieldResponse(rsp)
+}
+
+// UpdateCustomFieldWithBodyWithResponse request with arbitrary body returning *UpdateCustomFieldResponse
+func (c *ClientWithResponses) UpdateCustomFieldWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomFieldResponse, error) {
+	rsp, err := c.UpdateCustomFieldWithBody(ctx, projectId, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCustomFieldResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCustomFieldWithResponse(ctx context.Context, projectId string, id string, body UpdateCustomFieldJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomFieldResponse, error) {
+	rsp, err := c.UpdateCustomField(ctx, projectId, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCustomFieldResponse(rsp)
+}
+
+// IssuesWithResponse request returning *IssuesResponse
+func (c *ClientWithResponses) IssuesWithResponse(ctx context.Context, projectId string, params *IssuesParams, reqEditors ...RequestEditorFn) (*IssuesResponse, error) {
+	rsp, err := c.Issues(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssuesResponse(rsp)
+}
+
+// NewIssueWithBodyWithResponse request with arbitrary body returning *NewIssueResponse
+func (c *ClientWithResponses) NewIssueWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewIssueResponse, error) {
+	rsp, err := c.NewIssueWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewIssueResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewIssueWithResponse(ctx context.Context, projectId string, body NewIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*NewIssueResponse, error) {
+	rsp, err := c.NewIssue(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewIssueResponse(rsp)
+}
+
+// GetIssueWithResponse request returning *GetIssueResponse
+func (c *ClientWithResponses) GetIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*GetIssueResponse, error) {
+	rsp, err := c.GetIssue(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetIssueResponse(rsp)
+}
+
+// UpdateIssueWithResponse request returning *UpdateIssueResponse
+func (c *ClientWithResponses) UpdateIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*UpdateIssueResponse, error) {
+	rsp, err := c.UpdateIssue(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateIssueResponse(rsp)
+}
+
+// IssueActivityWithResponse request returning *IssueActivityResponse
+func (c *ClientWithResponses) IssueActivityWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueActivityResponse, error) {
+	rsp, err := c.IssueActivity(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueActivityResponse(rsp)
+}
+
+// IssueChildrenWithResponse request returning *IssueChildrenResponse
+func (c *ClientWithResponses) IssueChildrenWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueChildrenResponse, error) {
+	rsp, err := c.IssueChildren(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueChildrenResponse(rsp)
+}
+
+// NewChildIssueWithBodyWithResponse request with arbitrary body returning *NewChildIssueResponse
+func (c *ClientWithResponses) NewChildIssueWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewChildIssueResponse, error) {
+	rsp, err := c.NewChildIssueWithBody(ctx, projectId, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewChildIssueResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewChildIssueWithResponse(ctx context.Context, projectId string, id string, body NewChildIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*NewChildIssueResponse, error) {
+	rsp, err := c.NewChildIssue(ctx, projectId, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewChildIssueResponse(rsp)
+}
+
+// IssueLinksWithResponse request returning *IssueLinksResponse
+func (c *ClientWithResponses) IssueLinksWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueLinksResponse, error) {
+	rsp, err := c.IssueLinks(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueLinksResponse(rsp)
+}
+
+// NewIssueLinkWithBodyWithResponse request with arbitrary body returning *NewIssueLinkResponse
+func (c *ClientWithResponses) NewIssueLinkWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewIssueLinkResponse, error) {
+	rsp, err := c.NewIssueLinkWithBody(ctx, projectId, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewIssueLinkResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewIssueLinkWithResponse(ctx context.Context, projectId string, id string, body NewIssueLinkJSONRequestBody, reqEditors ...RequestEditorFn) (*NewIssueLinkResponse, error) {
+	rsp, err := c.NewIssueLink(ctx, projectId, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewIssueLinkResponse(rsp)
+}
+
+// DeleteIssueLinkWithResponse request returning *DeleteIssueLinkResponse
+func (c *ClientWithResponses) DeleteIssueLinkWithResponse(ctx context.Context, projectId string, id string, linkId string, reqEditors ...RequestEditorFn) (*DeleteIssueLinkResponse, error) {
+	rsp, err := c.DeleteIssueLink(ctx, projectId, id, linkId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteIssueLinkResponse(rsp)
+}
+
+// MoveIssueWithBodyWithResponse request with arbitrary body returning *MoveIssueResponse
+func (c *ClientWithResponses) MoveIssueWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveIssueResponse, error) {
+	rsp, err := c.MoveIssueWithBody(ctx, projectId, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveIssueResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveIssueWithResponse(ctx context.Context, projectId string, id string, body MoveIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveIssueResponse, error) {
+	rsp, err := c.MoveIssue(ctx, projectId, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveIssueResponse(rsp)
+}
+
+// IssueRevisionsWithResponse request returning *IssueRevisionsResponse
+func (c *ClientWithResponses) IssueRevisionsWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueRevisionsResponse, error) {
+	rsp, err := c.IssueRevisions(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueRevisionsResponse(rsp)
+}
+
+// TransitionIssueWithBodyWithResponse request with arbitrary body returning *TransitionIssueResponse
+func (c *ClientWithResponses) TransitionIssueWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransitionIssueResponse, error) {
+	rsp, err := c.TransitionIssueWithBody(ctx, projectId, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransitionIssueResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransitionIssueWithResponse(ctx context.Context, projectId string, id string, body TransitionIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*TransitionIssueResponse, error) {
+	rsp, err := c.TransitionIssue(ctx, projectId, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransitionIssueResponse(rsp)
+}
+
+// UnvoteIssueWithResponse request returning *UnvoteIssueResponse
+func (c *ClientWithResponses) UnvoteIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*UnvoteIssueResponse, error) {
+	rsp, err := c.UnvoteIssue(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnvoteIssueResponse(rsp)
+}
+
+// VoteIssueWithResponse request returning *VoteIssueResponse
+func (c *ClientWithResponses) VoteIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*VoteIssueResponse, error) {
+	rsp, err := c.VoteIssue(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseVoteIssueResponse(rsp)
+}
+
+// UnwatchIssueWithResponse request returning *UnwatchIssueResponse
+func (c *ClientWithResponses) UnwatchIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*UnwatchIssueResponse, error) {
+	rsp, err := c.UnwatchIssue(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUnwatchIssueResponse(rsp)
+}
+
+// WatchIssueWithResponse request returning *WatchIssueResponse
+func (c *ClientWithResponses) WatchIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*WatchIssueResponse, error) {
+	rsp, err := c.WatchIssue(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseWatchIssueResponse(rsp)
+}
+
+// IssueWatchersWithResponse request returning *IssueWatchersResponse
+func (c *ClientWithResponses) IssueWatchersWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*IssueWatchersResponse, error) {
+	rsp, err := c.IssueWatchers(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseIssueWatchersResponse(rsp)
+}
+
+// AttachmentsWithResponse request returning *AttachmentsResponse
+func (c *ClientWithResponses) AttachmentsWithResponse(ctx context.Context, projectId string, issueId string, params *AttachmentsParams, reqEditors ...RequestEditorFn) (*AttachmentsResponse, error) {
+	rsp, err := c.Attachments(ctx, projectId, issueId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAttachmentsResponse(rsp)
+}
+
+// UploadAttachmentWithBodyWithResponse request with arbitrary body returning *UploadAttachmentResponse
+func (c *ClientWithResponses) UploadAttachmentWithBodyWithResponse(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadAttachmentResponse, error) {
+	rsp, err := c.UploadAttachmentWithBody(ctx, projectId, issueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadAttachmentResponse(rsp)
+}
+
+// DeleteAttachmentWithResponse request returning *DeleteAttachmentResponse
+func (c *ClientWithResponses) DeleteAttachmentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*DeleteAttachmentResponse, error) {
+	rsp, err := c.DeleteAttachment(ctx, projectId, issueId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAttachmentResponse(rsp)
+}
+
+// GetAttachmentWithResponse request returning *GetAttachmentResponse
+func (c *ClientWithResponses) GetAttachmentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*GetAttachmentResponse, error) {
+	rsp, err := c.GetAttachment(ctx, projectId, issueId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAttachmentResponse(rsp)
+}
+
+// DownloadAttachmentWithResponse request returning *DownloadAttachmentResponse
+func (c *ClientWithResponses) DownloadAttachmentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*DownloadAttachmentResponse, error) {
+	rsp, err := c.DownloadAttachment(ctx, projectId, issueId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadAttachmentResponse(rsp)
+}
+
+// CommentsWithResponse request returning *CommentsResponse
+func (c *ClientWithResponses) CommentsWithResponse(ctx context.Context, projectId string, issueId string, params *CommentsParams, reqEditors ...RequestEditorFn) (*CommentsResponse, error) {
+	rsp, err := c.Comments(ctx, projectId, issueId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommentsResponse(rsp)
+}
+
+// NewCommentWithBodyWithResponse request with arbitrary body returning *NewCommentResponse
+func (c *ClientWithResponses) NewCommentWithBodyWithResponse(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCommentResponse, error) {
+	rsp, err := c.NewCommentWithBody(ctx, projectId, issueId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewCommentResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewCommentWithResponse(ctx context.Context, projectId string, issueId string, body NewCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCommentResponse, error) {
+	rsp, err := c.NewComment(ctx, projectId, issueId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewCommentResponse(rsp)
+}
+
+// DeleteCommentWithResponse request returning *DeleteCommentResponse
+func (c *ClientWithResponses) DeleteCommentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*DeleteCommentResponse, error) {
+	rsp, err := c.DeleteComment(ctx, projectId, issueId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCommentResponse(rsp)
+}
+
+// GetCommentWithResponse request returning *GetCommentResponse
+func (c *ClientWithResponses) GetCommentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*GetCommentResponse, error) {
+	rsp, err := c.GetComment(ctx, projectId, issueId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCommentResponse(rsp)
+}
+
+// UpdateCommentWithResponse request returning *UpdateCommentResponse
+func (c *ClientWithResponses) UpdateCommentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*UpdateCommentResponse, error) {
+	rsp, err := c.UpdateComment(ctx, projectId, issueId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCommentResponse(rsp)
+}
+
+// RemoveReactionWithResponse request returning *RemoveReactionResponse
+func (c *ClientWithResponses) RemoveReactionWithResponse(ctx context.Context, projectId string, issueId string, id string, reaction string, reqEditors ...RequestEditorFn) (*RemoveReactionResponse, error) {
+	rsp, err := c.RemoveReaction(ctx, projectId, issueId, id, reaction, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRemoveReactionResponse(rsp)
+}
+
+// AddReactionWithResponse request returning *AddReactionResponse
+func (c *ClientWithResponses) AddReactionWithResponse(ctx context.Context, projectId string, issueId string, id string, reaction string, reqEditors ...RequestEditorFn) (*AddReactionResponse, error) {
+	rsp, err := c.AddReaction(ctx, projectId, issueId, id, reaction, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddReactionResponse(rsp)
+}
+
+// CommentRevisionsWithResponse request returning *CommentRevisionsResponse
+func (c *ClientWithResponses) CommentRevisionsWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*CommentRevisionsResponse, error) {
+	rsp, err := c.CommentRevisions(ctx, projectId, issueId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCommentRevisionsResponse(rsp)
+}
+
+// BulkUpdateIssuesWithBodyWithResponse request with arbitrary body returning *BulkUpdateIssuesResponse
+func (c *ClientWithResponses) BulkUpdateIssuesWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*BulkUpdateIssuesResponse, error) {
+	rsp, err := c.BulkUpdateIssuesWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkUpdateIssuesResponse(rsp)
+}
+
+func (c *ClientWithResponses) BulkUpdateIssuesWithResponse(ctx context.Context, projectId string, body BulkUpdateIssuesJSONRequestBody, reqEditors ...RequestEditorFn) (*BulkUpdateIssuesResponse, error) {
+	rsp, err := c.BulkUpdateIssues(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseBulkUpdateIssuesResponse(rsp)
+}
+
+// MilestonesWithResponse request returning *MilestonesResponse
+func (c *ClientWithResponses) MilestonesWithResponse(ctx context.Context, projectId string, params *MilestonesParams, reqEditors ...RequestEditorFn) (*MilestonesResponse, error) {
+	rsp, err := c.Milestones(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMilestonesResponse(rsp)
+}
+
+// NewMilestoneWithBodyWithResponse request with arbitrary body returning *NewMilestoneResponse
+func (c *ClientWithResponses) NewMilestoneWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewMilestoneResponse, error) {
+	rsp, err := c.NewMilestoneWithBody(ctx, projectId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewMilestoneResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewMilestoneWithResponse(ctx context.Context, projectId string, body NewMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*NewMilestoneResponse, error) {
+	rsp, err := c.NewMilestone(ctx, projectId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewMilestoneResponse(rsp)
+}
+
+// GetMilestoneWithResponse request returning *GetMilestoneResponse
+func (c *ClientWithResponses) GetMilestoneWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*GetMilestoneResponse, error) {
+	rsp, err := c.GetMilestone(ctx, projectId, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMilestoneResponse(rsp)
+}
+
+// UpdateMilestoneWithBodyWithResponse request with arbitrary body returning *UpdateMilestoneResponse
+func (c *ClientWithResponses) UpdateMilestoneWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMilestoneResponse, error) {
+	rsp, err := c.UpdateMilestoneWithBody(ctx, projectId, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMilestoneResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMilestoneWithResponse(ctx context.Context, projectId string, id string, body UpdateMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMilestoneResponse, error) {
+	rsp, err := c.UpdateMilestone(ctx, projectId, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMilestoneResponse(rsp)
+}
+
+// TransitionMilestoneWithBodyWithResponse request with arbitrary body returning *TransitionMilestoneResponse
+func (c *ClientWithResponses) TransitionMilestoneWithBodyWithResponse(ctx context.Context, projectId string, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TransitionMilestoneResponse, error) {
+	rsp, err := c.TransitionMilestoneWithBody(ctx, projectId, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransitionMilestoneResponse(rsp)
+}
+
+func (c *ClientWithResponses) TransitionMilestoneWithResponse(ctx context.Context, projectId string, id string, body TransitionMilestoneJSONRequestBody, reqEditors ...RequestEditorFn) (*TransitionMilestoneResponse, error) {
+	rsp, err := c.TransitionMilestone(ctx, projectId, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTransitionMilestoneResponse(rsp)
+}
+
+// GetProjectReportWithResponse request returning *GetProjectReportResponse
+func (c *ClientWithResponses) GetProjectReportWithResponse(ctx context.Context, projectId string, params *GetProjectReportParams, reqEditors ...RequestEditorFn) (*GetProjectReportResponse, error) {
+	rsp, err := c.GetProjectReport(ctx, projectId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetProjectReportResponse(rsp)
+}
+
+// GetSlaReportWithResponse request returning *GetSlaReportResponse
+func (c *ClientWithResponses) GetSlaReportWithResponse(ctx context.Context, projectId string, reqEditors ...RequestEditorFn) (*GetSlaReportResponse, error) {
+	rsp, err := c.GetSlaReport(ctx, projectId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSlaReportResponse(rsp)
+}
+
+// SlaPoliciesWithResponse request returning *SlaPoliciesResponse
+func (c *ClientWithResponses) SlaPoliciesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*SlaPoliciesResponse, error) {
+	rsp, err := c.SlaPolicies(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSlaPoliciesResponse(rsp)
+}
+
+// NewSlaPolicyWithBodyWithResponse request with arbitrary body returning *NewSlaPolicyResponse
+func (c *ClientWithResponses) NewSlaPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewSlaPolicyResponse, error) {
+	rsp, err := c.NewSlaPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewSlaPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewSlaPolicyWithResponse(ctx context.Context, body NewSlaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*NewSlaPolicyResponse, error) {
+	rsp, err := c.NewSlaPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewSlaPolicyResponse(rsp)
+}
+
+// DeleteSlaPolicyWithResponse request returning *DeleteSlaPolicyResponse
+func (c *ClientWithResponses) DeleteSlaPolicyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*DeleteSlaPolicyResponse, error) {
+	rsp, err := c.DeleteSlaPolicy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteSlaPolicyResponse(rsp)
+}
+
+// GetSlaPolicyWithResponse request returning *GetSlaPolicyResponse
+func (c *ClientWithResponses) GetSlaPolicyWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetSlaPolicyResponse, error) {
+	rsp, err := c.GetSlaPolicy(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSlaPolicyResponse(rsp)
+}
+
+// UpdateSlaPolicyWithBodyWithResponse request with arbitrary body returning *UpdateSlaPolicyResponse
+func (c *ClientWithResponses) UpdateSlaPolicyWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateSlaPolicyResponse, error) {
+	rsp, err := c.UpdateSlaPolicyWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSlaPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateSlaPolicyWithResponse(ctx context.Context, id string, body UpdateSlaPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateSlaPolicyResponse, error) {
+	rsp, err := c.UpdateSlaPolicy(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateSlaPolicyResponse(rsp)
+}
+
+// UsersWithResponse request returning *UsersResponse
+func (c *ClientWithResponses) UsersWithResponse(ctx context.Context, params *UsersParams, reqEditors ...RequestEditorFn) (*UsersResponse, error) {
+	rsp, err := c.Users(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUsersResponse(rsp)
+}
+
+// NewUserWithBodyWithResponse request with arbitrary body returning *NewUserResponse
+func (c *ClientWithResponses) NewUserWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewUserResponse, error) {
+	rsp, err := c.NewUserWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewUserResponse(rsp)
+}
+
+func (c *ClientWithResponses) NewUserWithResponse(ctx context.Context, body NewUserJSONRequestBody, reqEditors ...RequestEditorFn) (*NewUserResponse, error) {
+	rsp, err := c.NewUser(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseNewUserResponse(rsp)
+}
+
+// GetUserWithResponse request returning *GetUserResponse
+func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
+	rsp, err := c.GetUser(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserResponse(rsp)
+}
+
+// ParseCustomersResponse parses an HTTP response from a CustomersWithResponse call
+func ParseCustomersResponse(rsp *http.Response) (*CustomersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CustomersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomersPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseNewCustomerResponse parses an HTTP response from a NewCustomerWithResponse call
+func ParseNewCustomerResponse(rsp *http.Response) (*NewCustomerResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &NewCustomerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Customer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
 
-	NewIssue(ctx context.Context, projectId string, body NewIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	return response, nil
+}
 
-	// GetIssue request
-	GetIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// ParseGetCustomerResponse parses an HTTP response from a GetCustomerWithResponse call
+func ParseGetCustomerResponse(rsp *http.Response) (*GetCustomerResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateIssue request
-	UpdateIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	response := &GetCustomerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// Comments request
-	Comments(ctx context.Context, projectId string, issueId string, params *CommentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Customer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// NewComment request with any body
-	NewCommentWithBody(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	}
 
-	NewComment(ctx context.Context, projectId string, issueId string, body NewCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	return response, nil
+}
 
-	// UpdateComment request
-	UpdateComment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+// ParseUpdateCustomerResponse parses an HTTP response from a UpdateCustomerWithResponse call
+func ParseUpdateCustomerResponse(rsp *http.Response) (*UpdateCustomerResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// Users request
-	Users(ctx context.Context, params *UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+	response := &UpdateCustomerResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Customer
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCustomerTaxonomyResponse parses an HTTP response from a GetCustomerTaxonomyWithResponse call
+func ParseGetCustomerTaxonomyResponse(rsp *http.Response) (*GetCustomerTaxonomyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCustomerTaxonomyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Taxonomy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCustomerTaxonomyResponse parses an HTTP response from a UpdateCustomerTaxonomyWithResponse call
+func ParseUpdateCustomerTaxonomyResponse(rsp *http.Response) (*UpdateCustomerTaxonomyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCustomerTaxonomyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Taxonomy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStartExportResponse parses an HTTP response from a StartExportWithResponse call
+func ParseStartExportResponse(rsp *http.Response) (*StartExportResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StartExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadExportResponse parses an HTTP response from a DownloadExportWithResponse call
+func ParseDownloadExportResponse(rsp *http.Response) (*DownloadExportResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseImportIssuesResponse parses an HTTP response from a ImportIssuesWithResponse call
+func ParseImportIssuesResponse(rsp *http.Response) (*ImportIssuesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportIssuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImportReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseReceiveEmailResponse parses an HTTP response from a ReceiveEmailWithResponse call
+func ParseReceiveEmailResponse(rsp *http.Response) (*ReceiveEmailResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ReceiveEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InboundEmail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest InboundEmail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseJobsResponse parses an HTTP response from a JobsWithResponse call
+func ParseJobsResponse(rsp *http.Response) (*JobsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &JobsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JobsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetJobResponse parses an HTTP response from a GetJobWithResponse call
+func ParseGetJobResponse(rsp *http.Response) (*GetJobResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetJobResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Job
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMentionsResponse parses an HTTP response from a MentionsWithResponse call
+func ParseMentionsResponse(rsp *http.Response) (*MentionsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MentionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MentionsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
 
-	// GetUser request
-	GetUser(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error)
+	return response, nil
 }
 
-func (c *Client) Customers(ctx context.Context, params *CustomersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCustomersRequest(c.Server, params)
+// ParseNotificationPreferencesResponse parses an HTTP response from a NotificationPreferencesWithResponse call
+func ParseNotificationPreferencesResponse(rsp *http.Response) (*NotificationPreferencesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &NotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) NewCustomerWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNewCustomerRequestWithBody(c.Server, contentType, body)
+// ParseUpdateNotificationPreferencesResponse parses an HTTP response from a UpdateNotificationPreferencesWithResponse call
+func ParseUpdateNotificationPreferencesResponse(rsp *http.Response) (*UpdateNotificationPreferencesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &UpdateNotificationPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) NewCustomer(ctx context.Context, body NewCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNewCustomerRequest(c.Server, body)
+// ParseNotificationsResponse parses an HTTP response from a NotificationsWithResponse call
+func ParseNotificationsResponse(rsp *http.Response) (*NotificationsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &NotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) GetCustomer(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCustomerRequest(c.Server, id)
+// ParseReadAllNotificationsResponse parses an HTTP response from a ReadAllNotificationsWithResponse call
+func ParseReadAllNotificationsResponse(rsp *http.Response) (*ReadAllNotificationsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &ReadAllNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) UpdateCustomerWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCustomerRequestWithBody(c.Server, id, contentType, body)
+// ParseUnreadNotificationResponse parses an HTTP response from a UnreadNotificationWithResponse call
+func ParseUnreadNotificationResponse(rsp *http.Response) (*UnreadNotificationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &UnreadNotificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) UpdateCustomer(ctx context.Context, id string, body UpdateCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCustomerRequest(c.Server, id, body)
+// ParseReadNotificationResponse parses an HTTP response from a ReadNotificationWithResponse call
+func ParseReadNotificationResponse(rsp *http.Response) (*ReadNotificationResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &ReadNotificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) Projects(ctx context.Context, params *ProjectsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewProjectsRequest(c.Server, params)
+// ParseWatchedIssuesResponse parses an HTTP response from a WatchedIssuesWithResponse call
+func ParseWatchedIssuesResponse(rsp *http.Response) (*WatchedIssuesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &WatchedIssuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssuesPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) NewProjectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNewProjectRequestWithBody(c.Server, contentType, body)
+// ParseProjectsResponse parses an HTTP response from a ProjectsWithResponse call
+func ParseProjectsResponse(rsp *http.Response) (*ProjectsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &ProjectsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) NewProject(ctx context.Context, body NewProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNewProjectRequest(c.Server, body)
+// ParseNewProjectResponse parses an HTTP response from a NewProjectWithResponse call
+func ParseNewProjectResponse(rsp *http.Response) (*NewProjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &NewProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) GetProject(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetProjectRequest(c.Server, id)
+// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
+func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &GetProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) UpdateProjectWithBody(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectRequestWithBody(c.Server, id, contentType, body)
+// ParseUpdateProjectResponse parses an HTTP response from a UpdateProjectWithResponse call
+func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &UpdateProjectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Project
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) UpdateProject(ctx context.Context, id string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateProjectRequest(c.Server, id, body)
+// ParseGetProjectTaxonomyResponse parses an HTTP response from a GetProjectTaxonomyWithResponse call
+func ParseGetProjectTaxonomyResponse(rsp *http.Response) (*GetProjectTaxonomyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &GetProjectTaxonomyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Taxonomy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) Issues(ctx context.Context, projectId string, params *IssuesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewIssuesRequest(c.Server, projectId, params)
+// ParseUpdateProjectTaxonomyResponse parses an HTTP response from a UpdateProjectTaxonomyWithResponse call
+func ParseUpdateProjectTaxonomyResponse(rsp *http.Response) (*UpdateProjectTaxonomyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
-	}
-	return c.Client.Do(req)
-}
 
-func (c *Client) NewIssueWithBody(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNewIssueRequestWithBody(c.Server, projectId, contentType, body)
-	if err != nil {
-		return nil, err
+	response := &UpdateProjectTaxonomyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Taxonomy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return c.Client.Do(req)
+
+	return response, nil
 }
 
-func (c *Client) NewIssue(ctx context.Context, projectId string, body NewIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNewIssueRequest(c.Server, projectId, body)
+// ParseCustomFieldsResponse parses an HTTP response from a CustomFieldsWithResponse call
+func ParseCustomFieldsResponse(rsp *http.Response) (*CustomFieldsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &CustomFieldsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomFieldsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) GetIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetIssueRequest(c.Server, projectId, id)
+// ParseNewCustomFieldResponse parses an HTTP response from a NewCustomFieldWithResponse call
+func ParseNewCustomFieldResponse(rsp *http.Response) (*NewCustomFieldResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &NewCustomFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CustomField
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) UpdateIssue(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateIssueRequest(c.Server, projectId, id)
+// ParseGetCustomFieldResponse parses an HTTP response from a GetCustomFieldWithResponse call
+func ParseGetCustomFieldResponse(rsp *http.Response) (*GetCustomFieldResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &GetCustomFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomField
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) Comments(ctx context.Context, projectId string, issueId string, params *CommentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCommentsRequest(c.Server, projectId, issueId, params)
+// ParseUpdateCustomFieldResponse parses an HTTP response from a UpdateCustomFieldWithResponse call
+func ParseUpdateCustomFieldResponse(rsp *http.Response) (*UpdateCustomFieldResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &UpdateCustomFieldResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CustomField
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) NewCommentWithBody(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNewCommentRequestWithBody(c.Server, projectId, issueId, contentType, body)
+// ParseIssuesResponse parses an HTTP response from a IssuesWithResponse call
+func ParseIssuesResponse(rsp *http.Response) (*IssuesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &IssuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssuesPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) NewComment(ctx context.Context, projectId string, issueId string, body NewCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewNewCommentRequest(c.Server, projectId, issueId, body)
+// ParseNewIssueResponse parses an HTTP response from a NewIssueWithResponse call
+func ParseNewIssueResponse(rsp *http.Response) (*NewIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &NewIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Issue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) UpdateComment(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateCommentRequest(c.Server, projectId, issueId, id)
+// ParseGetIssueResponse parses an HTTP response from a GetIssueWithResponse call
+func ParseGetIssueResponse(rsp *http.Response) (*GetIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &GetIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Issue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) Users(ctx context.Context, params *UsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUsersRequest(c.Server, params)
+// ParseUpdateIssueResponse parses an HTTP response from a UpdateIssueWithResponse call
+func ParseUpdateIssueResponse(rsp *http.Response) (*UpdateIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &UpdateIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdatedIssue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-func (c *Client) GetUser(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetUserRequest(c.Server, id)
+// ParseIssueActivityResponse parses an HTTP response from a IssueActivityWithResponse call
+func ParseIssueActivityResponse(rsp *http.Response) (*IssueActivityResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	req = req.WithContext(ctx)
-	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
-		return nil, err
+
+	response := &IssueActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return c.Client.Do(req)
-}
 
-// NewCustomersRequest generates requests for Customers
-func NewCustomersRequest(server string, params *CustomersParams) (*http.Request, error) {
-	var err error
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	serverURL, err := url.Parse(server)
+	}
+
+	return response, nil
+}
+
+// ParseIssueChildrenResponse parses an HTTP response from a IssueChildrenWithResponse call
+func ParseIssueChildrenResponse(rsp *http.Response) (*IssueChildrenResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/customers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &IssueChildrenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssuesPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseNewChildIssueResponse parses an HTTP response from a NewChildIssueWithResponse call
+func ParseNewChildIssueResponse(rsp *http.Response) (*NewChildIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.Q != nil {
+	response := &NewChildIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Issue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON201 = &dest
 
 	}
 
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return response, nil
+}
 
+// ParseIssueLinksResponse parses an HTTP response from a IssueLinksWithResponse call
+func ParseIssueLinksResponse(rsp *http.Response) (*IssueLinksResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Limit != nil {
+	response := &IssueLinksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssueLinksPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON200 = &dest
 
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	return response, nil
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// ParseNewIssueLinkResponse parses an HTTP response from a NewIssueLinkWithResponse call
+func ParseNewIssueLinkResponse(rsp *http.Response) (*NewIssueLinkResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	response := &NewIssueLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest IssueLink
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// NewNewCustomerRequest calls the generic NewCustomer builder with application/json body
-func NewNewCustomerRequest(server string, body NewCustomerJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewNewCustomerRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewNewCustomerRequestWithBody generates requests for NewCustomer with any type of body
-func NewNewCustomerRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	return response, nil
+}
 
-	serverURL, err := url.Parse(server)
+// ParseDeleteIssueLinkResponse parses an HTTP response from a DeleteIssueLinkWithResponse call
+func ParseDeleteIssueLinkResponse(rsp *http.Response) (*DeleteIssueLinkResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/customers")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &DeleteIssueLinkResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+// ParseMoveIssueResponse parses an HTTP response from a MoveIssueWithResponse call
+func ParseMoveIssueResponse(rsp *http.Response) (*MoveIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &MoveIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Issue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewGetCustomerRequest generates requests for GetCustomer
-func NewGetCustomerRequest(server string, id string) (*http.Request, error) {
-	var err error
+	}
 
-	var pathParam0 string
+	return response, nil
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// ParseIssueRevisionsResponse parses an HTTP response from a IssueRevisionsWithResponse call
+func ParseIssueRevisionsResponse(rsp *http.Response) (*IssueRevisionsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &IssueRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/customers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssueRevisionsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseTransitionIssueResponse parses an HTTP response from a TransitionIssueWithResponse call
+func ParseTransitionIssueResponse(rsp *http.Response) (*TransitionIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &TransitionIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Issue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// NewUpdateCustomerRequest calls the generic UpdateCustomer builder with application/json body
-func NewUpdateCustomerRequest(server string, id string, body UpdateCustomerJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ParseUnvoteIssueResponse parses an HTTP response from a UnvoteIssueWithResponse call
+func ParseUnvoteIssueResponse(rsp *http.Response) (*UnvoteIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCustomerRequestWithBody(server, id, "application/json", bodyReader)
-}
 
-// NewUpdateCustomerRequestWithBody generates requests for UpdateCustomer with any type of body
-func NewUpdateCustomerRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	response := &UnvoteIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	var pathParam0 string
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Issue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseVoteIssueResponse parses an HTTP response from a VoteIssueWithResponse call
+func ParseVoteIssueResponse(rsp *http.Response) (*VoteIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/customers/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &VoteIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Issue
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	return response, nil
+}
+
+// ParseUnwatchIssueResponse parses an HTTP response from a UnwatchIssueWithResponse call
+func ParseUnwatchIssueResponse(rsp *http.Response) (*UnwatchIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &UnwatchIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
+	return response, nil
 }
 
-// NewProjectsRequest generates requests for Projects
-func NewProjectsRequest(server string, params *ProjectsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+// ParseWatchIssueResponse parses an HTTP response from a WatchIssueWithResponse call
+func ParseWatchIssueResponse(rsp *http.Response) (*WatchIssueResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	response := &WatchIssueResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseIssueWatchersResponse parses an HTTP response from a IssueWatchersWithResponse call
+func ParseIssueWatchersResponse(rsp *http.Response) (*IssueWatchersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.Q != nil {
+	response := &IssueWatchersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WatchersPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON200 = &dest
 
 	}
 
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return response, nil
+}
 
+// ParseAttachmentsResponse parses an HTTP response from a AttachmentsWithResponse call
+func ParseAttachmentsResponse(rsp *http.Response) (*AttachmentsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Limit != nil {
+	response := &AttachmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AttachmentsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON200 = &dest
 
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	return response, nil
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// ParseUploadAttachmentResponse parses an HTTP response from a UploadAttachmentWithResponse call
+func ParseUploadAttachmentResponse(rsp *http.Response) (*UploadAttachmentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
+	response := &UploadAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Attachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// NewNewProjectRequest calls the generic NewProject builder with application/json body
-func NewNewProjectRequest(server string, body NewProjectJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ParseDeleteAttachmentResponse parses an HTTP response from a DeleteAttachmentWithResponse call
+func ParseDeleteAttachmentResponse(rsp *http.Response) (*DeleteAttachmentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewNewProjectRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewNewProjectRequestWithBody generates requests for NewProject with any type of body
-func NewNewProjectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	response := &DeleteAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseGetAttachmentResponse parses an HTTP response from a GetAttachmentWithResponse call
+func ParseGetAttachmentResponse(rsp *http.Response) (*GetAttachmentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &GetAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Attachment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	return response, nil
+}
+
+// ParseDownloadAttachmentResponse parses an HTTP response from a DownloadAttachmentWithResponse call
+func ParseDownloadAttachmentResponse(rsp *http.Response) (*DownloadAttachmentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &DownloadAttachmentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
+	return response, nil
 }
 
-// NewGetProjectRequest generates requests for GetProject
-func NewGetProjectRequest(server string, id string) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// ParseCommentsResponse parses an HTTP response from a CommentsWithResponse call
+func ParseCommentsResponse(rsp *http.Response) (*CommentsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &CommentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CommentsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseNewCommentResponse parses an HTTP response from a NewCommentWithResponse call
+func ParseNewCommentResponse(rsp *http.Response) (*NewCommentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &NewCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Comment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// NewUpdateProjectRequest calls the generic UpdateProject builder with application/json body
-func NewUpdateProjectRequest(server string, id string, body UpdateProjectJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ParseDeleteCommentResponse parses an HTTP response from a DeleteCommentWithResponse call
+func ParseDeleteCommentResponse(rsp *http.Response) (*DeleteCommentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateProjectRequestWithBody(server, id, "application/json", bodyReader)
-}
 
-// NewUpdateProjectRequestWithBody generates requests for UpdateProject with any type of body
-func NewUpdateProjectRequestWithBody(server string, id string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	response := &DeleteCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	var pathParam0 string
+	return response, nil
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// ParseGetCommentResponse parses an HTTP response from a GetCommentWithResponse call
+func ParseGetCommentResponse(rsp *http.Response) (*GetCommentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &GetCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Comment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	return response, nil
+}
+
+// ParseUpdateCommentResponse parses an HTTP response from a UpdateCommentWithResponse call
+func ParseUpdateCommentResponse(rsp *http.Response) (*UpdateCommentResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &UpdateCommentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UpdatedComment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewIssuesRequest generates requests for Issues
-func NewIssuesRequest(server string, projectId string, params *IssuesParams) (*http.Request, error) {
-	var err error
+	}
 
-	var pathParam0 string
+	return response, nil
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+// ParseRemoveReactionResponse parses an HTTP response from a RemoveReactionWithResponse call
+func ParseRemoveReactionResponse(rsp *http.Response) (*RemoveReactionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &RemoveReactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/issues", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Comment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseAddReactionResponse parses an HTTP response from a AddReactionWithResponse call
+func ParseAddReactionResponse(rsp *http.Response) (*AddReactionResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.Q != nil {
+	response := &AddReactionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Comment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON200 = &dest
 
 	}
 
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return response, nil
+}
 
+// ParseCommentRevisionsResponse parses an HTTP response from a CommentRevisionsWithResponse call
+func ParseCommentRevisionsResponse(rsp *http.Response) (*CommentRevisionsResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Limit != nil {
+	response := &CommentRevisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CommentRevisionsPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON200 = &dest
 
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	return response, nil
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// ParseBulkUpdateIssuesResponse parses an HTTP response from a BulkUpdateIssuesWithResponse call
+func ParseBulkUpdateIssuesResponse(rsp *http.Response) (*BulkUpdateIssuesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
+	response := &BulkUpdateIssuesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest IssueBulkResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// NewNewIssueRequest calls the generic NewIssue builder with application/json body
-func NewNewIssueRequest(server string, projectId string, body NewIssueJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ParseMilestonesResponse parses an HTTP response from a MilestonesWithResponse call
+func ParseMilestonesResponse(rsp *http.Response) (*MilestonesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewNewIssueRequestWithBody(server, projectId, "application/json", bodyReader)
-}
 
-// NewNewIssueRequestWithBody generates requests for NewIssue with any type of body
-func NewNewIssueRequestWithBody(server string, projectId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	response := &MilestonesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	var pathParam0 string
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MilestonesPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseNewMilestoneResponse parses an HTTP response from a NewMilestoneWithResponse call
+func ParseNewMilestoneResponse(rsp *http.Response) (*NewMilestoneResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/issues", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &NewMilestoneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Milestone
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	return response, nil
+}
+
+// ParseGetMilestoneResponse parses an HTTP response from a GetMilestoneWithResponse call
+func ParseGetMilestoneResponse(rsp *http.Response) (*GetMilestoneResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	response := &GetMilestoneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Milestone
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewGetIssueRequest generates requests for GetIssue
-func NewGetIssueRequest(server string, projectId string, id string) (*http.Request, error) {
-	var err error
+	}
 
-	var pathParam0 string
+	return response, nil
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+// ParseUpdateMilestoneResponse parses an HTTP response from a UpdateMilestoneWithResponse call
+func ParseUpdateMilestoneResponse(rsp *http.Response) (*UpdateMilestoneResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	response := &UpdateMilestoneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Milestone
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseTransitionMilestoneResponse parses an HTTP response from a TransitionMilestoneWithResponse call
+func ParseTransitionMilestoneResponse(rsp *http.Response) (*TransitionMilestoneResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/issues/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &TransitionMilestoneResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Milestone
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseGetProjectReportResponse parses an HTTP response from a GetProjectReportWithResponse call
+func ParseGetProjectReportResponse(rsp *http.Response) (*GetProjectReportResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewUpdateIssueRequest generates requests for UpdateIssue
-func NewUpdateIssueRequest(server string, projectId string, id string) (*http.Request, error) {
-	var err error
+	response := &GetProjectReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	var pathParam0 string
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ProjectReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
-	if err != nil {
-		return nil, err
 	}
 
-	var pathParam1 string
+	return response, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+// ParseGetSlaReportResponse parses an HTTP response from a GetSlaReportWithResponse call
+func ParseGetSlaReportResponse(rsp *http.Response) (*GetSlaReportResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &GetSlaReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/issues/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SlaReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseSlaPoliciesResponse parses an HTTP response from a SlaPoliciesWithResponse call
+func ParseSlaPoliciesResponse(rsp *http.Response) (*SlaPoliciesResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+	response := &SlaPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	return req, nil
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SlaPoliciesPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// NewCommentsRequest generates requests for Comments
-func NewCommentsRequest(server string, projectId string, issueId string, params *CommentsParams) (*http.Request, error) {
-	var err error
+	}
 
-	var pathParam0 string
+	return response, nil
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
+// ParseNewSlaPolicyResponse parses an HTTP response from a NewSlaPolicyWithResponse call
+func ParseNewSlaPolicyResponse(rsp *http.Response) (*NewSlaPolicyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	var pathParam1 string
+	response := &NewSlaPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SlaPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
-	if err != nil {
-		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
+	return response, nil
+}
+
+// ParseDeleteSlaPolicyResponse parses an HTTP response from a DeleteSlaPolicyWithResponse call
+func ParseDeleteSlaPolicyResponse(rsp *http.Response) (*DeleteSlaPolicyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	response := &DeleteSlaPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	queryURL, err := serverURL.Parse(operationPath)
+	return response, nil
+}
+
+// ParseGetSlaPolicyResponse parses an HTTP response from a GetSlaPolicyWithResponse call
+func ParseGetSlaPolicyResponse(rsp *http.Response) (*GetSlaPolicyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
-
-	if params.Q != nil {
+	response := &GetSlaPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SlaPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON200 = &dest
 
 	}
 
-	if params.Offset != nil {
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	return response, nil
+}
 
+// ParseUpdateSlaPolicyResponse parses an HTTP response from a UpdateSlaPolicyWithResponse call
+func ParseUpdateSlaPolicyResponse(rsp *http.Response) (*UpdateSlaPolicyResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
 
-	if params.Limit != nil {
+	response := &UpdateSlaPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SlaPolicy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
 		}
+		response.JSON200 = &dest
 
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	return response, nil
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// ParseUsersResponse parses an HTTP response from a UsersWithResponse call
+func ParseUsersResponse(rsp *http.Response) (*UsersResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
+	response := &UsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UsersPage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// NewNewCommentRequest calls the generic NewComment builder with application/json body
-func NewNewCommentRequest(server string, projectId string, issueId string, body NewCommentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// ParseNewUserResponse parses an HTTP response from a NewUserWithResponse call
+func ParseNewUserResponse(rsp *http.Response) (*NewUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewNewCommentRequestWithBody(server, projectId, issueId, "application/json", bodyReader)
-}
 
-// NewNewCommentRequestWithBody generates requests for NewComment with any type of body
-func NewNewCommentRequestWithBody(server string, projectId string, issueId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	response := &NewUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	var pathParam0 string
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
-	if err != nil {
-		return nil, err
 	}
 
-	var pathParam1 string
+	return response, nil
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
+func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
+	bodyBytes, err := ioutil.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
+	response := &GetUserResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	return response, nil
+}
 
-	req.Header.Add("Content-Type", contentType)
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get a list of customers.
+	// (GET /customers)
+	Customers(ctx echo.Context, params CustomersParams) error
+	// Create a customer.
+	// (POST /customers)
+	NewCustomer(ctx echo.Context) error
+
+	// (GET /customers/{id})
+	GetCustomer(ctx echo.Context, id string) error
+	// Update a customer.
+	// (PUT /customers/{id})
+	UpdateCustomer(ctx echo.Context, id string) error
+	// Get the issue taxonomy of a customer.
+	// (GET /customers/{id}/taxonomy)
+	GetCustomerTaxonomy(ctx echo.Context, id string) error
+	// Update the issue taxonomy of a customer.
+	// (PUT /customers/{id}/taxonomy)
+	UpdateCustomerTaxonomy(ctx echo.Context, id string) error
+	// Start an export.
+	// (POST /exports)
+	StartExport(ctx echo.Context) error
+	// Download an export.
+	// (GET /exports/{id}/archive)
+	DownloadExport(ctx echo.Context, id string) error
+	// Import issues.
+	// (POST /imports)
+	ImportIssues(ctx echo.Context) error
+	// Receive an inbound email.
+	// (POST /inbound/email)
+	ReceiveEmail(ctx echo.Context) error
+	// Get a list of jobs.
+	// (GET /jobs)
+	Jobs(ctx echo.Context, params JobsParams) error
+	// Get a job.
+	// (GET /jobs/{id})
+	GetJob(ctx echo.Context, id string) error
+	// Get a list of mentions.
+	// (GET /me/mentions)
+	Mentions(ctx echo.Context, params MentionsParams) error
+	// Get notification preferences.
+	// (GET /me/notification-preferences)
+	NotificationPreferences(ctx echo.Context) error
+	// Update notification preferences.
+	// (PUT /me/notification-preferences)
+	UpdateNotificationPreferences(ctx echo.Context) error
+	// Get a list of notifications.
+	// (GET /me/notifications)
+	Notifications(ctx echo.Context, params NotificationsParams) error
+	// Mark all notifications as read.
+	// (PUT /me/notifications/read)
+	ReadAllNotifications(ctx echo.Context) error
+	// Mark a notification as unread.
+	// (DELETE /me/notifications/{id}/read)
+	UnreadNotification(ctx echo.Context, id string) error
+	// Mark a notification as read.
+	// (PUT /me/notifications/{id}/read)
+	ReadNotification(ctx echo.Context, id string) error
+	// Get a list of watched issues.
+	// (GET /me/watching)
+	WatchedIssues(ctx echo.Context, params WatchedIssuesParams) error
+	// Get a list of projects.
+	// (GET /projects)
+	Projects(ctx echo.Context, params ProjectsParams) error
+	// Create a project.
+	// (POST /projects)
+	NewProject(ctx echo.Context) error
+	// Get a project.
+	// (GET /projects/{id})
+	GetProject(ctx echo.Context, id string) error
+	// Update a project.
+	// (PUT /projects/{id})
+	UpdateProject(ctx echo.Context, id string) error
+	// Get the issue taxonomy of a project.
+	// (GET /projects/{id}/taxonomy)
+	GetProjectTaxonomy(ctx echo.Context, id string) error
+	// Update the issue taxonomy of a project.
+	// (PUT /projects/{id}/taxonomy)
+	UpdateProjectTaxonomy(ctx echo.Context, id string) error
+	// Get a list of custom fields.
+	// (GET /projects/{project_id}/fields)
+	CustomFields(ctx echo.Context, projectId string) error
+	// Create a custom field.
+	// (POST /projects/{project_id}/fields)
+	NewCustomField(ctx echo.Context, projectId string) error
+
+	// (GET /projects/{project_id}/fields/{id})
+	GetCustomField(ctx echo.Context, projectId string, id string) error
+
+	// (PUT /projects/{project_id}/fields/{id})
+	UpdateCustomField(ctx echo.Context, projectId string, id string) error
+	// Get a list of issues.
+	// (GET /projects/{project_id}/issues)
+	Issues(ctx echo.Context, projectId string, params IssuesParams) error
+	// Create a issue.
+	// (POST /projects/{project_id}/issues)
+	NewIssue(ctx echo.Context, projectId string) error
+
+	// (GET /projects/{project_id}/issues/{id})
+	GetIssue(ctx echo.Context, projectId string, id string) error
+
+	// (PUT /projects/{project_id}/issues/{id})
+	UpdateIssue(ctx echo.Context, projectId string, id string) error
+	// Get the activity of an issue.
+	// (GET /projects/{project_id}/issues/{id}/activity)
+	IssueActivity(ctx echo.Context, projectId string, id string) error
+	// Get a list of child issues.
+	// (GET /projects/{project_id}/issues/{id}/children)
+	IssueChildren(ctx echo.Context, projectId string, id string) error
+	// Create a child issue.
+	// (POST /projects/{project_id}/issues/{id}/children)
+	NewChildIssue(ctx echo.Context, projectId string, id string) error
+	// Get a list of issue links.
+	// (GET /projects/{project_id}/issues/{id}/links)
+	IssueLinks(ctx echo.Context, projectId string, id string) error
+	// Link an issue to another issue.
+	// (POST /projects/{project_id}/issues/{id}/links)
+	NewIssueLink(ctx echo.Context, projectId string, id string) error
+	// Remove a link between issues.
+	// (DELETE /projects/{project_id}/issues/{id}/links/{link_id})
+	DeleteIssueLink(ctx echo.Context, projectId string, id string, linkId string) error
+	// Move an issue to another project.
+	// (POST /projects/{project_id}/issues/{id}/move)
+	MoveIssue(ctx echo.Context, projectId string, id string) error
+	// Get the revisions of an issue.
+	// (GET /projects/{project_id}/issues/{id}/revisions)
+	IssueRevisions(ctx echo.Context, projectId string, id string) error
+	// Change the state of an issue.
+	// (PUT /projects/{project_id}/issues/{id}/state)
+	TransitionIssue(ctx echo.Context, projectId string, id string) error
+	// Remove a vote for an issue.
+	// (DELETE /projects/{project_id}/issues/{id}/vote)
+	UnvoteIssue(ctx echo.Context, projectId string, id string) error
+	// Vote for an issue.
+	// (PUT /projects/{project_id}/issues/{id}/vote)
+	VoteIssue(ctx echo.Context, projectId string, id string) error
+	// Stop watching an issue.
+	// (DELETE /projects/{project_id}/issues/{id}/watch)
+	UnwatchIssue(ctx echo.Context, projectId string, id string) error
+	// Watch an issue.
+	// (PUT /projects/{project_id}/issues/{id}/watch)
+	WatchIssue(ctx echo.Context, projectId string, id string) error
+	// Get the watchers of an issue.
+	// (GET /projects/{project_id}/issues/{id}/watchers)
+	IssueWatchers(ctx echo.Context, projectId string, id string) error
+	// Get a list of attachments.
+	// (GET /projects/{project_id}/issues/{issue_id}/attachments)
+	Attachments(ctx echo.Context, projectId string, issueId string, params AttachmentsParams) error
+	// Upload an attachment.
+	// (POST /projects/{project_id}/issues/{issue_id}/attachments)
+	UploadAttachment(ctx echo.Context, projectId string, issueId string) error
+	// Delete an attachment.
+	// (DELETE /projects/{project_id}/issues/{issue_id}/attachments/{id})
+	DeleteAttachment(ctx echo.Context, projectId string, issueId string, id string) error
+	// Get an attachment.
+	// (GET /projects/{project_id}/issues/{issue_id}/attachments/{id})
+	GetAttachment(ctx echo.Context, projectId string, issueId string, id string) error
+	// Download an attachment.
+	// (GET /projects/{project_id}/issues/{issue_id}/attachments/{id}/content)
+	DownloadAttachment(ctx echo.Context, projectId string, issueId string, id string) error
+	// Get a list of Comments.
+	// (GET /projects/{project_id}/issues/{issue_id}/comments)
+	Comments(ctx echo.Context, projectId string, issueId string, params CommentsParams) error
+	// Create a comment on a issue.
+	// (POST /projects/{project_id}/issues/{issue_id}/comments)
+	NewComment(ctx echo.Context, projectId string, issueId string) error
+
+	// (DELETE /projects/{project_id}/issues/{issue_id}/comments/{id})
+	DeleteComment(ctx echo.Context, projectId string, issueId string, id string) error
+
+	// (GET /projects/{project_id}/issues/{issue_id}/comments/{id})
+	GetComment(ctx echo.Context, projectId string, issueId string, id string) error
+
+	// (PUT /projects/{project_id}/issues/{issue_id}/comments/{id})
+	UpdateComment(ctx echo.Context, projectId string, issueId string, id string) error
+	// Remove a reaction from a comment.
+	// (DELETE /projects/{project_id}/issues/{issue_id}/comments/{id}/reactions/{reaction})
+	RemoveReaction(ctx echo.Context, projectId string, issueId string, id string, reaction string) error
+	// React to a comment.
+	// (PUT /projects/{project_id}/issues/{issue_id}/comments/{id}/reactions/{reaction})
+	AddReaction(ctx echo.Context, projectId string, issueId string, id string, reaction string) error
+	// Get the revisions of a comment.
+	// (GET /projects/{project_id}/issues/{issue_id}/comments/{id}/revisions)
+	CommentRevisions(ctx echo.Context, projectId string, issueId string, id string) error
+	// Change many issues at once.
+	// (POST /projects/{project_id}/issues:bulk)
+	BulkUpdateIssues(ctx echo.Context, projectId string) error
+	// Get a list of milestones.
+	// (GET /projects/{project_id}/milestones)
+	Milestones(ctx echo.Context, projectId string, params MilestonesParams) error
+	// Create a milestone.
+	// (POST /projects/{project_id}/milestones)
+	NewMilestone(ctx echo.Context, projectId string) error
+
+	// (GET /projects/{project_id}/milestones/{id})
+	GetMilestone(ctx echo.Context, projectId string, id string) error
+
+	// (PUT /projects/{project_id}/milestones/{id})
+	UpdateMilestone(ctx echo.Context, projectId string, id string) error
+	// Close or reopen a milestone.
+	// (PUT /projects/{project_id}/milestones/{id}/state)
+	TransitionMilestone(ctx echo.Context, projectId string, id string) error
+	// Get the flow report of a project.
+	// (GET /projects/{project_id}/reports)
+	GetProjectReport(ctx echo.Context, projectId string, params GetProjectReportParams) error
+	// Get the SLA report of a project.
+	// (GET /projects/{project_id}/reports/sla)
+	GetSlaReport(ctx echo.Context, projectId string) error
+	// Get a list of SLA policies.
+	// (GET /sla-policies)
+	SlaPolicies(ctx echo.Context) error
+	// Create an SLA policy.
+	// (POST /sla-policies)
+	NewSlaPolicy(ctx echo.Context) error
+
+	// (DELETE /sla-policies/{id})
+	DeleteSlaPolicy(ctx echo.Context, id string) error
+
+	// (GET /sla-policies/{id})
+	GetSlaPolicy(ctx echo.Context, id string) error
+
+	// (PUT /sla-policies/{id})
+	UpdateSlaPolicy(ctx echo.Context, id string) error
+	// Get a list of users.
+	// (GET /users)
+	Users(ctx echo.Context, params UsersParams) error
+	// Create a user.
+	// (POST /users)
+	NewUser(ctx echo.Context) error
+
+	// (GET /users/{id})
+	GetUser(ctx echo.Context, id string) error
+}
 
-	return req, nil
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
 }
 
-// NewUpdateCommentRequest generates requests for UpdateComment
-func NewUpdateCommentRequest(server string, projectId string, issueId string, id string) (*http.Request, error) {
+// Customers converts echo context to params.
+func (w *ServerInterfaceWrapper) Customers(ctx echo.Context) error {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "project_id", runtime.ParamLocationPath, projectId)
-	if err != nil {
-		return nil, err
-	}
+	ctx.Set(OpenIdScopes, []string{"exitus/customer.read", "exitus/customer.admin"})
 
-	var pathParam1 string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CustomersParams
+	// ------------- Optional query parameter "q" -------------
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, issueId)
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
 
-	var pathParam2 string
+	// ------------- Optional query parameter "offset" -------------
 
-	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	serverURL, err := url.Parse(server)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	operationPath := fmt.Sprintf("/projects/%s/issues/%s/comments/%s", pathParam0, pathParam1, pathParam2)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Customers(ctx, params)
+	return err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// NewCustomer converts echo context to params.
+func (w *ServerInterfaceWrapper) NewCustomer(ctx echo.Context) error {
+	var err error
 
-	req, err := http.NewRequest("PUT", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	ctx.Set(OpenIdScopes, []string{"exitus/customer.write", "exitus/customer.admin"})
 
-	return req, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewCustomer(ctx)
+	return err
 }
 
-// NewUsersRequest generates requests for Users
-func NewUsersRequest(server string, params *UsersParams) (*http.Request, error) {
+// GetCustomer converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCustomer(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	serverURL, err := url.Parse(server)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	operationPath := fmt.Sprintf("/users")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	ctx.Set(OpenIdScopes, []string{"exitus/customer.read", "exitus/customer.admin"})
 
-	queryURL, err := serverURL.Parse(operationPath)
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCustomer(ctx, id)
+	return err
+}
+
+// UpdateCustomer converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCustomer(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	queryValues := queryURL.Query()
+	ctx.Set(OpenIdScopes, []string{"exitus/customer.write", "customers.admin"})
 
-	if params.Q != nil {
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateCustomer(ctx, id)
+	return err
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, *params.Q); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// GetCustomerTaxonomy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCustomerTaxonomy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	if params.Offset != nil {
+	ctx.Set(OpenIdScopes, []string{"exitus/customer.read", "exitus/customer.admin"})
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCustomerTaxonomy(ctx, id)
+	return err
+}
 
-	}
+// UpdateCustomerTaxonomy converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCustomerTaxonomy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	if params.Limit != nil {
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+	ctx.Set(OpenIdScopes, []string{"exitus/customer.write", "exitus/customer.admin"})
 
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateCustomerTaxonomy(ctx, id)
+	return err
+}
 
-	queryURL.RawQuery = queryValues.Encode()
+// StartExport converts echo context to params.
+func (w *ServerInterfaceWrapper) StartExport(ctx echo.Context) error {
+	var err error
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	ctx.Set(OpenIdScopes, []string{"exitus/export.write"})
 
-	return req, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartExport(ctx)
+	return err
 }
 
-// NewGetUserRequest generates requests for GetUser
-func NewGetUserRequest(server string, id string) (*http.Request, error) {
+// DownloadExport converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadExport(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "id", runtime.ParamLocationPath, id)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+	ctx.Set(OpenIdScopes, []string{"exitus/export.read"})
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DownloadExport(ctx, id)
+	return err
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
+// ImportIssues converts echo context to params.
+func (w *ServerInterfaceWrapper) ImportIssues(ctx echo.Context) error {
+	var err error
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	ctx.Set(OpenIdScopes, []string{"exitus/import.write"})
 
-	return req, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ImportIssues(ctx)
+	return err
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// ReceiveEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) ReceiveEmail(ctx echo.Context) error {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write", "exitus/comment.write"})
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return &ClientWithResponses{client}, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReceiveEmail(ctx)
+	return err
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+// Jobs converts echo context to params.
+func (w *ServerInterfaceWrapper) Jobs(ctx echo.Context) error {
+	var err error
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// Customers request
-	CustomersWithResponse(ctx context.Context, params *CustomersParams, reqEditors ...RequestEditorFn) (*CustomersResponse, error)
+	ctx.Set(OpenIdScopes, []string{"exitus/job.read"})
 
-	// NewCustomer request with any body
-	NewCustomerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCustomerResponse, error)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params JobsParams
+	// ------------- Optional query parameter "type" -------------
 
-	NewCustomerWithResponse(ctx context.Context, body NewCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCustomerResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
 
-	// GetCustomer request
-	GetCustomerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetCustomerResponse, error)
+	// ------------- Optional query parameter "status" -------------
 
-	// UpdateCustomer request with any body
-	UpdateCustomerWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomerResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
 
-	UpdateCustomerWithResponse(ctx context.Context, id string, body UpdateCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomerResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	// Projects request
-	ProjectsWithResponse(ctx context.Context, params *ProjectsParams, reqEditors ...RequestEditorFn) (*ProjectsResponse, error)
+	// ------------- Optional query parameter "limit" -------------
 
-	// NewProject request with any body
-	NewProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewProjectResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	NewProjectWithResponse(ctx context.Context, body NewProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*NewProjectResponse, error)
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Jobs(ctx, params)
+	return err
+}
 
-	// GetProject request
-	GetProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error)
+// GetJob converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	// UpdateProject request with any body
-	UpdateProjectWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-	UpdateProjectWithResponse(ctx context.Context, id string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error)
+	ctx.Set(OpenIdScopes, []string{"exitus/job.read"})
 
-	// Issues request
-	IssuesWithResponse(ctx context.Context, projectId string, params *IssuesParams, reqEditors ...RequestEditorFn) (*IssuesResponse, error)
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetJob(ctx, id)
+	return err
+}
 
-	// NewIssue request with any body
-	NewIssueWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewIssueResponse, error)
+// Mentions converts echo context to params.
+func (w *ServerInterfaceWrapper) Mentions(ctx echo.Context) error {
+	var err error
 
-	NewIssueWithResponse(ctx context.Context, projectId string, body NewIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*NewIssueResponse, error)
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
 
-	// GetIssue request
-	GetIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*GetIssueResponse, error)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MentionsParams
+	// ------------- Optional query parameter "offset" -------------
 
-	// UpdateIssue request
-	UpdateIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*UpdateIssueResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
 
-	// Comments request
-	CommentsWithResponse(ctx context.Context, projectId string, issueId string, params *CommentsParams, reqEditors ...RequestEditorFn) (*CommentsResponse, error)
+	// ------------- Optional query parameter "limit" -------------
 
-	// NewComment request with any body
-	NewCommentWithBodyWithResponse(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCommentResponse, error)
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-	NewCommentWithResponse(ctx context.Context, projectId string, issueId string, body NewCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCommentResponse, error)
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Mentions(ctx, params)
+	return err
+}
 
-	// UpdateComment request
-	UpdateCommentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*UpdateCommentResponse, error)
+// NotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) NotificationPreferences(ctx echo.Context) error {
+	var err error
 
-	// Users request
-	UsersWithResponse(ctx context.Context, params *UsersParams, reqEditors ...RequestEditorFn) (*UsersResponse, error)
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
 
-	// GetUser request
-	GetUserWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUserResponse, error)
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NotificationPreferences(ctx)
+	return err
 }
 
-type CustomersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CustomersPage
-}
+// UpdateNotificationPreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateNotificationPreferences(ctx echo.Context) error {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r CustomersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CustomersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateNotificationPreferences(ctx)
+	return err
 }
 
-type NewCustomerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Customer
-}
+// Notifications converts echo context to params.
+func (w *ServerInterfaceWrapper) Notifications(ctx echo.Context) error {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r NewCustomerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r NewCustomerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// Parameter object where we will unmarshal all parameters from the context
+	var params NotificationsParams
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", ctx.QueryParams(), &params.Unread)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter unread: %s", err))
 	}
-	return 0
-}
 
-type GetCustomerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Customer
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// Status returns HTTPResponse.Status
-func (r GetCustomerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCustomerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return 0
-}
 
-type UpdateCustomerResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Customer
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Notifications(ctx, params)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateCustomerResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// ReadAllNotifications converts echo context to params.
+func (w *ServerInterfaceWrapper) ReadAllNotifications(ctx echo.Context) error {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCustomerResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
 
-type ProjectsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ProjectsPage
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReadAllNotifications(ctx)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r ProjectsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// UnreadNotification converts echo context to params.
+func (w *ServerInterfaceWrapper) UnreadNotification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ProjectsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return 0
-}
 
-type NewProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Project
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
 
-// Status returns HTTPResponse.Status
-func (r NewProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnreadNotification(ctx, id)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r NewProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ReadNotification converts echo context to params.
+func (w *ServerInterfaceWrapper) ReadNotification(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return 0
-}
 
-type GetProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Project
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
 
-// Status returns HTTPResponse.Status
-func (r GetProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ReadNotification(ctx, id)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// WatchedIssues converts echo context to params.
+func (w *ServerInterfaceWrapper) WatchedIssues(ctx echo.Context) error {
+	var err error
 
-type UpdateProjectResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Project
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
 
-// Status returns HTTPResponse.Status
-func (r UpdateProjectResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchedIssuesParams
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateProjectResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return 0
-}
 
-type IssuesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *IssuesPage
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WatchedIssues(ctx, params)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r IssuesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// Projects converts echo context to params.
+func (w *ServerInterfaceWrapper) Projects(ctx echo.Context) error {
+	var err error
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r IssuesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProjectsParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
-	return 0
-}
 
-type NewIssueResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Issue
-}
+	// ------------- Optional query parameter "offset" -------------
 
-// Status returns HTTPResponse.Status
-func (r NewIssueResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r NewIssueResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return 0
-}
 
-type GetIssueResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Issue
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Projects(ctx, params)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r GetIssueResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+// NewProject converts echo context to params.
+func (w *ServerInterfaceWrapper) NewProject(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewProject(ctx)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetIssueResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetProject converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return 0
-}
 
-type UpdateIssueResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpdatedIssue
+	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProject(ctx, id)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateIssueResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateProject converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateProject(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return http.StatusText(0)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateProject(ctx, id)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateIssueResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetProjectTaxonomy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectTaxonomy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return 0
-}
 
-type CommentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]CommentsPage
+	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetProjectTaxonomy(ctx, id)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r CommentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// UpdateProjectTaxonomy converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateProjectTaxonomy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return http.StatusText(0)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateProjectTaxonomy(ctx, id)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CommentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// CustomFields converts echo context to params.
+func (w *ServerInterfaceWrapper) CustomFields(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return 0
-}
 
-type NewCommentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Comment
+	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CustomFields(ctx, projectId)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r NewCommentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewCustomField converts echo context to params.
+func (w *ServerInterfaceWrapper) NewCustomField(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return http.StatusText(0)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewCustomField(ctx, projectId)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r NewCommentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// GetCustomField converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCustomField(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return 0
-}
 
-type UpdateCommentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UpdatedComment
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// Status returns HTTPResponse.Status
-func (r UpdateCommentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return http.StatusText(0)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCustomField(ctx, projectId, id)
+	return err
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCommentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// UpdateCustomField converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateCustomField(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return 0
-}
 
-type UsersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *UsersPage
-}
+	// ------------- Path parameter "id" -------------
+	var id string
 
-// Status returns HTTPResponse.Status
-func (r UsersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UsersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
 
-type GetUserResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *User
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateCustomField(ctx, projectId, id)
+	return err
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUserResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+// Issues converts echo context to params.
+func (w *ServerInterfaceWrapper) Issues(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUserResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return 0
-}
 
-// CustomersWithResponse request returning *CustomersResponse
-func (c *ClientWithResponses) CustomersWithResponse(ctx context.Context, params *CustomersParams, reqEditors ...RequestEditorFn) (*CustomersResponse, error) {
-	rsp, err := c.Customers(ctx, params, reqEditors...)
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params IssuesParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
-	return ParseCustomersResponse(rsp)
-}
 
-// NewCustomerWithBodyWithResponse request with arbitrary body returning *NewCustomerResponse
-func (c *ClientWithResponses) NewCustomerWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCustomerResponse, error) {
-	rsp, err := c.NewCustomerWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
-	return ParseNewCustomerResponse(rsp)
-}
 
-func (c *ClientWithResponses) NewCustomerWithResponse(ctx context.Context, body NewCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCustomerResponse, error) {
-	rsp, err := c.NewCustomer(ctx, body, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
-	return ParseNewCustomerResponse(rsp)
-}
 
-// GetCustomerWithResponse request returning *GetCustomerResponse
-func (c *ClientWithResponses) GetCustomerWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetCustomerResponse, error) {
-	rsp, err := c.GetCustomer(ctx, id, reqEditors...)
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
 	}
-	return ParseGetCustomerResponse(rsp)
-}
 
-// UpdateCustomerWithBodyWithResponse request with arbitrary body returning *UpdateCustomerResponse
-func (c *ClientWithResponses) UpdateCustomerWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCustomerResponse, error) {
-	rsp, err := c.UpdateCustomerWithBody(ctx, id, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("pipeDelimited", true, false, "filter", ctx.QueryParams(), &params.Filter)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
 	}
-	return ParseUpdateCustomerResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateCustomerWithResponse(ctx context.Context, id string, body UpdateCustomerJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCustomerResponse, error) {
-	rsp, err := c.UpdateCustomer(ctx, id, body, reqEditors...)
+	// ------------- Optional query parameter "due_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due_before", ctx.QueryParams(), &params.DueBefore)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due_before: %s", err))
 	}
-	return ParseUpdateCustomerResponse(rsp)
-}
 
-// ProjectsWithResponse request returning *ProjectsResponse
-func (c *ClientWithResponses) ProjectsWithResponse(ctx context.Context, params *ProjectsParams, reqEditors ...RequestEditorFn) (*ProjectsResponse, error) {
-	rsp, err := c.Projects(ctx, params, reqEditors...)
+	// ------------- Optional query parameter "overdue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "overdue", ctx.QueryParams(), &params.Overdue)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter overdue: %s", err))
 	}
-	return ParseProjectsResponse(rsp)
-}
 
-// NewProjectWithBodyWithResponse request with arbitrary body returning *NewProjectResponse
-func (c *ClientWithResponses) NewProjectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewProjectResponse, error) {
-	rsp, err := c.NewProjectWithBody(ctx, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "columns" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "columns", ctx.QueryParams(), &params.Columns)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columns: %s", err))
 	}
-	return ParseNewProjectResponse(rsp)
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Issues(ctx, projectId, params)
+	return err
 }
 
-func (c *ClientWithResponses) NewProjectWithResponse(ctx context.Context, body NewProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*NewProjectResponse, error) {
-	rsp, err := c.NewProject(ctx, body, reqEditors...)
+// NewIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) NewIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseNewProjectResponse(rsp)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewIssue(ctx, projectId)
+	return err
 }
 
-// GetProjectWithResponse request returning *GetProjectResponse
-func (c *ClientWithResponses) GetProjectWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetProjectResponse, error) {
-	rsp, err := c.GetProject(ctx, id, reqEditors...)
+// GetIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) GetIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseGetProjectResponse(rsp)
-}
 
-// UpdateProjectWithBodyWithResponse request with arbitrary body returning *UpdateProjectResponse
-func (c *ClientWithResponses) UpdateProjectWithBodyWithResponse(ctx context.Context, id string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
-	rsp, err := c.UpdateProjectWithBody(ctx, id, contentType, body, reqEditors...)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return ParseUpdateProjectResponse(rsp)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetIssue(ctx, projectId, id)
+	return err
 }
 
-func (c *ClientWithResponses) UpdateProjectWithResponse(ctx context.Context, id string, body UpdateProjectJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateProjectResponse, error) {
-	rsp, err := c.UpdateProject(ctx, id, body, reqEditors...)
+// UpdateIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseUpdateProjectResponse(rsp)
-}
 
-// IssuesWithResponse request returning *IssuesResponse
-func (c *ClientWithResponses) IssuesWithResponse(ctx context.Context, projectId string, params *IssuesParams, reqEditors ...RequestEditorFn) (*IssuesResponse, error) {
-	rsp, err := c.Issues(ctx, projectId, params, reqEditors...)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return ParseIssuesResponse(rsp)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UpdateIssue(ctx, projectId, id)
+	return err
 }
 
-// NewIssueWithBodyWithResponse request with arbitrary body returning *NewIssueResponse
-func (c *ClientWithResponses) NewIssueWithBodyWithResponse(ctx context.Context, projectId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewIssueResponse, error) {
-	rsp, err := c.NewIssueWithBody(ctx, projectId, contentType, body, reqEditors...)
+// IssueActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) IssueActivity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseNewIssueResponse(rsp)
-}
 
-func (c *ClientWithResponses) NewIssueWithResponse(ctx context.Context, projectId string, body NewIssueJSONRequestBody, reqEditors ...RequestEditorFn) (*NewIssueResponse, error) {
-	rsp, err := c.NewIssue(ctx, projectId, body, reqEditors...)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return ParseNewIssueResponse(rsp)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.IssueActivity(ctx, projectId, id)
+	return err
 }
 
-// GetIssueWithResponse request returning *GetIssueResponse
-func (c *ClientWithResponses) GetIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*GetIssueResponse, error) {
-	rsp, err := c.GetIssue(ctx, projectId, id, reqEditors...)
+// IssueChildren converts echo context to params.
+func (w *ServerInterfaceWrapper) IssueChildren(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseGetIssueResponse(rsp)
-}
 
-// UpdateIssueWithResponse request returning *UpdateIssueResponse
-func (c *ClientWithResponses) UpdateIssueWithResponse(ctx context.Context, projectId string, id string, reqEditors ...RequestEditorFn) (*UpdateIssueResponse, error) {
-	rsp, err := c.UpdateIssue(ctx, projectId, id, reqEditors...)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return ParseUpdateIssueResponse(rsp)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.IssueChildren(ctx, projectId, id)
+	return err
 }
 
-// CommentsWithResponse request returning *CommentsResponse
-func (c *ClientWithResponses) CommentsWithResponse(ctx context.Context, projectId string, issueId string, params *CommentsParams, reqEditors ...RequestEditorFn) (*CommentsResponse, error) {
-	rsp, err := c.Comments(ctx, projectId, issueId, params, reqEditors...)
+// NewChildIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) NewChildIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseCommentsResponse(rsp)
-}
 
-// NewCommentWithBodyWithResponse request with arbitrary body returning *NewCommentResponse
-func (c *ClientWithResponses) NewCommentWithBodyWithResponse(ctx context.Context, projectId string, issueId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*NewCommentResponse, error) {
-	rsp, err := c.NewCommentWithBody(ctx, projectId, issueId, contentType, body, reqEditors...)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return ParseNewCommentResponse(rsp)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewChildIssue(ctx, projectId, id)
+	return err
 }
 
-func (c *ClientWithResponses) NewCommentWithResponse(ctx context.Context, projectId string, issueId string, body NewCommentJSONRequestBody, reqEditors ...RequestEditorFn) (*NewCommentResponse, error) {
-	rsp, err := c.NewComment(ctx, projectId, issueId, body, reqEditors...)
+// IssueLinks converts echo context to params.
+func (w *ServerInterfaceWrapper) IssueLinks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseNewCommentResponse(rsp)
-}
 
-// UpdateCommentWithResponse request returning *UpdateCommentResponse
-func (c *ClientWithResponses) UpdateCommentWithResponse(ctx context.Context, projectId string, issueId string, id string, reqEditors ...RequestEditorFn) (*UpdateCommentResponse, error) {
-	rsp, err := c.UpdateComment(ctx, projectId, issueId, id, reqEditors...)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return ParseUpdateCommentResponse(rsp)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.IssueLinks(ctx, projectId, id)
+	return err
 }
 
-// UsersWithResponse request returning *UsersResponse
-func (c *ClientWithResponses) UsersWithResponse(ctx context.Context, params *UsersParams, reqEditors ...RequestEditorFn) (*UsersResponse, error) {
-	rsp, err := c.Users(ctx, params, reqEditors...)
+// NewIssueLink converts echo context to params.
+func (w *ServerInterfaceWrapper) NewIssueLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
-	return ParseUsersResponse(rsp)
-}
 
-// GetUserWithResponse request returning *GetUserResponse
-func (c *ClientWithResponses) GetUserWithResponse(ctx context.Context, id string, reqEditors ...RequestEditorFn) (*GetUserResponse, error) {
-	rsp, err := c.GetUser(ctx, id, reqEditors...)
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
-	return ParseGetUserResponse(rsp)
+
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewIssueLink(ctx, projectId, id)
+	return err
 }
 
-// ParseCustomersResponse parses an HTTP response from a CustomersWithResponse call
-func ParseCustomersResponse(rsp *http.Response) (*CustomersResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteIssueLink converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteIssueLink(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	response := &CustomersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CustomersPage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "link_id" -------------
+	var linkId string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "link_id", runtime.ParamLocationPath, ctx.Param("link_id"), &linkId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter link_id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteIssueLink(ctx, projectId, id, linkId)
+	return err
 }
 
-// ParseNewCustomerResponse parses an HTTP response from a NewCustomerWithResponse call
-func ParseNewCustomerResponse(rsp *http.Response) (*NewCustomerResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// MoveIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) MoveIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &NewCustomerResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Customer
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.MoveIssue(ctx, projectId, id)
+	return err
 }
 
-// ParseGetCustomerResponse parses an HTTP response from a GetCustomerWithResponse call
-func ParseGetCustomerResponse(rsp *http.Response) (*GetCustomerResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// IssueRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) IssueRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &GetCustomerResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Customer
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.IssueRevisions(ctx, projectId, id)
+	return err
 }
 
-// ParseUpdateCustomerResponse parses an HTTP response from a UpdateCustomerWithResponse call
-func ParseUpdateCustomerResponse(rsp *http.Response) (*UpdateCustomerResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// TransitionIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) TransitionIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &UpdateCustomerResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Customer
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.TransitionIssue(ctx, projectId, id)
+	return err
 }
 
-// ParseProjectsResponse parses an HTTP response from a ProjectsWithResponse call
-func ParseProjectsResponse(rsp *http.Response) (*ProjectsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// UnvoteIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) UnvoteIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &ProjectsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ProjectsPage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnvoteIssue(ctx, projectId, id)
+	return err
 }
 
-// ParseNewProjectResponse parses an HTTP response from a NewProjectWithResponse call
-func ParseNewProjectResponse(rsp *http.Response) (*NewProjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// VoteIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) VoteIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &NewProjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Project
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.VoteIssue(ctx, projectId, id)
+	return err
 }
 
-// ParseGetProjectResponse parses an HTTP response from a GetProjectWithResponse call
-func ParseGetProjectResponse(rsp *http.Response) (*GetProjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// UnwatchIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) UnwatchIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &GetProjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Project
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UnwatchIssue(ctx, projectId, id)
+	return err
 }
 
-// ParseUpdateProjectResponse parses an HTTP response from a UpdateProjectWithResponse call
-func ParseUpdateProjectResponse(rsp *http.Response) (*UpdateProjectResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// WatchIssue converts echo context to params.
+func (w *ServerInterfaceWrapper) WatchIssue(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &UpdateProjectResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Project
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WatchIssue(ctx, projectId, id)
+	return err
 }
 
-// ParseIssuesResponse parses an HTTP response from a IssuesWithResponse call
-func ParseIssuesResponse(rsp *http.Response) (*IssuesResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// IssueWatchers converts echo context to params.
+func (w *ServerInterfaceWrapper) IssueWatchers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &IssuesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest IssuesPage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.IssueWatchers(ctx, projectId, id)
+	return err
 }
 
-// ParseNewIssueResponse parses an HTTP response from a NewIssueWithResponse call
-func ParseNewIssueResponse(rsp *http.Response) (*NewIssueResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// Attachments converts echo context to params.
+func (w *ServerInterfaceWrapper) Attachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	response := &NewIssueResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Issue
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AttachmentsParams
+	// ------------- Optional query parameter "comment_id" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "comment_id", ctx.QueryParams(), &params.CommentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment_id: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Attachments(ctx, projectId, issueId, params)
+	return err
 }
 
-// ParseGetIssueResponse parses an HTTP response from a GetIssueWithResponse call
-func ParseGetIssueResponse(rsp *http.Response) (*GetIssueResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// UploadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) UploadAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &GetIssueResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Issue
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.UploadAttachment(ctx, projectId, issueId)
+	return err
 }
 
-// ParseUpdateIssueResponse parses an HTTP response from a UpdateIssueWithResponse call
-func ParseUpdateIssueResponse(rsp *http.Response) (*UpdateIssueResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DeleteAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	response := &UpdateIssueResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UpdatedIssue
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteAttachment(ctx, projectId, issueId, id)
+	return err
 }
 
-// ParseCommentsResponse parses an HTTP response from a CommentsWithResponse call
-func ParseCommentsResponse(rsp *http.Response) (*CommentsResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	response := &CommentsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []CommentsPage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAttachment(ctx, projectId, issueId, id)
+	return err
 }
 
-// ParseNewCommentResponse parses an HTTP response from a NewCommentWithResponse call
-func ParseNewCommentResponse(rsp *http.Response) (*NewCommentResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DownloadAttachment converts echo context to params.
+func (w *ServerInterfaceWrapper) DownloadAttachment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	response := &NewCommentResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Comment
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "id" -------------
+	var id string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DownloadAttachment(ctx, projectId, issueId, id)
+	return err
 }
 
-// ParseUpdateCommentResponse parses an HTTP response from a UpdateCommentWithResponse call
-func ParseUpdateCommentResponse(rsp *http.Response) (*UpdateCommentResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// Comments converts echo context to params.
+func (w *ServerInterfaceWrapper) Comments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &UpdateCommentResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UpdatedComment
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	return response, nil
-}
+	ctx.Set(OpenIdScopes, []string{"exitus/comment.read"})
 
-// ParseUsersResponse parses an HTTP response from a UsersWithResponse call
-func ParseUsersResponse(rsp *http.Response) (*UsersResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CommentsParams
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
 	if err != nil {
-		return nil, err
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
 	}
 
-	response := &UsersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest UsersPage
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
 	}
 
-	return response, nil
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Comments(ctx, projectId, issueId, params)
+	return err
 }
 
-// ParseGetUserResponse parses an HTTP response from a GetUserWithResponse call
-func ParseGetUserResponse(rsp *http.Response) (*GetUserResponse, error) {
-	bodyBytes, err := ioutil.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// NewComment converts echo context to params.
+func (w *ServerInterfaceWrapper) NewComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	response := &GetUserResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
 
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	return response, nil
+	ctx.Set(OpenIdScopes, []string{"exitus/comment.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewComment(ctx, projectId, issueId)
+	return err
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get a list of customers.
-	// (GET /customers)
-	Customers(ctx echo.Context, params CustomersParams) error
-	// Create a customer.
-	// (POST /customers)
-	NewCustomer(ctx echo.Context) error
+// DeleteComment converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteComment(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	// (GET /customers/{id})
-	GetCustomer(ctx echo.Context, id string) error
-	// Update a customer.
-	// (PUT /customers/{id})
-	UpdateCustomer(ctx echo.Context, id string) error
-	// Get a list of projects.
-	// (GET /projects)
-	Projects(ctx echo.Context, params ProjectsParams) error
-	// Create a project.
-	// (POST /projects)
-	NewProject(ctx echo.Context) error
-	// Get a project.
-	// (GET /projects/{id})
-	GetProject(ctx echo.Context, id string) error
-	// Update a project.
-	// (PUT /projects/{id})
-	UpdateProject(ctx echo.Context, id string) error
-	// Get a list of issues.
-	// (GET /projects/{project_id}/issues)
-	Issues(ctx echo.Context, projectId string, params IssuesParams) error
-	// Create a issue.
-	// (POST /projects/{project_id}/issues)
-	NewIssue(ctx echo.Context, projectId string) error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
 
-	// (GET /projects/{project_id}/issues/{id})
-	GetIssue(ctx echo.Context, projectId string, id string) error
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
 
-	// (PUT /projects/{project_id}/issues/{id})
-	UpdateIssue(ctx echo.Context, projectId string, id string) error
-	// Get a list of Comments.
-	// (GET /projects/{project_id}/issues/{issue_id}/comments)
-	Comments(ctx echo.Context, projectId string, issueId string, params CommentsParams) error
-	// Create a comment on a issue.
-	// (POST /projects/{project_id}/issues/{issue_id}/comments)
-	NewComment(ctx echo.Context, projectId string, issueId string) error
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
+	}
 
-	// (PUT /projects/{project_id}/issues/{issue_id}/comments/{id})
-	UpdateComment(ctx echo.Context, projectId string, issueId string, id string) error
-	// Get a list of users.
-	// (GET /users)
-	Users(ctx echo.Context, params UsersParams) error
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	// (GET /users/{id})
-	GetUser(ctx echo.Context, id string) error
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	ctx.Set(OpenIdScopes, []string{"exitus/comment.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteComment(ctx, projectId, issueId, id)
+	return err
 }
 
-// Customers converts echo context to params.
-func (w *ServerInterfaceWrapper) Customers(ctx echo.Context) error {
+// GetComment converts echo context to params.
+func (w *ServerInterfaceWrapper) GetComment(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	ctx.Set(OpenIdScopes, []string{"exitus/customer.read", "exitus/customer.admin"})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CustomersParams
-	// ------------- Optional query parameter "q" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(OpenIdScopes, []string{"exitus/comment.read"})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.Customers(ctx, params)
+	err = w.Handler.GetComment(ctx, projectId, issueId, id)
 	return err
 }
 
-// NewCustomer converts echo context to params.
-func (w *ServerInterfaceWrapper) NewCustomer(ctx echo.Context) error {
+// UpdateComment converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateComment(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/customer.write", "exitus/customer.admin"})
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.NewCustomer(ctx)
-	return err
-}
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
+	}
 
-// GetCustomer converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCustomer(ctx echo.Context) error {
-	var err error
 	// ------------- Path parameter "id" -------------
 	var id string
 
@@ -2930,16 +13868,32 @@ func (w *ServerInterfaceWrapper) GetCustomer(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/customer.read", "exitus/customer.admin"})
+	ctx.Set(OpenIdScopes, []string{"exitus/comment.write"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetCustomer(ctx, id)
+	err = w.Handler.UpdateComment(ctx, projectId, issueId, id)
 	return err
 }
 
-// UpdateCustomer converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateCustomer(ctx echo.Context) error {
+// RemoveReaction converts echo context to params.
+func (w *ServerInterfaceWrapper) RemoveReaction(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
+
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
+	}
+
 	// ------------- Path parameter "id" -------------
 	var id string
 
@@ -2948,61 +13902,82 @@ func (w *ServerInterfaceWrapper) UpdateCustomer(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/customer.write", "customers.admin"})
+	// ------------- Path parameter "reaction" -------------
+	var reaction string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "reaction", runtime.ParamLocationPath, ctx.Param("reaction"), &reaction)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reaction: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"exitus/comment.write"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.UpdateCustomer(ctx, id)
+	err = w.Handler.RemoveReaction(ctx, projectId, issueId, id, reaction)
 	return err
 }
 
-// Projects converts echo context to params.
-func (w *ServerInterfaceWrapper) Projects(ctx echo.Context) error {
+// AddReaction converts echo context to params.
+func (w *ServerInterfaceWrapper) AddReaction(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ProjectsParams
-	// ------------- Optional query parameter "q" -------------
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
 
-	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "reaction" -------------
+	var reaction string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "reaction", runtime.ParamLocationPath, ctx.Param("reaction"), &reaction)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reaction: %s", err))
 	}
 
+	ctx.Set(OpenIdScopes, []string{"exitus/comment.write"})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.Projects(ctx, params)
+	err = w.Handler.AddReaction(ctx, projectId, issueId, id, reaction)
 	return err
 }
 
-// NewProject converts echo context to params.
-func (w *ServerInterfaceWrapper) NewProject(ctx echo.Context) error {
+// CommentRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) CommentRevisions(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.NewProject(ctx)
-	return err
-}
+	// ------------- Path parameter "issue_id" -------------
+	var issueId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
+	}
 
-// GetProject converts echo context to params.
-func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
-	var err error
 	// ------------- Path parameter "id" -------------
 	var id string
 
@@ -3011,33 +13986,33 @@ func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
+	ctx.Set(OpenIdScopes, []string{"exitus/comment.read"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetProject(ctx, id)
+	err = w.Handler.CommentRevisions(ctx, projectId, issueId, id)
 	return err
 }
 
-// UpdateProject converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateProject(ctx echo.Context) error {
+// BulkUpdateIssues converts echo context to params.
+func (w *ServerInterfaceWrapper) BulkUpdateIssues(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "id" -------------
-	var id string
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
+	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.UpdateProject(ctx, id)
+	err = w.Handler.BulkUpdateIssues(ctx, projectId)
 	return err
 }
 
-// Issues converts echo context to params.
-func (w *ServerInterfaceWrapper) Issues(ctx echo.Context) error {
+// Milestones converts echo context to params.
+func (w *ServerInterfaceWrapper) Milestones(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "project_id" -------------
 	var projectId string
@@ -3047,38 +14022,42 @@ func (w *ServerInterfaceWrapper) Issues(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params IssuesParams
-	// ------------- Optional query parameter "q" -------------
+	var params MilestonesParams
+	// ------------- Optional query parameter "state" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.Milestones(ctx, projectId, params)
+	return err
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// NewMilestone converts echo context to params.
+func (w *ServerInterfaceWrapper) NewMilestone(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "project_id" -------------
+	var projectId string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
+	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.Issues(ctx, projectId, params)
+	err = w.Handler.NewMilestone(ctx, projectId)
 	return err
 }
 
-// NewIssue converts echo context to params.
-func (w *ServerInterfaceWrapper) NewIssue(ctx echo.Context) error {
+// GetMilestone converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMilestone(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "project_id" -------------
 	var projectId string
@@ -3088,15 +14067,23 @@ func (w *ServerInterfaceWrapper) NewIssue(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(OpenIdScopes, []string{"exitus/project.read"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.NewIssue(ctx, projectId)
+	err = w.Handler.GetMilestone(ctx, projectId, id)
 	return err
 }
 
-// GetIssue converts echo context to params.
-func (w *ServerInterfaceWrapper) GetIssue(ctx echo.Context) error {
+// UpdateMilestone converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateMilestone(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "project_id" -------------
 	var projectId string
@@ -3114,15 +14101,15 @@ func (w *ServerInterfaceWrapper) GetIssue(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/issue.read"})
+	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.GetIssue(ctx, projectId, id)
+	err = w.Handler.UpdateMilestone(ctx, projectId, id)
 	return err
 }
 
-// UpdateIssue converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateIssue(ctx echo.Context) error {
+// TransitionMilestone converts echo context to params.
+func (w *ServerInterfaceWrapper) TransitionMilestone(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "project_id" -------------
 	var projectId string
@@ -3140,15 +14127,15 @@ func (w *ServerInterfaceWrapper) UpdateIssue(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/issue.write"})
+	ctx.Set(OpenIdScopes, []string{"exitus/project.write"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.UpdateIssue(ctx, projectId, id)
+	err = w.Handler.TransitionMilestone(ctx, projectId, id)
 	return err
 }
 
-// Comments converts echo context to params.
-func (w *ServerInterfaceWrapper) Comments(ctx echo.Context) error {
+// GetProjectReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectReport(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "project_id" -------------
 	var projectId string
@@ -3158,46 +14145,55 @@ func (w *ServerInterfaceWrapper) Comments(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	// ------------- Path parameter "issue_id" -------------
-	var issueId string
+	ctx.Set(OpenIdScopes, []string{"exitus/report.read"})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectReportParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/comment.read"})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CommentsParams
-	// ------------- Optional query parameter "q" -------------
+	// ------------- Optional query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "q", ctx.QueryParams(), &params.Q)
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "interval" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "interval", ctx.QueryParams(), &params.Interval)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter interval: %s", err))
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-None-Match, got %d", n))
+		}
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, valueList[0], &IfNoneMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-None-Match: %s", err))
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
 	}
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.Comments(ctx, projectId, issueId, params)
+	err = w.Handler.GetProjectReport(ctx, projectId, params)
 	return err
 }
 
-// NewComment converts echo context to params.
-func (w *ServerInterfaceWrapper) NewComment(ctx echo.Context) error {
+// GetSlaReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSlaReport(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "project_id" -------------
 	var projectId string
@@ -3207,40 +14203,74 @@ func (w *ServerInterfaceWrapper) NewComment(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
 	}
 
-	// ------------- Path parameter "issue_id" -------------
-	var issueId string
+	ctx.Set(OpenIdScopes, []string{"exitus/report.read"})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
-	}
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSlaReport(ctx, projectId)
+	return err
+}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/comment.write"})
+// SlaPolicies converts echo context to params.
+func (w *ServerInterfaceWrapper) SlaPolicies(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"exitus/sla.read"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.NewComment(ctx, projectId, issueId)
+	err = w.Handler.SlaPolicies(ctx)
 	return err
 }
 
-// UpdateComment converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateComment(ctx echo.Context) error {
+// NewSlaPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) NewSlaPolicy(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "project_id" -------------
-	var projectId string
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "project_id", runtime.ParamLocationPath, ctx.Param("project_id"), &projectId)
+	ctx.Set(OpenIdScopes, []string{"exitus/sla.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewSlaPolicy(ctx)
+	return err
+}
+
+// DeleteSlaPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSlaPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// ------------- Path parameter "issue_id" -------------
-	var issueId string
+	ctx.Set(OpenIdScopes, []string{"exitus/sla.write"})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "issue_id", runtime.ParamLocationPath, ctx.Param("issue_id"), &issueId)
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DeleteSlaPolicy(ctx, id)
+	return err
+}
+
+// GetSlaPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSlaPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "id", runtime.ParamLocationPath, ctx.Param("id"), &id)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter issue_id: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(OpenIdScopes, []string{"exitus/sla.read"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetSlaPolicy(ctx, id)
+	return err
+}
+
+// UpdateSlaPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateSlaPolicy(ctx echo.Context) error {
+	var err error
 	// ------------- Path parameter "id" -------------
 	var id string
 
@@ -3249,10 +14279,10 @@ func (w *ServerInterfaceWrapper) UpdateComment(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	ctx.Set(OpenIdScopes, []string{"exitus/comment.write"})
+	ctx.Set(OpenIdScopes, []string{"exitus/sla.write"})
 
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.UpdateComment(ctx, projectId, issueId, id)
+	err = w.Handler.UpdateSlaPolicy(ctx, id)
 	return err
 }
 
@@ -3290,6 +14320,17 @@ func (w *ServerInterfaceWrapper) Users(ctx echo.Context) error {
 	return err
 }
 
+// NewUser converts echo context to params.
+func (w *ServerInterfaceWrapper) NewUser(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(OpenIdScopes, []string{"exitus/user.write"})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.NewUser(ctx)
+	return err
+}
+
 // GetUser converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUser(ctx echo.Context) error {
 	var err error
@@ -3340,18 +14381,78 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/customers", wrapper.NewCustomer)
 	router.GET(baseURL+"/customers/:id", wrapper.GetCustomer)
 	router.PUT(baseURL+"/customers/:id", wrapper.UpdateCustomer)
+	router.GET(baseURL+"/customers/:id/taxonomy", wrapper.GetCustomerTaxonomy)
+	router.PUT(baseURL+"/customers/:id/taxonomy", wrapper.UpdateCustomerTaxonomy)
+	router.POST(baseURL+"/exports", wrapper.StartExport)
+	router.GET(baseURL+"/exports/:id/archive", wrapper.DownloadExport)
+	router.POST(baseURL+"/imports", wrapper.ImportIssues)
+	router.POST(baseURL+"/inbound/email", wrapper.ReceiveEmail)
+	router.GET(baseURL+"/jobs", wrapper.Jobs)
+	router.GET(baseURL+"/jobs/:id", wrapper.GetJob)
+	router.GET(baseURL+"/me/mentions", wrapper.Mentions)
+	router.GET(baseURL+"/me/notification-preferences", wrapper.NotificationPreferences)
+	router.PUT(baseURL+"/me/notification-preferences", wrapper.UpdateNotificationPreferences)
+	router.GET(baseURL+"/me/notifications", wrapper.Notifications)
+	router.PUT(baseURL+"/me/notifications/read", wrapper.ReadAllNotifications)
+	router.DELETE(baseURL+"/me/notifications/:id/read", wrapper.UnreadNotification)
+	router.PUT(baseURL+"/me/notifications/:id/read", wrapper.ReadNotification)
+	router.GET(baseURL+"/me/watching", wrapper.WatchedIssues)
 	router.GET(baseURL+"/projects", wrapper.Projects)
 	router.POST(baseURL+"/projects", wrapper.NewProject)
 	router.GET(baseURL+"/projects/:id", wrapper.GetProject)
 	router.PUT(baseURL+"/projects/:id", wrapper.UpdateProject)
+	router.GET(baseURL+"/projects/:id/taxonomy", wrapper.GetProjectTaxonomy)
+	router.PUT(baseURL+"/projects/:id/taxonomy", wrapper.UpdateProjectTaxonomy)
+	router.GET(baseURL+"/projects/:project_id/fields", wrapper.CustomFields)
+	router.POST(baseURL+"/projects/:project_id/fields", wrapper.NewCustomField)
+	router.GET(baseURL+"/projects/:project_id/fields/:id", wrapper.GetCustomField)
+	router.PUT(baseURL+"/projects/:project_id/fields/:id", wrapper.UpdateCustomField)
 	router.GET(baseURL+"/projects/:project_id/issues", wrapper.Issues)
 	router.POST(baseURL+"/projects/:project_id/issues", wrapper.NewIssue)
 	router.GET(baseURL+"/projects/:project_id/issues/:id", wrapper.GetIssue)
 	router.PUT(baseURL+"/projects/:project_id/issues/:id", wrapper.UpdateIssue)
+	router.GET(baseURL+"/projects/:project_id/issues/:id/activity", wrapper.IssueActivity)
+	router.GET(baseURL+"/projects/:project_id/issues/:id/children", wrapper.IssueChildren)
+	router.POST(baseURL+"/projects/:project_id/issues/:id/children", wrapper.NewChildIssue)
+	router.GET(baseURL+"/projects/:project_id/issues/:id/links", wrapper.IssueLinks)
+	router.POST(baseURL+"/projects/:project_id/issues/:id/links", wrapper.NewIssueLink)
+	router.DELETE(baseURL+"/projects/:project_id/issues/:id/links/:link_id", wrapper.DeleteIssueLink)
+	router.POST(baseURL+"/projects/:project_id/issues/:id/move", wrapper.MoveIssue)
+	router.GET(baseURL+"/projects/:project_id/issues/:id/revisions", wrapper.IssueRevisions)
+	router.PUT(baseURL+"/projects/:project_id/issues/:id/state", wrapper.TransitionIssue)
+	router.DELETE(baseURL+"/projects/:project_id/issues/:id/vote", wrapper.UnvoteIssue)
+	router.PUT(baseURL+"/projects/:project_id/issues/:id/vote", wrapper.VoteIssue)
+	router.DELETE(baseURL+"/projects/:project_id/issues/:id/watch", wrapper.UnwatchIssue)
+	router.PUT(baseURL+"/projects/:project_id/issues/:id/watch", wrapper.WatchIssue)
+	router.GET(baseURL+"/projects/:project_id/issues/:id/watchers", wrapper.IssueWatchers)
+	router.GET(baseURL+"/projects/:project_id/issues/:issue_id/attachments", wrapper.Attachments)
+	router.POST(baseURL+"/projects/:project_id/issues/:issue_id/attachments", wrapper.UploadAttachment)
+	router.DELETE(baseURL+"/projects/:project_id/issues/:issue_id/attachments/:id", wrapper.DeleteAttachment)
+	router.GET(baseURL+"/projects/:project_id/issues/:issue_id/attachments/:id", wrapper.GetAttachment)
+	router.GET(baseURL+"/projects/:project_id/issues/:issue_id/attachments/:id/content", wrapper.DownloadAttachment)
 	router.GET(baseURL+"/projects/:project_id/issues/:issue_id/comments", wrapper.Comments)
 	router.POST(baseURL+"/projects/:project_id/issues/:issue_id/comments", wrapper.NewComment)
+	router.DELETE(baseURL+"/projects/:project_id/issues/:issue_id/comments/:id", wrapper.DeleteComment)
+	router.GET(baseURL+"/projects/:project_id/issues/:issue_id/comments/:id", wrapper.GetComment)
 	router.PUT(baseURL+"/projects/:project_id/issues/:issue_id/comments/:id", wrapper.UpdateComment)
+	router.DELETE(baseURL+"/projects/:project_id/issues/:issue_id/comments/:id/reactions/:reaction", wrapper.RemoveReaction)
+	router.PUT(baseURL+"/projects/:project_id/issues/:issue_id/comments/:id/reactions/:reaction", wrapper.AddReaction)
+	router.GET(baseURL+"/projects/:project_id/issues/:issue_id/comments/:id/revisions", wrapper.CommentRevisions)
+	router.POST(baseURL+"/projects/:project_id/issues:bulk", wrapper.BulkUpdateIssues)
+	router.GET(baseURL+"/projects/:project_id/milestones", wrapper.Milestones)
+	router.POST(baseURL+"/projects/:project_id/milestones", wrapper.NewMilestone)
+	router.GET(baseURL+"/projects/:project_id/milestones/:id", wrapper.GetMilestone)
+	router.PUT(baseURL+"/projects/:project_id/milestones/:id", wrapper.UpdateMilestone)
+	router.PUT(baseURL+"/projects/:project_id/milestones/:id/state", wrapper.TransitionMilestone)
+	router.GET(baseURL+"/projects/:project_id/reports", wrapper.GetProjectReport)
+	router.GET(baseURL+"/projects/:project_id/reports/sla", wrapper.GetSlaReport)
+	router.GET(baseURL+"/sla-policies", wrapper.SlaPolicies)
+	router.POST(baseURL+"/sla-policies", wrapper.NewSlaPolicy)
+	router.DELETE(baseURL+"/sla-policies/:id", wrapper.DeleteSlaPolicy)
+	router.GET(baseURL+"/sla-policies/:id", wrapper.GetSlaPolicy)
+	router.PUT(baseURL+"/sla-policies/:id", wrapper.UpdateSlaPolicy)
 	router.GET(baseURL+"/users", wrapper.Users)
+	router.POST(baseURL+"/users", wrapper.NewUser)
 	router.GET(baseURL+"/users/:id", wrapper.GetUser)
 
 }
@@ -3359,48 +14460,262 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RbbXPbuBH+Kxi0M21nGMnJ5a6tPp3ju6a5tol7TtpeU88NTK4kJCTBAKBtNaP/foM3",
-	"EhRBipQYT3T5ZEsEFot9efbhAvqIY5YVLIdcCrz4iAvCSQYSuP6U0oxK9U8CIua0kJTleIHfCEiQZEgU",
-	"ENPlBsk1oIzc06zMUF5mN8ARWyIOMeOJQHdrGq8R4YA4yJLnkCCa6zk53EtUkBXMcISpkvyhBL7BEc5J",
-	"Bnhh14+wiNeQEaPIkpSpxIuvzyK8ZDwjEi8wzeU3T3GE5aYA8xFWwPF2G2G2XAro2QOHDyUI2dRHKUhQ",
-	"SoVErABO1JwuHe0CQSUH6vihWz29GLrZILXaGLU+hDXCtQZCcpqv8FZpYEZqn1+wLIM8YDH7AHEQBcuF",
-	"dlrBlSKSgp5KSrlmXP33Ww5LvMC/mdfRNbdrzN8Ite0IxyyXwYVerwHZh4gIwWJKJCTojsq1dpNVZNbe",
-	"SoRjDmrwz6RDrqQZCEmywpeE7ohAdqaSWvksIRIeqSmhpWjSbSOaQC7pkgJX8uCeZEWqZp89fvLV06+/",
-	"+eOf/nz+7OK77//y/K8//O0fLy//+ePV63/9+z8//Te0TlkkB24pJUIiO33ovrYRVilBOSR48VZtMnJ+",
-	"rV3WsHNDw+tKILt5B7FUG7AqiUuygk6TCZN43cEV23HqfyohE/vizAXytlKJcE42rS1Wgq+VrqWQLAMe",
-	"0NM+6VNxVPDFTqAXfYODryF8d61z5H1WUOwvF5lEEiwDdEPi9yvOyjyZDY5wp/WUIZ6SG0hFe7G/6+8V",
-	"CNBVbgCR5EitKzca+FwctCQ2Pe5QMeQUDaw7Nmru6CXcoSoujk7Qhtv9DD0iQfXuKjOOzE6rT1d6OnX3",
-	"pacTMzw/nUn3JmglWmXoCyHKgJ76677aZGIIBlcnImHF+KajPNmnLnD06s2ouSlXwQLlwdgA9DJeOaZa",
-	"atUiRPKNl+4RYhwlIAlNBXK2Vum1hrRQVmTpLSA6RY01jjkE40LwY6SdFvZwKBiXMJgYCbgFTmVH6Lmn",
-	"PaEXcyppTNLQdoUksgMJ9aMesayAPCiyNGASqEP2UY/QyxSIALSk93oEcM4mANk66CZCWLdHZ0DPSx5W",
-	"HAjBWtsO/DU72QO+VAsYjLwGQvfBrhWqMPcl3HW+FujqWL0a6JepEHk7EL3iTq7fYnFmBaduJ4/zq3m3",
-	"wg/Cr06Z9uyYv8lArBM6SrUSTG257oqXzup7flTtPTAK6eQ19NO7vruKnB9dQwYDvjbcAXi/E101+tZv",
-	"oL34awPwkrOwoioE7cMpMaAwIk8QAqzmbQRwJhwPAJ3Grw0/zYu0k/cw79HjfRzisU7nX+Nb9OXoWBpL",
-	"73yPfwav0FadDgbnlN3D4WxcDWdxzpr7eFwlWCXlG7MTj82RNH21xIu3/Yt5DHAbfdxR/Ra4sAk0pN3t",
-	"K+emXm+vo90WuFa1zS29XXgkb/g26rbDw+6jxTnrjVREafAuHIV/0C3skLZafw/qB++gDt8H3UOr6qtd",
-	"iNCLgvp2shqlhR10zAAZoWl7ie/V1w5xlfQm3L5j63yWMPjWfjWLWTa0NmldpyxM4bLx0isZ7Q38wNY5",
-	"+o7B8cWiMv1UxyHGI1XJGFMplC4dZUKruadGlGJMg9X1k3qrgxF5rQ8BIS4Vo75S882Cr85LuX6iMzFl",
-	"d94hH/2/Pn28YAm0vnzDU7zAaykLsZjPvQCcMzVu7gbrLkrMCtugTTJ9jvmck1wKROIYhNBcRT2oDzxV",
-	"ceZAknqo+mTH4wjfcSqhfqg/uqfKGOw97NVQD9Kmch4k2g76rJTmS+Z6GsSAns1RnBH+/ts7li5hRpMZ",
-	"Kevj2CvJOKDzyxcqRhqrmzbLzJs1JwVtcVP8ek2FEoAgJzcpCMQJFTRfRa5HQvMVInmCbpn+l+XIiv5f",
-	"rogNjSEX2llWpYsLdC4lpzelWuHR1ZpwOE/pe0BPZ2fo9xcX6NlPj67O1ac/DNHaraCsBjwTr5ZXwG9p",
-	"DP3T9FiVeFTqxDfNMGuqqhTgx7MzJZkVkCvzLPBXs7PZE5UhRK51AM0bRxCr0KH/j/oCQnWGvqw6H0Ll",
-	"WhViLxLv4EPoNepbER2VrR4y/6Br2p5B9trAgJHmEoQqaA4Y9AafnJ3tdNZIUaQ01juYvxOmgNZXAIYc",
-	"xdjThm0r+Co7VeCEfcTQVnlVgLbcWwz3VJai8sdMp2vU+tpkvC7VoswywjcqbUF2+UeSlfDPgzShLZgI",
-	"3VXQmKzTgTuf53DX6HQ13e0zQ4OQIOQzlmwmM3KDezZhWPISti3/Pp7cv32udczkIA8b0B3oYuechjcC",
-	"zt1GXk7PP9JkuyexhScT3RABiUZB+TuxQ2earn8O0nP9Tq7vdOMrMX50qiq1BBmv3V0chUk19mvS0HS2",
-	"fzdnl3E8RKL3BkIdABF+eva0o13qRicMBMqZRHBPhRSzaYEhnPNl6EKX4feNlO9OdzP6eLcbvjed36eH",
-	"nd135c8ReixrPgp66lIRBB0XHkNAx2/FDOMRbkY70Fxv6AtgEY02WMDdzkhj3Oz60hopeqmC7wLnV/vd",
-	"AUTB64e3eELdxvxENKFqjTxsqjaWDbruEI7gLGnytIsKePZuO8/PyaE8wGk8igbUnh1RDtxKJ0MCBvh5",
-	"0gzt9e2Iat6Zk2bwsc47mVL++cLDAXW8Fx6qoj0CHux/P9NkO6/vxQwr47Y90Qow05M4KLLC8VQrOSqu",
-	"ol8ha/AuPwUiy3hkTEDpGQP4Qu1rF1HmssJ4rkDd7YkWU3hhrz8cBkim2Nb3KGaTxtL1J6Mv9mzqYdHJ",
-	"WzQQQYcQF2PzftpS+WU3hvZh0lAaY7QfRWIOD7qpsapvSbOxk+FMe8LrCHwKoE8vIRodEmbmiUXF1GTs",
-	"E4VF4/x+uujwQOcAYFF/9Tf+bw324EzVcLdTAuchTtjJxJDek7+hUCxZY30RNGzMz+aqH57snB63m3ju",
-	"93tjmnf2Vvd+lnbhua/q1tmLSAcc/9S3ydunP1bqyYQ3kZLErVvyU8X4p6OH1T2yB25E+8sGQ/igEzBr",
-	"+X6a6BZgeYgz1vF8CLhXTLKHNghPh7HE4eTyoo9QHoj2vadB1q4nR1oGJMRxiRCO7+oO07CWjB4eiE3x",
-	"ZdzMqC+MBdykbTPGSWrCgKJb2dx5UH3hu2/o26sa7AFO/3urvq42CmW0+JN5hTTX8cJePMKJuz4afnJq",
-	"zkmjoafyO3c8Gn3/KNzOjQIvvVHoVScKMsMoDC5RVzgDvw2HzSVnSRnrX3GYQa37bKSgs8DltNvHeHu9",
-	"/SUAAP//GmpRGCVHAAA=",
+	"H4sIAAAAAAAC/+y96XIcN5Yw+iqIujfimyVJSrLdM61fLcvdbftatkaS2+MZOhioTBQLZhZQBpCkqvvq",
+	"3b84B2tmIbdikSLd+kVWJhLbWXBw1n8sSrnZSsGE0Yvn/1hsqaIbZpjCXyteG6a+0bph+LtiulR8a7gU",
+	"i+eLHzWriJHEtiIcmxEuCCU114bILVMU2haE0XId20Ebs2ZkJdWGrDirq+fXtG4YuVkzxc4FPoJ2UjAi",
+	"V0QbalhBNLtmiptdQUpq2KVUu4LUdMnqgmx4zbSRgl3wikhFytWpoBscgdBzUTbaSDfU6blYFAv2flvL",
+	"ii2eG9WwYsFhPb81TO0WxQK+XDx3i18UC12u2YbC+rlhG9wIs9tCE20UF5eLD4V/QJWi0EUj+G8N+8Y2",
+	"hyE+FAttdjW02fIt+4rVfMMNq+Bb3LiXsm42IrPLL+VmQ4lmABnDKlLahrAvlJT6GpYrql+1FG7XVw4S",
+	"BaFihz+rglyxXUG2Sv7KSnPBq+JcbKliAv8nulnC82J0o6FPrfmlYAw/rBp2QU1xLtL9L8i1NDB8KYVh",
+	"whSkVAymDk1Js63c/3twIh0wfcVWtKmNBhzD+R88zziBc0FFlUzCYkMO/G6fW/Bn7+lmi0CE+fjp2NmU",
+	"q1M7f8SZDoJ8KBYI8H4i0ltW8tUO6WJD3/NNsyGi2SyZAhAqVkpVaXKz5uWaUMWIYqZRglWelgR7b8iW",
+	"XrLTnvXY8dPVVHZ/F8+/eFIsgBapWTxfcGH+8HlcAReGXTKFS5CrlWYDa1Dst4Zp055PjiH0zdENkJ3k",
+	"xDn+1j89HIwsdwRRbsa0fsvPaJGFs5bKjHFMqaoRhuk4X55yAuEFijgXkRaQ+oaJC1gBW/H35IabNTlB",
+	"/JPKEJgtExUXl6fn4q3rkXCNb1kFe6eouCJakhM/IE5dW7yV2liiZH5xK660KdyvcwHjycYQCisgsB5E",
+	"5prqAUqEwXvIMMwiA4oP/hMExIvS8GtouAcUBBah7j1RTG+l0EhHWwUgMdzCkpb2i24H79aMlGsqLoFy",
+	"Kwa7CbuBaz5dFMl0N/KaVftTLaBrqTJTq5gwfMUtE4BOG80UuVlLN1IY+TTXa8Se/KQN3zBt6GabdERu",
+	"qMbOFwm9AaBOoHVulIoZymvcof9XsdXi+eL/OYtCxZmDwZkHwFeuORx81f68fDPCw9pPs3QGzIYrVi2e",
+	"/y90VHjw+M2ME2ttxC8fikV3KgDbquLwOa1ft2CeWW06W9eDh47dQiBL4NKWKLaKXXPZaAIHj2A3BEUd",
+	"nSxKIg0vkom9ppdsYGuQrQ7jqUf1ILFMAc2eINPd59Az7qIxtFxvmMhgV3w3MM9SbjZWBJmC+a41yIhO",
+	"euQ1I1wTimNZxkp9szw9WIHkwr7IkrFtQaCFHxjGOQJ5JVsCJOY+Pp1MZjANyxBzY8Gb0Qln6S1Oa5Di",
+	"nJg6EViB9+31otf02Rd/yC9izd4TJkpZsYq8/frFybMv/kDKNSuvdLMZXZzmf+/ZG3iTfg4n7nJnmG5t",
+	"fp9MUSyabS1pxaqL5W4Wi/bfDc07x8jCRidA7yCvW27YzvYk9xleALLuYS2xwSh3iU2nM5jwzTiLSbqH",
+	"qb+09Jy/Fw3zF9qYtVRjc/tRWyi7/R3mC1RrWXK8h6HoBJB9OYHjrM2mHu5ZMVExxSqyUnJDNlRdVfJG",
+	"oGRGBTdcs4p8/e7VdwUxVF85octeBNyHVJOKa7qsWWWpZinfM3vsIEbBHUqTmosr6PUMn+mzf1yx3Ycj",
+	"sDcPjoN4W8VqZljVt0OxZ9cQF6XYhnKhYd2UbGtasrWsK6ZQ3uVGE8W2NW+dtEspa0aFHXJr1vkB8VX3",
+	"2HHXLLNWjFYFMXJLanbNat/AAuPJaZaDsIoPLM8iQGe8NdVkyZhwMkVFNBclI3xvh/eXlmPRHjxtHh8l",
+	"0ydPn332+Rd/+I///OOLL19+9ee//PXrb/+/V9+//q83b9/97af//vl/clALGoQ5x7dZ8wAZYmQW8xSz",
+	"slxWG9LAXsuVVSn5ll7mdqMUHlz2omXWbEdumGL2OgIcGrduEu9644bY51zAdKsDSQRuPP5GN5VOskKv",
+	"5XGRfXnMjkQV0C/d2BZ5txbyS0YudTN/w665zl6BIjO2LQalvgl8toM0NOCN7f4I/CrM1N93pnMr1bsN",
+	"79KOrfYGlVXKcHEJq3gaxdcyzzAzKo0U6GHsFOKd074DrZ4jvwuy0YM/NJx87HfRZuzsjyMkyxie/uis",
+	"PXueO+nRyYaOca6oXPkLZ3WO9yaal6GZzrutp50mODTjzHVqrD1ZkLhX9qaK3NKiLRVOkqgk0+L/GLJV",
+	"8ppXjEiRl8lbHefGCb+jfA6636mXl9bOHvNom3rRcpONg2kj1e5iKzlgRqZjue052KBvWtfyhlV2362G",
+	"XxAmmk0caaIFooWr3aF+WjOzZopQB2BkrLYxkYKwa6Z2eze4RL7ovz63r817m2M5Ym5b5h2ke7ifnqa3",
+	"OEwFjS2T1xFqM0/NyBT+hhC9haKphekOP67Yzupk7VOYfeFOHSv2w+w0+Zeff/7555NXr06++upfUUj1",
+	"V2yqiR0ur4xKpt/Hg5NJjTJi22o6G46jj7Ji13VkxEz1TZapI3Ngpg7kvnM5ox+usDdPLTeMLGl5dalk",
+	"I+ayTKaOyi6t0W1/sO/wubfFWQ0dcDTDzW4eN5vGkP0etVf0PbshL3tNc4cxH6bugPG4bTyEyzA1SKNM",
+	"jcpJvpuZFGovuMOSUugaKPSbzVYq02vuhg1/+fZvzsxN1oxWTGkCV3uQoZ0jAfA7ezziOQX2snrnrWHp",
+	"gXZKPA6CY0G0oi93KIBTtJJXqLPDNmRDDSqTlztSy0suiFSEbSivrX2qo2GyiM2yKOxt0vmX8Ra09469",
+	"N0wJWmdv1vaWhHsTNFC8auld/eVXy0aVrMD/lbzx5mSuE5FObrhp3zxyVL33SjEAIlPZl8Eml31pqMnv",
+	"l4Ne5l0Hn3zDiE1ownvDdFaetZcyeAe7xPEDvI6JKOT03xn2+4pWed/Oc3+QnnLa71QTpFTO0PfTehe/",
+	"JKVs6grk6yVz8+2B0CimDGLGqJZ/7+UV6wdqo/slzTiB5Kws8IdfH1mylVQM7dY9ywdJGPlJOGvZe65R",
+	"zubimta8ShhknNwNVQKEnP3pWanMOVWgfkgxVCQi+VN/D5pzUnVQNQVQ2KaifXe0KPyKbrfQ4+Ei4iu6",
+	"1V40bMEZjt34nL3nptGn5EdkeRu6hdeNbkkEVny09A9Ntqw6F+7w3mzNzsmNyDArJeF9Qa7T3aSKAQTt",
+	"t9guANraRXfwsIiM91yMcN69g8/u2w99Fyr7mjjRPUPklj1zNnrQtQGEzDscXuPf+ZMuXrkv0pMhw93d",
+	"2+jJRbzbhBRw1KF1SScNOV7G/e1xuXN2Z3T2Oh24+1+knHp/Iv7t6ERCw/kTUbsL1WRE4Dd4wJCbNTXk",
+	"BvhBygzCNCIz5yZ/V/USWW599l3XsBi4jL5eFItLbtbNclEsfuWKXrzf1P5feP3L4KE5C6Wia15+ru49",
+	"0KpdNOGiR3vuu5omMuc7tsJBnJSD7CW/ZqIgHEUsf+jxFTzwKiFkydl5OSw5hOBQaJj/GTKXmV91r5YW",
+	"fyKftojZy26sYDRBH3nhT7GZMkZBuCjrBoVh8CYJ76Wwew8vLLXmJZCJ4zqCH9BMD1DvO9UwwAshzRrm",
+	"kx70eTr1U584LX3Ft9vkLMHDuyNK5OfsJYVp49jDbEQeS3sPjn+TrlD70mvu6stuLsYu2FVlb9cdfjv9",
+	"fu1JvR87HEiTEQCs9hRwDLoYtktO5XEBjsCJCidxWAU0qdSOqEa4Ie1wbeaf3OuFFT8uAh/Y88ZUXVHJ",
+	"ArzilZVeDIgyKKTgNYoHp02Qj1qWX88N3aEXTyb4+BYSpKex1vbtw6voSsXQd8T2Yp/vtBBrb7MCKiPn",
+	"E0vQMf0ZhLEM57Nvrax2dHcvv6/BbmaHuUGrP4jruyMYA2OfipWMX8/yy1Jykx+DVpViWndG0LAq+Og4",
+	"TlZdxMOBnGwGqMyNs7ZXfdb2DdOaXrJesnxl359885Uf2C3G+/KKy5qRpaLlFTN6UCSZtq4gkrQhIxvT",
+	"t4gOzSQr6pBN6loFcNuznSIv7vMRHnA1ShRBU5yNkuiEyS5l8I+dB2+pVPP4P/mKEbptK02XzWW23zWv",
+	"K8XE6MmGMS3Q2Hps4LeJWmWCBdYqNG/jmfWN1Q1CGEzUlCNtONfgaPcykqxZvQUQy/q690j55/Hn+qar",
+	"rpnMEK269yLaeyaaeZyNDORKjG7IqpNEmwyqZobfRpbWbE9HtIU4HVnXorNuNlSQleJMVPUOYIf35JIa",
+	"jyMp64PX4cTLaM7QG8Zfubzjfrs91wTDDdorevH6m5Onzz77OEacNEpsCtsL7efxvll+abbxgMPy4WeX",
+	"nfCS1VJcDvi6RU36lJNjusqmh7GXihte0jo3GV3TSYz9bU1buvzMRODVwCzklonsDKIRoEtB7tVAp69r",
+	"RjUjENgELayu/dYGv8gJD7D2FQsMxxq7blo1KDiNr+k1wxCuCv1YWysd8Q3bF3f8bnpQJeiTiAip4bHF",
+	"u5Pj3q9jnm0SZ/5lU1+9RBalh2KnNKFbL6daM58z7VFDAK6odAxxVo3G27hzQKoyEllVjd2agZFVVStc",
+	"S89jaAeJcdIxsGRUAvfcrnK9EbYdXiA2PcwDWPyEddqG8Zw5ZLETOY+RRLMeDeAYvzASz6zuzqwkaJRh",
+	"T+DFjVRXq1repMpax064uNgqeamY1oti4WQ5vP3WUrOceehDiqXTLIjUI6E9CINhjZJlU1/5INh9jOwx",
+	"/eXd4CivG5U/kLCfixKj1/vsiG6G0E3bdiakuViBGNxSDrj/LoyiQnMXRnfNlOZSXJRSrGpemqy6ewzx",
+	"FYoyrQsr1X6PeqyaWQkKYNDtqhfJcrZIx6pgSo3wTvXRGzcGPzo2VLjds22s3z1aN0RFlKxrCLWh5VX6",
+	"mjSiAia1ZCVtNCMU9J9MJSgiCDVyw0u/AxkIxfMlTBPOGmy3KBbJ0FmAzDvbePZsKwhdGXfjjOGlhzq3",
+	"OID8sk9pGSjBSzcrB5JhhRJ6LwwfK6gb1vQ6yM1ckxWtNQtOrR2YrGnlCbAneMRB41AdsgNrXoWs+rYm",
+	"4UIgGaRHZE+0Q4vMpimkO6ywP+Zh1uJxNgOr3vMDt1tQJMQQCCBCvYVR76i6zOUmeOE5tJEeoybw6vls",
+	"7XSuoPkDeCz5kyTe2lael6QEGQIggOoOp8XWhv2IXU+jQNylgjAe2RlmGHAZXSZIY0hhrewJSIJ7bq52",
+	"ROHSHyRyAF/5dCYgDZGS9tJSQqdlFDsnYb0XU224rcm6kXYgpq2VAL0h6tpbkg1TenLWG+ocsmIqHdpJ",
+	"5TJPUOM92SfepVJVwtjncQZHaKNOf24rA86lysCBsC6/iS4VBfWPPRkvd1ZWzBwHVsZ7/o899tIyeu6/",
+	"TKXGbAOULrNvgoiZfWukoXXuVWevbLvUhDNVnvWb+x0XV33KLdQQHsvpGTs7RCvYr3zDLo+pgZtjQ4HB",
+	"WXV09c/U3qdqUMY76tObYFdtzcloZ/0BHorVmKBGr/nWdofylDtfc50nFoValld6msyITVrmmhG9RmrG",
+	"6VNEAJH0eElHTByNY8BG0y38fuBRhmn7DRT9Sl73zhPvyL2yy4EKy+7Ve5KlLRkrzLw/StRb0Y4aI+ou",
+	"lb/DCNE9vX/+wjCH9CdvVn/YaaS9nOl0JOi0jQF3EHLaxsB5AadBtZ3dybffvSAlsrBUHsnFOSltLvya",
+	"xub7tqYvoVM8amTNy91EuoXp2A/wp5uZvWjJzbbxhtAevaGWdeODj6bNr0v5YbJh695F7VEP4O2Z1r5W",
+	"7O/gfDWh41XztYHxdEpeD5OCnV1Y9CCij6D3XG8xaD6K04nfzrdymbnfBUs4+VUuhxO9sM12PAoCuSbC",
+	"AvoLeSuQqfXxrHmcGPo9KK+IH2Zm4h43dT/2aT4dk+B6PWsJuilLxiqrg4yKv8Pl52/lcixpE2gRLno0",
+	"z++8jSwIhjRoJImDfrbTDX1/cRh2cB0218V7cDuiHlKGjWvkEUNQ1QT4FzbaG8rZlolK+/AcH6XcBm0U",
+	"FlUjBqGarqaykq9qhPW58oqbBPunwbdPbe25XhOSzP0ql0E3/atceq8Ro7j3faHo7iJXK9iTmoVwC8iA",
+	"2bjsOfATg+ZcT1KUriFaIJGEHYxZRVKIW5Cm/NZ2u8CdE9bxMIAgqu5+mXXTSEPJHaAis2bvtzYn5C3V",
+	"356xHCsbi8/N5eN8ApF0aCbg2IA51fLvnuMFKH/kcAHUmHy0wEkxdrBghzCtV0zkD3n34tg+oFFt76IV",
+	"o4i8cSNG7Vr/rXbemWMPA+oymPqBbsux/Q7dS6q9MOe559+Nkoal+3tkxxg+7hgz6tqQqAZa69y7lrgt",
+	"7yEk93aMmNwQ0wnK9TtKVKFjnKr3d8rM078aIi4Uayfid/StCgzQfj9fujpkwLvPlxDGyweejbkYxuke",
+	"w80wgu+oCdgSrfUgMvrRX/sPbkW+cWtGfNumajdboOpc4nJ3tT7nMcNNzfpw0dQ944Vur5+ePjt9cnup",
+	"IkNat08R0WJ+dqFR+5lcc+e4Z+3jxYBhJrXFCULjKgvvNG0FSwsw3xRDYwJfyfKreAvOW14GGnQ2Km1d",
+	"dLpv8dchTUVoNFFbgR5YycgXRk4hKPii4+CKj5J9g57RKojiDiwHErPf2rEqGVROJDj3bLpqJGxi37Eb",
+	"3o+evKHl9LPXfzJ++sbOYdbfs5vedLOYRCakzOvBhYMjFYayVh+UZnPJQJ88GPmzl8/Ozt1vxFBGO9iM",
+	"sp3VrmdHHluWuRmJ3wqncdjQHcKWckFqZqzpv+KX3OiCnCBPvPiUJG4sSZxjQm6qRcwVh28KPC4XNqS7",
+	"zZv6ksp10Lsnt1sL25nKo/rLmDqsF83vIZ3XY86ylYeGW5EDwp/f50ProWf77jCjZyd/glUeFcHTxL3S",
+	"3eXhGWwbj2dL+mCX0BM+CCvgzvTVs4BDIwJ5JiqmsLNF6c9lOrKe5ZaUrTO4Vcr6YZFN4fI4ZtMgNaN7",
+	"bYJD6syQwxe3CTg89Ejlxw/+u/vANp4EtmFic0He/OUl+eyzz/4YLxnh4HFud96vrDGNYsEfmWuimS2y",
+	"5YBGfpqKFbE4Tz9WhDYtrIjwfPbk2R9Onj49efLs3dP/eP7kyfMnT/7n4YedUdGVzN3uaroJrGIGfVmA",
+	"YtwFNzrpdmBnY6Nhgjs4zu2Wa3JdDazAtRiefn88yYtbx7FNjiLD/TggiKwnJV2alXsw1OqX5LzIO+/F",
+	"M8M58PXdOeBulrm4w+PUP8vzharZ1hwmpIecwdJyApOU0ZlADyN9dPLde5k58TH4mOE/XiMcl5z+cC9x",
+	"MHtt/+UAd7Wup5oD7IAqFwC7SdS5RxMpP5rGc7bezZ9gXBNbKdNzpCR3zbhergsJnIXb/9e2o/zuu5fH",
+	"3PvIRadK8z1x4notlfHl+bxb+LLhdSvq3xcYc+HcBVkyc8OYIP5oMcjLt0yRkmrm76Uo/eDNNPqcOYux",
+	"bXFKfhAlQ8HBuICrnKd9K578IV9VsviUoMBhF5W3NX2N7k959Epcs/rFfXOhuL662DJVBvHWqUr+44si",
+	"d4OxLUFbhi4PBv3wfXQR7GJNt5p5v4oYmagJNQQGO7VmaCgtunj+xz+CYCTsj6dZHx1aM1FRNcVFzDed",
+	"DhjcnzZc3hoKnVREN9s++/7hVgsHERvdZ+NKW7c9t/32nETRJ39q4bb36GbcSwxSCPU4k9SJxMYDxkx4",
+	"Pk+mwew50+O03tZ0YhyG13u4aTsMxmwCWeSFN/1oy/IpoDAzlN/0H3VXNfCrXIvTSrI/uUenJabe2eca",
+	"kPYzv7P4Kr38Fv7kMLINR5fOGQvj/smbVzvzqSSbrvz7PkHb/bV9K9eCfJXvb45nLi1LpgEtr5hIR3PO",
+	"D9Y1HRs2Zg2rsplDfEh5fnLQ9sn//0X1dPWs/Gz5OR1ldxYCISG5BTfijATKKmn+SEzfDhemnFnlFONo",
+	"rSMeu0ZXEO/6YR2aroS86fH1Po6nCUZawsidxLZ0acvYDpabnGwfd7Ng166roxZeTYEzUny1Bcd78VG5",
+	"UyeSYqEYrYbTUot0ycF5Fb47VK8doOgvJV7aSV1VvPH2IoZ4O1Sy72qKVxWWS1nQ73jW5yETK+Hihuz5",
+	"yORQ5GhFcR3hDtbEDSF9ezbqdGqvFVsxxUTJsrnGgTzhex2g4FwjS6lcvZMU2rpwVgwEHNex4XKXpv7O",
+	"6mn7iGfXsWG1FbOBufWEqwYMGO6c3LhU2WGM8CWRIt931bCRXkWcqVT7Q1QNI1pKTMstr5mqsOyC1/8g",
+	"32xrkJw+iOf1QMnUeoSKr6FkQQovhCY2ZqDIXa0KwjcbVnF0JVHWLaGivN7BJQfkF5fkPPkuflHvEjgf",
+	"tJJgOF+tFsUidIzGKl7vsg6vkQEMAiMcgly3XQ/3d0/XdLivDLq4CwHQyVIxasOXpXI/MMkc3mKs1Hgk",
+	"MLf53ejyg4dQd/62h2xmhm4t2bmMt8sLe7wX0iZj/gstDJ7swpCOkE3CIPInWyerFLZqE9GE9AvtKYfB",
+	"YHN61SpRpXKcOOPXMcXwPfgKztfe5OQVP+d7yCY4SUv0qDQ1r2draua65aUo9QDqNrnp9OWT97OFTFfj",
+	"WeXpJcsmT/8hcSlb7sha3hCQlwm9lEmemJBWcicbYK3GOr3PKJEL03uBc/iygTTAOTzoT5SMukiPCHat",
+	"M9xthWHqmvakY62ZuLTFnOFkI1umuMxTtE/yML5Q2FQb+3fwDeaWUZl2Hm9ie9jutZLN5XrbmJ5oxMBI",
+	"XfVs57PJRbo1BZF1dSgGvMYucrA3Mg8eJqrD4N4fhx4yOxu5SLCjtUEhwUay5YUnouSc6zn/3duxo99N",
+	"avqp7/od1emFjmGqoT51poaKfUNKcOIdjMppWqXIWgF4sffIl9eMKjMhaNx9W7gR7HT3eMUg1wr537lZ",
+	"o4Cv0B1XrkhFd3pgLUOCUeJpm487hGgt6H8wiQ5VzHFMRcyaCisdb8DjBL61+mVHTktcKioYBJhFN9z0",
+	"jMzFtJFDWsz2qDDgBBkvDLMPm5f5HXzh0KhdDMj7Bx4IiCEYYL/5DvCVnU/eG6vr2tpeYcLABxzeEyQp",
+	"ovLPL96sqfHKwMBLpep1dl/uLtIk8dlBoeiYa5MIkUXIBBrd6jFGU8i49zO4tIVvhkkvd63KVEfqMnXz",
+	"OFaX3t39GP2FJE23YBg9+ZzCVNsb0d7pooUaEUndYTr3LKeJjJO9gB2j8E+a9mo8+Dsow0JAwz7BWBcp",
+	"733VVQcmQ6OsOEGM9OKM9TJW2rgn6K5tWzpLqa/FcYDYYSeTJu4KOxMB+aYl1u3ruFAgd7tuZ5rCM4pG",
+	"xEh5lewepn3UrJSi0rmIRSouyl1ZswtcSnbHoJHvwkLATiAmpEmyJ6ZziyXX4q7JZlknW2axwOq8qMBJ",
+	"XBh54SY/dT64s0ecScUnbUvFuxO5m43B6Uzcmorf7eZMJeo8qkZKbiHtABGPjxTpYC+ZKMfqdBi05uk4",
+	"zXtqv5uWatSnffGTAsp92+ub6N8MqfwmKTz8cTDdq1FRcZXvF950++2mlfLZ5DdSG9uo7VD5dFwvaLUd",
+	"OI2cRuNtTb9sNBdM669lo3pE2KVrQtbQxip4KxpcB28YuyrcI2DVKOlsLHyp9W9SPnFle9sriuDyWvmN",
+	"FPCkWJiGafvfDauE/9+sG+X+XSlu/9HUNMr92+DXORU+E9VIbpCwRKQI7QpNutqnX3/9/NWrgjz7/PmT",
+	"J4S76lDxGlzRDkag83RP0J8yU2cCx2puIh0N5R+zY3XwwO2QO/uYcCSTuAFNAXy0L7hoR0Z1o5B/FHYB",
+	"sjGaV3Yh9ht0ORZQDBwgps9FKIEC0t0pgUADO4VQncp+yO0OhJgP6D9X0Nv3vL+Cr9Bj9l9+/vnnn09e",
+	"vTr56qt/Te4E7D2UZPRSTHuhLem86x7/7OTZF/2+sVFIXXt6muod1CbETI+wA3/P+qcCrL558f0L4pt4",
+	"1Iww8NvU0TA32ihac3r2itVL2SjBJjhtumn4NRYRBh6rMP9YX1SPPQLSDLH0knKhTduGlbmQWVtXNewU",
+	"673swDHAfTAjQYKEnTFjY2DuNhxCG6yl7Gu5hSMWDxo4YuOx3nKrmDadUQ9gt1ge1+orPtopXjG21cQl",
+	"+bmL1EY4TEGcc2TISRTslW0fyGTKa6rPhXWAtFeiDTOtzPwBFIm04FED+UAw5l4tCu+dCXcztxFoQsyX",
+	"QLC9XGy4aHorvfidFZE5uPZTrpPtAVoZ9b0rKu+LtA7OqHw81lrX9MK3ncNsnDPsmNqy1X06+d3AtHeD",
+	"GeoyTrR34zd7NPfYeWbQZBuOlXQ56fKYhspPbr8tt99jePLONXB2kOW4Ns6A7nEvij0CnGcBfVvTMesn",
+	"LGnU+Dk74+pb5J+xMGVfwvzUDhGFveA8E/xlnJpJSymYNugpNc98FnaiJ8Xnx7Ey7u3UoNGtDYSOaS1J",
+	"wtJZa3/BjhAilGz4OpHDxgS8Y0ZU36EH7KxqR5MKOc4rEdg/szuqBnicJPZ9veSy4fYWw6upR8kWok/L",
+	"gmTNXyImhva1KRrdK6rkjaxByMy+3bAe26y8mpITaVietavvK57j8177A27P9pdEueRF2yFOPSIyow5D",
+	"JhehkNtmhy5aeDM162h89TpHporcRYnQWku8Yul42ThdjIVBRT42bb525CQ5dRFHc6PbrKw2Rj9vOxye",
+	"UkrgU1SHXTncfw7Qf0ffSyE3OWbh3gw5DlgjFmcjuWpiO1/On6+Sh4kd1ShedgtWTa1MODqN2M6WyrI3",
+	"bdBnJtPaD87qmdbg0em3ePROFKdepNuZk5dsxaYqSVFF6/qH1eL5/444k8a0Vh+Kf3RA6Kr8IXV6CZEL",
+	"84fPx++l/tNfPvzSDQGwU91PmJWsop1favpKku/ueTX5jFfdJTF1wHqYuu/F7Oc0igsJktnkVXi59V6X",
+	"0MmoE+ffCr6fvIY0bdy9riOTDCCuJfG4nryS6Ed2r+vYC6uPqxjQ9LiP94Omi25FJeob5ELSP4VX5/Us",
+	"v8cAZodU/aKLbUBMlGD6kqfMFmDuWjIpgmiCtiS01hrpXP2i2XbqFGA388HeLtD7OOEaP/ps6bNLdNxt",
+	"RHnuco5zPabqc2rYug3n/h3Fps8NNp+r0AxYdaz6DD683SJd1G3OUFrCnHpMLTjdERMLFvqfbFuBHkcZ",
+	"pO0S+OJPGCen+qoR2TC64xE9DBzKudy4KqhziqRRLUV/DXMf/+h7LoizRW+oaGhdJLd9r+orQiAsZqRq",
+	"RU4GvxD8elEs/OeLWMc/CQZUraDBbKVtzdQM3eK4rsp3GLZmL07bAXiw5pSD8qixz7ebjIxu8FF8DB3/",
+	"8gFPwLIBMeIt9GJH/uFFY9bP4D8IbsJHwDuk4n/HmMOXrrR96+GPql48X6yN2ernZ2cJ2z+T0O7MN2aL",
+	"YqFLubVD0WoDrHnxV4WFZAMjI/iCwH6EMEcbUembwi/XflEsbhQ3LL7En/4t7AdwxrEZYqPFh7h9+PiZ",
+	"PaO5WEmfQZpatuxOxsWGqqs/3ch6xU55dUobz7fAciYVIzawr2mN7lyVk6/O6Jbvh+q/W3NMIkWYoMua",
+	"aaIo10hrjg6sd2FFriX+K4MXNBrNa14yZ3JxU3r5krwwRvElasxO3q6pYi9qfsXI56dPyL+8fEm+/Pnk",
+	"7Qv49a9TZu1HgF1jaqN/WL1l6pqXbPgzbBsS5QenabtV4RK0eHr6xId+wfY8X3x2+uT0GZAKNWtEoDNv",
+	"KMRfWSXpG2YaJZKazeGTUxtoZFHsG0Cul6E3GEPRDTPYdc+dLjY5+w1vcyON5GqlmZnSEiNQFnCV8xwC",
+	"F/jsyZNOHnM0nNpY4LNfHb+2HGFaBlTPrRDL2xsX9ilwqUXKMXBXMFQD2Qp7z02jAzxOXSaL7mNL8XhJ",
+	"1c1mQ9UOyJaZPvgYegn7HyaDfHYrdQbQL5EZO497B3MRMqEztQ/uVL9jmSTT5ktZ7Y62yS0NUpsTG9Ww",
+	"D3vwfXp0+A6BNoQpHAJhy3QngtgDpwWNDHA/FAlNn/2DVx9GCFsnfZIl1Tb/OTf/R3cuEW3Q/5WZBPQd",
+	"Wh+SF8JY4MvNTLnGKMbFc+RJkfc7OSEFdpEAritp3AehDyJCRIBi8fmTz3vyOfvWlWSYrIWw91wbfXpc",
+	"xpCn+cb0KhJaJN9P7rb17cFuryDHg/vx2U5X4/0QWY+7yN2K9cSjIst0PHrMZzpnJlFeDXIfdAp3Tkqp",
+	"3k2kWimrVVjuWsnsh1A1YU9BjXYQvj547hSWl8EV0zVxPgTu1BZbknTKfrJy1YNxvsUcfnY36NXmhA8F",
+	"w+6MD7ZxbIwP3jNuH4MPTkJXr3U/DGOBR9pqG7hNeRH8vxqG8e9JwV/3TauUR0FsSpYixG+766xFbGoM",
+	"Ldfwm2yYoRU11GlqzkWsy2LrIkvBYnogIyHe3V4fWUW+/+rbtz98T6gq1/yanZJ9h3Y7OevnAeTGqnPh",
+	"nPthBTAZ+N/1AO0qeSNqSaukJG+oqx2K6trbd5vk3hqqzJ99Ydw7umu4/j84PG+h9bOjDYTVaPcx2m1m",
+	"WrV8kF87sHXY9RRubUdy2N/BctxnzIX0PgQ4Omy2T9q4bE97B+BJh/3l3/l2u4ddqHMyTGCSAEHiXuwz",
+	"368cCgVkmMF0E6T9VS4/wuEOq29jStAmL7mgGDCfqb2TxRS/dZOwxX2zhyzwyR8HP7FJPUxCntNRzGVW",
+	"a2GYh98EJOObEYb5jW0QEodYhsNVZIg28J68fPs3suI1Kwglf+Xm62bpv0EsdEuVilDyLVeU/Per74hU",
+	"5wK+cy+5QP4YuWVCgzzJ5LJmwj+/QKO1j+LbgObYrppr0DeeC9fOZXKMfB3YLNPFgMiCMYUUKanR3hHQ",
+	"VVY7JW8w16c+F9DMGa9d2BVXhIcoZi0bVcIfH/YE07O7bgPNbBF1uzZLvzaNqPMYPxc3zFrI7UchAKmw",
+	"JxGp1A66dsYM+IoaciObusLSPv4jH86IHg92GjuD9pbMUWCB/o0vhNl/Fmya2vAtVeYMiOwEDsIFhtCW",
+	"snL5xJJadO7Dd07532XviPOBaruOn30FIlZYoF66lbasR3303iqQN+idvLHZXmzj/Wqh9nlhZ7dv7Ltf",
+	"Wc5O1gVEZHiaQ7pONITlT0/ye+tWCFSL20wxcP6a1rw6PezkLBafP/1sAJBcEyMlqamCCO1xLuignj1o",
+	"7YakWVccB+SblAOKJSRpPAt+BHk++KIs2dZozB91gzW1vvjs2TOyYVqDmYxqAt9ZHkAJ9IVVaHZWqMPO",
+	"YXFKNiZm8nXbdC5u1lI7h/3UtcAyNF9oR5a0JluXooQSxUq+5ZikuKoU0zqmSKZb/idkri4i6t/hgWUY",
+	"ntF4/yPgZDayybujfyNO4NHu5J2E2bwJKZPJmtHKTwqZK3BaqmqYqV3hkpVyw+LZIFtVyUzw7iD/1Ujc",
+	"B/be1oEC8yk1jbJ891wAqQLrdVxOuP5dAgdYYg0n3w52gfFr5q+jdquk4pdcUACBhiTMGRb3xn73Z2fI",
+	"H2BxFsJnalX+57NnB0gW98gBLCrbNWU4QMTE3A4idR5TyTY2HUd5bkqTGJIntxLPN2AsSywWpq3/u8uO",
+	"5mbfz6K+l4FDpRQWKCpcv9x4w4zLT2o270L6696QXT2ALEdzSIvEm25ei7nZF467/SqXetKlIaarBbld",
+	"71cZFeymldaxTU7fwjgjF4UfQNBxWqM4Buzabmt90qDVbw1DSnJ3BZeYvv92UIwO4yo6cp0E1+SGsm9b",
+	"g3mfj98a1tj0TFaIg0ZeWIfzH1ODZ1PtPy6TK4Cxz9qKezlDBwRXy9ztpG1LhV5T9IVLY0TdafY101Kb",
+	"0A4uZ3XX3+LddMa9FpQGj8ag1qP/mKz4gIbzlR7DAHeQ2Ifzhp15T8ZJkE5uokHOcPVMbTeOcSlbQlIz",
+	"RWippNaYvCVq+IYZ2is/pbl+Fg+Knv0q+mjab/wcurbH1jhlp+6pHuruWYR8mir+ZNsuzzGICTezq3WE",
+	"BFYpamRcLnrqhdwhlPqGzAAsXRFJ9ut4AOwbIQVj2mbMVnSHoLIDDAHsDnTYQ7C6P1H/waCMhcEhWJPh",
+	"ANMOgNYXUVCNmDLG279vDTlDam2PbPEa8svZaKKk7FNOvHRVMFKZIIQUrWitWa4cyeM6bvZLn4zg450c",
+	"PPvlSqYj4Jkvi5LlaK+outKu6FOmNkoOF4G3eazoaiBo9aKuu8jYAU6PhNbBRIa6anXFqjjebTYUFori",
+	"UnucZC1z9hQtWLHeTM1yaR7s3tJJG9oIP43OgYDP0x2dJ+SL9pd3IeRPgCdqZyI0/WKHBPbW5wd4l4xh",
+	"QnuAFgTmyQRzoNxPNr8zCD9A+E4jcx9ZM+fetgfpJEInf1HDEEbFSiZMvQu1vHrOdhtkUgXb1SO+vNk1",
+	"9B2jbjvv4PxsVUtrHaD4xoE/LU4yLazBf7EPNF8t5Z8gqKFVGCYDVr9JcwDrvpkA2hQEHqju2QFxC0mW",
+	"sL2whVh16448ifwA9+w53Bo2C7pDQhb8Tma1/n770/3eB15Kk1PDEvyMZ0UlRMjOOHf9SI9GhToBzkel",
+	"0EHYzggu6KVJ2/i2wHs0kQUPlz0c4E47yB48FsxkD/MCCAbcpFwGihDZ6YrIeCeuFa1rEO3AKHMuusX2",
+	"nQYEa8K6pMqp7wgoViq24oIRbjSBWu3nYoApHeYovg1E8c8SiZD3z5l0lRhjZX3e2xnsnBxucDsM9MWH",
+	"zwXjmH3G3jc35IbXNVHQryF9iJnDtxYrfSAo9yk04Yi89DAcbrPYmNz2wxlmfBu9KZl1O0WcdqyvQnNI",
+	"BrX7wsP/Yoc7HkK2EvU+pJhRu9Lh+HC/mXdyoWoNkeIFPjk4Ftx2OB3unbyGDwPydxisbpf5UYJGk6H7",
+	"se3u7oDpKDl8G2NC8yLW3WoOiFp/IIhYTAkRdKt8ZPHy0xBxRmCq++IoMmGGDQ7dYA9FtzRM9HFi3CML",
+	"1Z/BeD8Ovh9HIpzOVWPph2lKcKdUJz9xsyY2qAC94M9KfY3RUckuvT8RFexUqDoWPe+TOs7aKEY31pJE",
+	"id4CEeo1Y4asFGeigugifW3DKyQa1qRgNijLBqqQLVOk5oIV5wI14DFAQbOalfC7lHWzEbogjaiZ1rig",
+	"jU1AfsmvWfZa3meD+QgU+VHMCOMNdYyymtB6xWvDVNJ+L3sgwg0xba/oSNXE1LO2el2PmwoU+bINF0Uu",
+	"1GEwY+LgnGS2GsrWliBnXOEcK2p6pyavmaqarDv4HJcZnMJLi9D3aMErFjnabne2t6ELzxuG241bBweC",
+	"KgALC2KxC6sI2K1B6IAc4OO9jmZgzERkwZMDbkuhiMfedQi3/mA1txXf23VCHsPtyK76nu9FyaAZJDzk",
+	"KpRGxvRdhAJcujg0dlZPvQHZ2c+6+hyOdPcngioIM4yhgq4MUBHo8vFcg0bwLuV9n1l03+d9tinEjYXa",
+	"8lTYHOVB2TKf8WXY2qD9bjau2S9/L+h27DvQHeFbq7jFBLSbz+YOYmVntDT82lXzGTXk0abimG3fZke3",
+	"BRDAGa1iFvl9zSFZVwMu1LgJL/zAD/3SzR2hPGz88tvZp872cD6ezxcihO81KYF88LF6Vq55XSkmJuFi",
+	"xRX6y7hvujPIYNxL3/1DxzhY3paik+OQAPnQTtOP4G2I0D/2lSCJ+bW/qUYHAgsSm1lmzZmiqlzvCNfE",
+	"6T3OBRekYluzzik0wPwAs30M5+6douA/8d1lyKki2ezDnbRHzD+RWg5n0jUXV9NCrbCly/AkosBmBeRI",
+	"sxlO/R2O8SgEg6CgwtU+Ek6N+zvIrR3w7oBlJ92nSAgPZjJs+CS9c9meHfPSdJMmoIBHXFwzpe3YhOtz",
+	"4QnURX0nvZySL6FiJrie2Y1wuWMwc0gZ6GlXurxGiv2Kqu4exh82/THhtLhC2n0UnB/39mNw/zhwHxH1",
+	"HAM9Sf3wC59cx3J/uAbnEO+Wp8J3OFLCl1tklCHNGcfD2T/gz4VTlfUF7b1hoDbRnpKBtrnRnkwzmSWx",
+	"n0dGS0ehnpGBPCNUuKH5ER1Ajh+elmC6HX+iwINfHFfQsQjl8WnJzA0LRqODERq3tDez2yuLwZ6MPA6H",
+	"3CL7KsHsAeWy9/qih+fCeajuio5THwywX88czyC0tCCnwdSQ1jDmK0m6wYtz0Soy7yGGQhpMPN5rYKRQ",
+	"G9RmUnMcPGZYcP0Im7xY1lVYpD0U7R09fyzCzj0aHWTraOwnsodxKOKuwvbeewq56Tr1XntiQM1gPHT4",
+	"1cHk4ZBX24tUnY+OzG9eyWuWPUIzrr7zLlmKXXM9KaeFzSfg2wfm4koo2jxHCNpUQTZJQfsmzOGThvZo",
+	"1BE2dfj2FeA/7Sy9pdZgSMMbZ3IUFS+eXf0pMvYICrVy+JG9w/lwDaOo0NwlllDs+bk4F/8WRG3QMmyZ",
+	"KAgXF1slL22CU8W0rK9tsseylho0dv+GDeGDCU2TJnGIfNPw1LVrv7X/+XeZw/FdWN7jUBcGgEW4PPgT",
+	"Mu7xgz4nZ5L8wN3SfmLLl6Og7Pz03A4zJ1neVtuIRsGQVpEdh29cS3+DHL5LwrDX0o66lyyipYosrIbf",
+	"ZX1lqxWclzxWTIU3sFPQWzZbDLx4RNT5e/LIuKuDcPhWiXgFV58cOsPLfmeNF1U1gpst87mrd2pT/Lcw",
+	"dB8R//YJDf8p0PBvE7BvGi+1iVgGmOmPQjdLeLBk+rZclCMTTetzd/kovvuEwZ+P3odJLcUllAFlwBxc",
+	"op3Te0fEt0Zuk6RLGWz0mX56mOHbAeRqscE4yBRG+NMnPJqCRx8Ze37yVXZ6sWYGExsvjK0DJ9IkMLVq",
+	"trOaL33/6Yw9yhnrt7NPCeKB+9G1H2EiPZeYGTgLf/FJrP83DXmT9uk09mwNY4j8Ihn3MaOx28jbDYeJ",
+	"edElIbfFfk/7YoncazuLj+TvGafc6/KZrOpjElL0/UhmlFJRfDzgAfLjFqrSgbXYF+1KODgKDlLg1apl",
+	"f8NybxFccABulbzmUCfP2tz439m5SPXkAEW0X5VrhoXZUnNaKcWKXzaKVdbrTxehjKb/nmso0gmoXJ0L",
+	"/61e02df/MFOh5vWNLI5YmCtEcT/dPT6ywF14/qqvyXE+nzc6dG1RvzC/cenDuVOc+Xg+qvLJZaXNpeZ",
+	"WGjOIk2+7zV7T7BSHqvI269fnAB2IcLqZuMHhJmd5iItW5XoblF/7ukdsLNhTtbjTfNkGABI0vYm6gwI",
+	"1poQNqxdA1KqFipki3LOV8set3Rdv4RtmQdwxzbCZbntgYJLCIPrUyFYXx3dnkUisrgaW3kXn39ixjcy",
+	"HE135p5ujwn8LLQnOvik3x31CmmRZCKCFxMiSZihvPbCdbvPvSjNT7j50XDzyf2fNveG6L2y851z8bNk",
+	"R0dJpe1LEruyx+meHJJUnifU9vDnd/Syv2j4J+p6kNQlS8PMic0Vc+vy6Kkw59Dpo5JZWvD8yLTmr6ET",
+	"EgaEiDb3ifV6g/kSqSqmCsLgMqKguC5ZSZBidUtK5QZfcgaSpL3Rxt+Jx6lrThWzHduqw3gxNWu2s+Vg",
+	"fKndzN30pV/T4wrP8ft6elySfZQlBrhhm9Hy5R7OVrsU6JoqRbNpVQPizogZ8mq2cc3RywR8nizd14fk",
+	"yYzqvf0gTdfro0HvRE3RVVvetSbmdsk43UbfdyLOdNgsCh+SdGaw8nIMwHQDSJHLQRPx+ZAzZvqVPEyj",
+	"L2VIkbTBWGh/kKA8t61pySr7gp4L/LkG04Mi2h407tzShu7gHDO0zFZRtxN6VPR2f/Jeood0AL23C78f",
+	"etZtP68smySaZWgnx+LHcs6OIHU+3exjY/ZDyZXuFgsfSXbbce5+K/kkK3sM1a89BDFdYtpPuDkVNx9X",
+	"Dqi7wdFB7nmwQHGmGC1dIU7/78SgXt98wBXbw3CqLzZ2ybJ1HWHUN27ET7JEllLuJhT5B2t1//enBTl5",
+	"WpCaNpfrAu3kjWZVQdaMKlOQtZSK7gqiZHnFDJjY2I713cZVBOQjPFYGDJKBJlyAo262W6nMmCPecYWr",
+	"jGt7mFebLmc6uHf7yXp3Rpq3jae6d76oqk/0/Ym+P9H3LPqmpWlR3WFBA72ywe2ilFNrUmQLw16MDlK/",
+	"j+DkB84L7oHiRuOhI/nNiog+AkVNj4rOEdd02fv5sqmv+jOMvNiCxSfab1zOVSMT7bjtyPtBJvWyNyGC",
+	"wqVoL0izhTZfPHkS60AYn9Wf/Bk0ySFUwI5VES4qtmWisjW0nWUJ2ZrPzaCYtoUYuVnbFCOKUY13XbKi",
+	"vIaDAk1M1MgNL6FzzQwc90ktijAcGJd3odAefH8K8d0vREgehtGqMJgv2XFB0alSgltv0g83/uqwZEz4",
+	"xkRzUbrQpXNBrS0t2dhlkk35Zk0tyEta10yRmmpjC5xnNJpfNvVVkl1Z/25raeHy4mo/SgA1DP/G4l2O",
+	"cbwLaBmOGxeZLK0p1enfB/0H8QtdeCyVypHRoeUNhiKmN4D0kSSlKGfGS4dsPZOKBcbWrfKEqVHYl9Qo",
+	"0sZAdbIx6G0NuwAEsX9Mv4pzeXgHdLuwiHDstZ2mIVlyXxSAzWWRjsxEswFYQVeLYmF7Snxr7+VkjVvf",
+	"d6Ym0LyTuobtrfMIHJ4eYLBF2IQOxusZhj34PVczjIu8ZxNqZ+Ae5JqX+DB+ZrixDtE+DaKhV0ycHq0I",
+	"YhipDzmncNipdUDismbZpR4Q/o7cNxK4PRYb0UT0nXTPiM2PWvmwwytHqlsjxRQkeY8c1B/f9nw/BBPt",
+	"GI8UGR9NccSZfPzeCWG/KuKBBHH/7P9W7H0kWdlLkO5AYFQMxZP0ZCEv4g97T03r15VUWLFzyby0CZfj",
+	"cwHa+AtoeWFbXhhJAGN1yCy4LwiZWJG/iHJsvFbbXlHe5SYEK4b8ZPDAzcF9A247vgQC25OGTwezlT1S",
+	"VvE4spaFzf14mcsOOjqfjJK8dPnIOtgLKKtb1+y7ZDxgf2rRjqhAQZWd5gaDQW8rkQ4wkNmiqdXIjWvi",
+	"1/LGL3BVyxti1ko2l+s2GwHIN8bnBTZY70QbqZI8uMgb9em5TVCog7Bvr26euShGStkIpylEtcuWKS6r",
+	"kJ3ZZRMC/lQ4YWbD0IPd9YIvbaZ9fAV9bhjVGIOd1LhHe0EcGjkjNUSBbuWU/BAAey5wRha+9BKzoVAs",
+	"bKoF3eq1NK3Uymm1UYSSkDeojXxjN5yUVClQimJkC3a6oZaz03LtpmjL774/oZch+ddLeHvyUgqjZA1G",
+	"tIqpHG/9KzOvLVjsgA+Qsb6zqf5U2DiLiyCQriiqhY0knz0hFbiDujKyNpY6p1UBhDhC8ViYFBPV0JRM",
+	"u4m3VFsE7ZuekUeaXM3EJcgFqxZZcEF+fPeyIDeMXWm/q4K8kqKiu745cWGYuqZ1a2ZupTg1aOt1U/YX",
+	"9L8oFhspzHrxy8QpI4Zbg4fFbburYVoWi+O8vlmdfC8FO3lF7bXw41z32uSTObe85tOuplP6sc++lG4A",
+	"2kos9gzrk5EZEamIh9iM883P8obaT1ayEZM0zw5IQ+YsPAncWlJlcHoM2ddTzqAzXdNJ0YRwhjQ6ZitQ",
+	"Ou5+PEga+JK8/e4FyKLlle6wZy7QC90fXRSSpEXxNV82Gs4Frq+gp6UC8oNDQCqyptfMPWEoJ3N1LmwK",
+	"cV2kmvEYLuXKY/ew7rc1fShs+y5JLC4zQ14AuQxpfRRET+YyCc91TU+2suYln2ZUgf59+3iuuBoPewjy",
+	"tqavfed3Cx0/TJ9FoDXvGTYBXdMJ9oC093SrgU/MtwWE3nanxP/nolFIpA2C+xWDH70Fb4ViYcIw8F59",
+	"wzVzeXkMZgTAs9Om26zrVDjeA6tjK5UEKzMwELR/c0XkjQjzfLdmCffq3NCJYkHgDowLVuq+zteU8lDd",
+	"Le7MwBCHuGcDQ2fgHlzdzUsG477hWGFo+nmbzf6SuUyGSXWqN3n5P9pXE44wjcIG7RkiJYg94uoysYlR",
+	"Yu1uBwLFUlReAaksaXnlaS6QiKOnc2H7KzoapyvGtva0JSsuaI1DW+GgP24sxf8ZR2uyqo8QWpWMPiu6",
+	"Kv1uvp2hg0Nd9jtswpqECKc9ws/tIfQ4TEpTGdY9AXvfoBSO2uFS8pNo3kw8zM5FOM28CaH/RLNzOArK",
+	"PBrDz8zz9f7RNW/5Oc75eocsDQ49TPk7JrJHERWbZwygOpv091FmfRhESD2QjRf3Zs6tAD6YcC0Ie+6h",
+	"Bw8OuBA02nOlWl5y9L1vnIfXBriEK++Ks8pJ0rD4uxOisfd7lp/jmPuQnCc0hx3taqn+ONJ+tvUWwTPs",
+	"ueMh2MGXQO5TnXJwG+L5NizFOOyYcRr5UKfHIboM4sokeQVbHsDDO2yiC9XR78M1ilYbLhbF3nPse/+x",
+	"RbIi64VT5C13RSaNVJFz7S2yUQRFPlqn2N+J8OhXuew8Ye+jeqv7sDvLTe5pqh8r9sTFou/GydR1Hutf",
+	"K1k1NtzJNloUi0bVi+eLtTFb/fzsjG75qSu/eiPrFTvl1Sltzq6fLj788uH/DgDNzLC8/ZsBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,32 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/conf"
+)
+
+// ErrNotFound the blob does not exist.
+var ErrNotFound = errors.New("blob not found")
+
+// Store stores the content of attachments by key.
+type Store interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New create the blob store configured by the blob backend, either filesystem or s3.
+func New(cfg *conf.Config) (Store, error) {
+	switch cfg.BlobBackend {
+	case "filesystem":
+		return NewFileStore(cfg.BlobDir), nil
+	case "s3":
+		return NewS3Store(cfg)
+	}
+
+	return nil, fmt.Errorf("unknown blob backend: %s", cfg.BlobBackend)
+}
@@ -0,0 +1,99 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// FileStore stores blobs as files under a root directory.
+type FileStore struct {
+	root string
+}
+
+// NewFileStore new file store, directories are created as blobs are written.
+func NewFileStore(root string) *FileStore {
+	return &FileStore{root: root}
+}
+
+// Put write a blob, the content is written to a temporary file which is renamed into place so
+// readers never see a partial blob.
+func (fs *FileStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	path, err := fs.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return errors.Wrapf(err, "failed to create directory for blob: %s", key)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return errors.Wrapf(err, "failed to create blob: %s", key)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "failed to write blob: %s", key)
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "failed to sync blob: %s", key)
+	}
+
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close blob: %s", key)
+	}
+
+	return errors.Wrapf(os.Rename(tmp.Name(), path), "failed to rename blob: %s", key)
+}
+
+// Get open a blob for reading.
+func (fs *FileStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := fs.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to open blob: %s", key)
+	}
+
+	return f, nil
+}
+
+// Delete remove a blob, removing a blob which doesn't exist has no effect.
+func (fs *FileStore) Delete(ctx context.Context, key string) error {
+	path, err := fs.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to delete blob: %s", key)
+	}
+
+	return nil
+}
+
+// path the file for a key, keys can't escape the root directory.
+func (fs *FileStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if key == "" || clean != "/"+key || strings.Contains(key, `\`) {
+		return "", fmt.Errorf("invalid blob key: %s", key)
+	}
+
+	return filepath.Join(fs.root, filepath.FromSlash(clean)), nil
+}
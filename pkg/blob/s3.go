@@ -0,0 +1,252 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/conf"
+)
+
+const (
+	// unsignedPayload lets uploads stream without hashing the body up front, the body is
+	// still protected by TLS and verified against the attachment checksum.
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// emptyPayloadHash the SHA-256 of an empty body.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	amzDateFormat = "20060102T150405Z"
+)
+
+// S3Store stores blobs in a bucket of an S3 compatible service using path style requests signed
+// with AWS signature version 4.
+type S3Store struct {
+	endpoint  *url.URL
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewS3Store new S3 store using the endpoint, bucket and credentials in the config.
+func NewS3Store(cfg *conf.Config) (*S3Store, error) {
+	endpoint, err := url.Parse(cfg.S3Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint: %s", cfg.S3Endpoint)
+	}
+
+	if cfg.S3Bucket == "" {
+		return nil, errors.New("missing s3 bucket")
+	}
+
+	return &S3Store{
+		endpoint:  endpoint,
+		bucket:    cfg.S3Bucket,
+		region:    cfg.S3Region,
+		accessKey: cfg.S3AccessKeyID,
+		secretKey: cfg.S3SecretAccessKey,
+		client:    http.DefaultClient,
+		now:       time.Now,
+	}, nil
+}
+
+// Put upload a blob.
+func (ss *S3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	body := ioutil.NopCloser(r)
+	if size == 0 {
+		body = http.NoBody
+	}
+
+	req, err := ss.request(ctx, http.MethodPut, key, body)
+	if err != nil {
+		return err
+	}
+
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := ss.do(req, unsignedPayload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to put blob: %s", key)
+	}
+
+	return res.Body.Close()
+}
+
+// Get download a blob, the caller closes the reader.
+func (ss *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := ss.request(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := ss.do(req, emptyPayloadHash)
+	if err != nil {
+		if err == ErrNotFound {
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to get blob: %s", key)
+	}
+
+	return res.Body, nil
+}
+
+// Delete remove a blob, removing a blob which doesn't exist has no effect.
+func (ss *S3Store) Delete(ctx context.Context, key string) error {
+	req, err := ss.request(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := ss.do(req, emptyPayloadHash)
+	if err != nil {
+		if err == ErrNotFound {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to delete blob: %s", key)
+	}
+
+	return res.Body.Close()
+}
+
+func (ss *S3Store) request(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	u := *ss.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + ss.bucket + "/" + key
+	u.RawPath = ""
+
+	return http.NewRequestWithContext(ctx, method, u.String(), body)
+}
+
+// do sign and send a request, responses other than 2xx are returned as errors.
+func (ss *S3Store) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	ss.sign(req, payloadHash, ss.now())
+
+	res, err := ss.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return res, nil
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+
+	msg, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+
+	return nil, fmt.Errorf("unexpected status %s: %s", res.Status, strings.TrimSpace(string(msg)))
+}
+
+// sign adds an AWS signature version 4 authorization header, the host and every header already
+// set on the request are signed.
+func (ss *S3Store) sign(req *http.Request, payloadHash string, now time.Time) {
+	now = now.UTC()
+	amzDate := now.Format(amzDateFormat)
+	scope := strings.Join([]string{now.Format("20060102"), ss.region, "s3", "aws4_request"}, "/")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	canonicalHeaders := new(strings.Builder)
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		hexSHA256([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+ss.secretKey), now.Format("20060102"))
+	key = hmacSHA256(key, ss.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		ss.accessKey, scope, signedHeaders, signature))
+}
+
+func canonicalQuery(values url.Values) string {
+	pairs := []string{}
+	for name, vals := range values {
+		for _, val := range vals {
+			pairs = append(pairs, uriEncode(name, true)+"="+uriEncode(val, true))
+		}
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, "&")
+}
+
+// uriEncode percent encodes everything except the unreserved characters, and slashes when
+// encoding a path.
+func uriEncode(s string, encodeSlash bool) string {
+	b := new(strings.Builder)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,216 @@
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dateLayout the layout of holidays.
+const dateLayout = "2006-01-02"
+
+// maxDays the furthest ahead a duration is added before giving up, this guards against a
+// calendar which is all holidays.
+const maxDays = 366 * 10
+
+// weekdays lookup of the lower case names of the days of the week.
+var weekdays = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// ValidationError returned when a calendar is not valid.
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Message
+}
+
+// Hours the business hours of a day of the week, start and end are the number of minutes
+// since midnight.
+type Hours struct {
+	Day   time.Weekday
+	Start int
+	End   int
+}
+
+// Calendar the business hours and holidays used to measure time, a calendar without hours is
+// open all the time.
+type Calendar struct {
+	loc      *time.Location
+	hours    [7][]Hours
+	always   bool
+	holidays map[string]bool
+}
+
+// New new calendar in the timezone, the timezone defaults to UTC.
+func New(timezone string, hours []Hours, holidays []string) (*Calendar, error) {
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, &ValidationError{Message: fmt.Sprintf("unknown timezone: %s", timezone)}
+	}
+
+	cal := &Calendar{loc: loc, always: len(hours) == 0, holidays: map[string]bool{}}
+
+	for _, h := range hours {
+		if h.Day < time.Sunday || h.Day > time.Saturday {
+			return nil, &ValidationError{Message: fmt.Sprintf("invalid day: %d", h.Day)}
+		}
+		if h.Start < 0 || h.End > 24*60 || h.Start >= h.End {
+			return nil, &ValidationError{Message: fmt.Sprintf("invalid hours on %s: start must be before end", strings.ToLower(h.Day.String()))}
+		}
+		cal.hours[h.Day] = append(cal.hours[h.Day], h)
+	}
+
+	for day, spans := range cal.hours {
+		sort.Slice(spans, func(i, j int) bool { return spans[i].Start < spans[j].Start })
+		for i := 1; i < len(spans); i++ {
+			if spans[i].Start < spans[i-1].End {
+				return nil, &ValidationError{Message: fmt.Sprintf("overlapping hours on %s", strings.ToLower(time.Weekday(day).String()))}
+			}
+		}
+	}
+
+	for _, holiday := range holidays {
+		if _, err := time.Parse(dateLayout, holiday); err != nil {
+			return nil, &ValidationError{Message: fmt.Sprintf("invalid holiday: %s must be formatted as YYYY-MM-DD", holiday)}
+		}
+		cal.holidays[holiday] = true
+	}
+
+	return cal, nil
+}
+
+// ParseWeekday parse the lower case name of a day of the week.
+func ParseWeekday(name string) (time.Weekday, error) {
+	day, ok := weekdays[name]
+	if !ok {
+		return 0, &ValidationError{Message: fmt.Sprintf("invalid day: %s", name)}
+	}
+
+	return day, nil
+}
+
+// ParseClock parse a time of day formatted as HH:MM into minutes since midnight, 24:00 is
+// the end of the day.
+func ParseClock(clock string) (int, error) {
+	parts := strings.Split(clock, ":")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 {
+		return 0, &ValidationError{Message: fmt.Sprintf("invalid time: %s must be formatted as HH:MM", clock)}
+	}
+
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, &ValidationError{Message: fmt.Sprintf("invalid time: %s must be formatted as HH:MM", clock)}
+	}
+
+	minute, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, &ValidationError{Message: fmt.Sprintf("invalid time: %s must be formatted as HH:MM", clock)}
+	}
+
+	if hour < 0 || minute < 0 || minute > 59 || hour*60+minute > 24*60 {
+		return 0, &ValidationError{Message: fmt.Sprintf("invalid time: %s is out of range", clock)}
+	}
+
+	return hour*60 + minute, nil
+}
+
+// Elapsed the business time between from and to.
+func (c *Calendar) Elapsed(from, to time.Time) time.Duration {
+	if !to.After(from) {
+		return 0
+	}
+
+	if c.always {
+		return to.Sub(from)
+	}
+
+	var elapsed time.Duration
+
+	for day := c.startOfDay(from); day.Before(to); day = c.nextDay(day) {
+		for _, span := range c.spans(day) {
+			start, end := c.at(day, span.Start), c.at(day, span.End)
+			if start.Before(from) {
+				start = from
+			}
+			if end.After(to) {
+				end = to
+			}
+			if end.After(start) {
+				elapsed += end.Sub(start)
+			}
+		}
+	}
+
+	return elapsed
+}
+
+// Add the time once the duration of business time has passed after from.
+func (c *Calendar) Add(from time.Time, d time.Duration) time.Time {
+	if c.always || d <= 0 {
+		return from.Add(d)
+	}
+
+	remaining := d
+	day := c.startOfDay(from)
+
+	for i := 0; i < maxDays; i++ {
+		for _, span := range c.spans(day) {
+			start, end := c.at(day, span.Start), c.at(day, span.End)
+			if start.Before(from) {
+				start = from
+			}
+			if !end.After(start) {
+				continue
+			}
+
+			available := end.Sub(start)
+			if remaining <= available {
+				return start.Add(remaining)
+			}
+			remaining -= available
+		}
+
+		day = c.nextDay(day)
+	}
+
+	return day
+}
+
+// spans the business hours of the day, holidays have none.
+func (c *Calendar) spans(day time.Time) []Hours {
+	if c.holidays[day.Format(dateLayout)] {
+		return nil
+	}
+
+	return c.hours[day.Weekday()]
+}
+
+func (c *Calendar) startOfDay(t time.Time) time.Time {
+	t = t.In(c.loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, c.loc)
+}
+
+func (c *Calendar) nextDay(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day()+1, 0, 0, 0, 0, c.loc)
+}
+
+// at the time of day on the day, this is resolved in the calendar timezone so it is correct
+// across daylight saving changes.
+func (c *Calendar) at(day time.Time, minutes int) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), minutes/60, minutes%60, 0, 0, c.loc)
+}
@@ -0,0 +1,96 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func businessWeek(t *testing.T, timezone string, holidays ...string) *Calendar {
+	hours := []Hours{}
+	for day := time.Monday; day <= time.Friday; day++ {
+		hours = append(hours, Hours{Day: day, Start: 9 * 60, End: 17 * 60})
+	}
+
+	cal, err := New(timezone, hours, holidays)
+	require.NoError(t, err)
+
+	return cal
+}
+
+func TestNew_Validation(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := New("Mars/Olympus", nil, nil)
+	assert.IsType(&ValidationError{}, err)
+
+	_, err = New("UTC", []Hours{{Day: time.Monday, Start: 17 * 60, End: 9 * 60}}, nil)
+	assert.IsType(&ValidationError{}, err)
+
+	_, err = New("UTC", []Hours{{Day: time.Monday, Start: 9 * 60, End: 13 * 60}, {Day: time.Monday, Start: 12 * 60, End: 17 * 60}}, nil)
+	assert.IsType(&ValidationError{}, err)
+
+	_, err = New("UTC", nil, []string{"25/12/2026"})
+	assert.IsType(&ValidationError{}, err)
+}
+
+func TestParseClock(t *testing.T) {
+	assert := require.New(t)
+
+	minutes, err := ParseClock("09:30")
+	assert.NoError(err)
+	assert.Equal(9*60+30, minutes)
+
+	minutes, err = ParseClock("24:00")
+	assert.NoError(err)
+	assert.Equal(24*60, minutes)
+
+	for _, clock := range []string{"9:30", "24:01", "12:60", "noon"} {
+		_, err = ParseClock(clock)
+		assert.IsType(&ValidationError{}, err, clock)
+	}
+}
+
+func TestCalendar_AlwaysOpen(t *testing.T) {
+	assert := require.New(t)
+
+	cal, err := New("", nil, nil)
+	assert.NoError(err)
+
+	from := time.Date(2026, 10, 17, 22, 0, 0, 0, time.UTC)
+	assert.Equal(from.Add(4*time.Hour), cal.Add(from, 4*time.Hour))
+	assert.Equal(4*time.Hour, cal.Elapsed(from, from.Add(4*time.Hour)))
+	assert.Equal(time.Duration(0), cal.Elapsed(from, from.Add(-time.Hour)))
+}
+
+func TestCalendar_BusinessHours(t *testing.T) {
+	assert := require.New(t)
+
+	cal := businessWeek(t, "UTC", "2026-10-19")
+
+	// friday afternoon, over the weekend and the monday holiday
+	from := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	due := cal.Add(from, 4*time.Hour)
+	assert.Equal(time.Date(2026, 10, 20, 11, 0, 0, 0, time.UTC), due)
+	assert.Equal(4*time.Hour, cal.Elapsed(from, due))
+
+	// created out of hours starts at the next opening
+	from = time.Date(2026, 10, 20, 6, 0, 0, 0, time.UTC)
+	assert.Equal(time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC), cal.Add(from, time.Hour))
+	assert.Equal(8*time.Hour, cal.Elapsed(from, time.Date(2026, 10, 21, 6, 0, 0, 0, time.UTC)))
+}
+
+func TestCalendar_DaylightSaving(t *testing.T) {
+	assert := require.New(t)
+
+	cal := businessWeek(t, "Australia/Melbourne")
+	loc, err := time.LoadLocation("Australia/Melbourne")
+	assert.NoError(err)
+
+	// clocks go forward on sunday the 4th of october 2026, the opening time doesn't move
+	from := time.Date(2026, 10, 2, 16, 0, 0, 0, loc)
+	due := cal.Add(from, 2*time.Hour)
+	assert.Equal(time.Date(2026, 10, 5, 10, 0, 0, 0, loc), due)
+	assert.Equal(2*time.Hour, cal.Elapsed(from, due))
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
@@ -31,6 +32,54 @@ type Config struct {
 	ClientID             string `envconfig:"OAUTH_CLIENT_ID"`
 	MetricsWriteInterval int    `envconfig:"METRICS_WRITE_INTERVAL"`
 	DbSecrets            string `envconfig:"DB_SECRET"`
+
+	// SMTPAddr the host:port of the SMTP server used to send email, email is disabled when empty.
+	SMTPAddr          string        `envconfig:"SMTP_ADDR"`
+	SMTPUsername      string        `envconfig:"SMTP_USERNAME"`
+	SMTPPassword      string        `envconfig:"SMTP_PASSWORD"`
+	SMTPFrom          string        `envconfig:"SMTP_FROM" default:"exitus@localhost"`
+	EmailPollInterval time.Duration `envconfig:"EMAIL_POLL_INTERVAL" default:"30s"`
+	EmailDigestHour   int           `envconfig:"EMAIL_DIGEST_HOUR" default:"8"`
+	EmailMaxAttempts  int           `envconfig:"EMAIL_MAX_ATTEMPTS" default:"5"`
+
+	// BlobBackend where attachment content is stored, either filesystem or s3.
+	BlobBackend       string `envconfig:"BLOB_BACKEND" default:"filesystem"`
+	BlobDir           string `envconfig:"BLOB_DIR" default:"data/blobs"`
+	S3Endpoint        string `envconfig:"S3_ENDPOINT" default:"https://s3.amazonaws.com"`
+	S3Bucket          string `envconfig:"S3_BUCKET"`
+	S3Region          string `envconfig:"S3_REGION" default:"us-east-1"`
+	S3AccessKeyID     string `envconfig:"S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `envconfig:"S3_SECRET_ACCESS_KEY"`
+
+	// AttachmentMaxSize the largest attachment in bytes, and the content types which may be uploaded.
+	AttachmentMaxSize      int64    `envconfig:"ATTACHMENT_MAX_SIZE" default:"26214400"`
+	AttachmentContentTypes []string `envconfig:"ATTACHMENT_CONTENT_TYPES" default:"image/png,image/jpeg,image/gif,image/webp,text/plain,text/csv,application/json,application/pdf,application/zip,application/gzip"`
+
+	// JobWorkers the number of background jobs run at once by each process, jobs are leased while
+	// they run and given the shutdown timeout to finish when the process stops.
+	JobWorkers         int           `envconfig:"JOB_WORKERS" default:"4"`
+	JobPollInterval    time.Duration `envconfig:"JOB_POLL_INTERVAL" default:"1s"`
+	JobLease           time.Duration `envconfig:"JOB_LEASE" default:"1m"`
+	JobMaxAttempts     int           `envconfig:"JOB_MAX_ATTEMPTS" default:"5"`
+	JobShutdownTimeout time.Duration `envconfig:"JOB_SHUTDOWN_TIMEOUT" default:"30s"`
+
+	// ImportMaxSize the largest file in bytes which can be imported.
+	ImportMaxSize int64 `envconfig:"IMPORT_MAX_SIZE" default:"33554432"`
+
+	// ReportMaxAge how long clients may cache a project report before asking for it again.
+	ReportMaxAge time.Duration `envconfig:"REPORT_MAX_AGE" default:"5m"`
+
+	// SLACheckInterval how often open issues are checked for SLA targets which are at risk or breached.
+	SLACheckInterval time.Duration `envconfig:"SLA_CHECK_INTERVAL" default:"1m"`
+
+	// DueReminderInterval how often issues which are due soon or overdue are checked for reminders.
+	DueReminderInterval time.Duration `envconfig:"DUE_REMINDER_INTERVAL" default:"5m"`
+
+	// DueSoonWindow how long before an issue is due the due soon reminder is sent.
+	DueSoonWindow time.Duration `envconfig:"DUE_SOON_WINDOW" default:"24h"`
+
+	// ContentHTMLRefreshInterval how often html rendered by an older version of the renderer is rendered again.
+	ContentHTMLRefreshInterval time.Duration `envconfig:"CONTENT_HTML_REFRESH_INTERVAL" default:"10m"`
 }
 
 type DBSecrets struct {
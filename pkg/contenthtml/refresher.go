@@ -0,0 +1,67 @@
+package contenthtml
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// batchSize the number of issues and comments rendered in each transaction.
+const batchSize = 500
+
+// Refresher renders the content of issues and comments again when the html cached for it was
+// rendered by an older version of the renderer, so reads don't have to write it back.
+type Refresher struct {
+	cfg  *conf.Config
+	html store.ContentHTML
+}
+
+// NewRefresher new content html refresher.
+func NewRefresher(cfg *conf.Config, html store.ContentHTML) *Refresher {
+	return &Refresher{cfg: cfg, html: html}
+}
+
+// Run refresh every interval until the context is cancelled, this is safe to run on every
+// replica as rows being refreshed by another replica are skipped.
+func (r *Refresher) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.cfg.ContentHTMLRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Refresh(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to refresh content html")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh render the stale html in batches until there is none left.
+func (r *Refresher) Refresh(ctx context.Context) error {
+	total := 0
+
+	for ctx.Err() == nil {
+		refreshed, err := r.html.Refresh(ctx, batchSize)
+		total += refreshed
+		if err != nil {
+			return err
+		}
+
+		if refreshed < batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Info().Int("refreshed", total).Msg("refreshed content html")
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+// Package export writes the data of a customer, or one of its projects, to a versioned NDJSON
+// archive. The archive format is documented in README.md.
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+const (
+	// Format identifies an exitus export archive.
+	Format = "exitus-export"
+
+	// Version the version of the archive format, it is increased whenever a change to the records
+	// would break a reader of older archives.
+	Version = 1
+
+	// The kinds of record which wrap the records streamed from the store.
+	kindHeader = "header"
+	kindFooter = "footer"
+)
+
+// Record a line of the archive.
+type Record struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// Header the first record of an archive.
+type Header struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	CustomerID string    `json:"customer_id"`
+	ProjectID  *string   `json:"project_id"`
+	ExportedAt time.Time `json:"exported_at"`
+}
+
+// Footer the last record of an archive, an archive without one is incomplete.
+type Footer struct {
+	Counts map[string]int `json:"counts"`
+}
+
+// Write stream the export to w one record per line, the records are encoded as they are read
+// from the store so the export is never held in memory.
+func Write(ctx context.Context, w io.Writer, exports store.Exports, opt *store.ExportOptions, now time.Time) (*Footer, error) {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+
+	header := &Header{Format: Format, Version: Version, CustomerID: opt.CustomerID, ExportedAt: now.UTC()}
+	if opt.ProjectID != "" {
+		header.ProjectID = &opt.ProjectID
+	}
+
+	if err := enc.Encode(&Record{Type: kindHeader, Data: header}); err != nil {
+		return nil, err
+	}
+
+	footer := &Footer{Counts: map[string]int{}}
+	for _, kind := range store.ExportKinds {
+		footer.Counts[kind] = 0
+	}
+
+	err := exports.Stream(ctx, opt, func(kind string, record interface{}) error {
+		footer.Counts[kind]++
+		return enc.Encode(&Record{Type: kind, Data: record})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := enc.Encode(&Record{Type: kindFooter, Data: footer}); err != nil {
+		return nil, err
+	}
+
+	return footer, bw.Flush()
+}
@@ -0,0 +1,130 @@
+package export
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/jobs"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// fakeExports streams a fixed set of records and keeps the saved archive.
+type fakeExports struct {
+	records []Record
+	err     error
+	archive []byte
+	size    int64
+}
+
+func (fe *fakeExports) Stream(ctx context.Context, opt *store.ExportOptions, fn store.ExportFunc) error {
+	if fe.err != nil {
+		return fe.err
+	}
+
+	for _, rec := range fe.records {
+		if err := fn(rec.Type, rec.Data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (fe *fakeExports) SaveArchive(ctx context.Context, id, customerId string, r io.Reader, size int64) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	fe.archive, fe.size = data, size
+
+	return nil
+}
+
+func (fe *fakeExports) OpenArchive(ctx context.Context, id, customerId string) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(fe.archive)), nil
+}
+
+func newFakeExports() *fakeExports {
+	projectId := "project-1"
+
+	return &fakeExports{records: []Record{
+		{Type: store.ExportKindProject, Data: &store.ExportProject{ID: projectId, Name: "api"}},
+		{Type: store.ExportKindLabel, Data: &store.ExportLabel{ProjectID: &projectId, Name: "bug"}},
+		{Type: store.ExportKindIssue, Data: &store.ExportIssue{ID: "issue-1", ProjectID: projectId, Subject: "<b>fails</b>", Labels: []string{"bug"}}},
+		{Type: store.ExportKindIssue, Data: &store.ExportIssue{ID: "issue-2", ProjectID: projectId, Subject: "works"}},
+		{Type: store.ExportKindComment, Data: &store.ExportComment{ID: "comment-1", ProjectID: projectId, IssueID: "issue-1"}},
+	}}
+}
+
+func TestWrite(t *testing.T) {
+	assert := require.New(t)
+
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	buf := &bytes.Buffer{}
+
+	footer, err := Write(context.Background(), buf, newFakeExports(), &store.ExportOptions{CustomerID: "customer-1", ProjectID: "project-1"}, now)
+	assert.NoError(err)
+	assert.Equal(map[string]int{"project": 1, "label": 1, "issue": 2, "comment": 1, "attachment": 0}, footer.Counts)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(lines, 7)
+
+	assert.JSONEq(`{"type":"header","data":{"format":"exitus-export","version":1,"customer_id":"customer-1","project_id":"project-1","exported_at":"2026-10-19T09:00:00Z"}}`, lines[0])
+	assert.JSONEq(`{"type":"footer","data":{"counts":{"project":1,"label":1,"issue":2,"comment":1,"attachment":0}}}`, lines[6])
+
+	// html isn't escaped so content is exported as it was written
+	assert.Contains(lines[3], `"subject":"<b>fails</b>"`)
+
+	types := []string{}
+	for _, line := range lines {
+		rec := struct {
+			Type string          `json:"type"`
+			Data json.RawMessage `json:"data"`
+		}{}
+		assert.NoError(json.Unmarshal([]byte(line), &rec))
+
+		types = append(types, rec.Type)
+	}
+
+	assert.Equal([]string{"header", "project", "label", "issue", "issue", "comment", "footer"}, types)
+}
+
+func TestRun(t *testing.T) {
+	assert := require.New(t)
+
+	fe := newFakeExports()
+
+	res, err := run(context.Background(), fe, &jobs.Job{ID: "job-1", CustomerID: "customer-1"}, Payload{})
+	assert.NoError(err)
+	assert.Equal(Version, res.Version)
+	assert.Equal(2, res.Counts["issue"])
+	assert.Equal(int64(len(fe.archive)), res.Size)
+	assert.Equal(res.Size, fe.size)
+
+	gz, err := gzip.NewReader(bytes.NewReader(fe.archive))
+	assert.NoError(err)
+
+	data, err := ioutil.ReadAll(gz)
+	assert.NoError(err)
+	assert.Equal(7, strings.Count(string(data), "\n"))
+}
+
+func TestRun_ProjectNotFound(t *testing.T) {
+	assert := require.New(t)
+
+	fe := &fakeExports{err: &store.ProjectNotFoundError{Message: "id project-2"}}
+
+	_, err := run(context.Background(), fe, &jobs.Job{ID: "job-1", CustomerID: "customer-1"}, Payload{ProjectID: "project-2"})
+	assert.Error(err)
+	assert.True(jobs.IsPermanent(err))
+	assert.Nil(fe.archive)
+}
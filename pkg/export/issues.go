@@ -0,0 +1,216 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wolfeidau/exitus/pkg/api"
+)
+
+const (
+	// FormatCSV issues written as a csv file with a header row.
+	FormatCSV = "csv"
+
+	// FormatNDJSON issues written as one JSON object per line.
+	FormatNDJSON = "ndjson"
+
+	// customFieldPrefix prefix of a custom field column, matching the sort and filter fields.
+	customFieldPrefix = "cf."
+)
+
+// DefaultIssueColumns the columns written when none are selected.
+var DefaultIssueColumns = []string{"key", "subject", "state", "severity", "category", "labels", "assignee_id", "created_at", "updated_at"}
+
+// issueColumns the value of each column of an issue, csv cells are the same values as strings.
+var issueColumns = map[string]func(issue *api.Issue) interface{}{
+	"id":           func(issue *api.Issue) interface{} { return issue.Id },
+	"key":          func(issue *api.Issue) interface{} { return issue.Key },
+	"project_id":   func(issue *api.Issue) interface{} { return issue.ProjectId },
+	"parent_id":    func(issue *api.Issue) interface{} { return issue.ParentId },
+	"subject":      func(issue *api.Issue) interface{} { return issue.Subject },
+	"state":        func(issue *api.Issue) interface{} { return issue.State },
+	"severity":     func(issue *api.Issue) interface{} { return issue.Severity },
+	"category":     func(issue *api.Issue) interface{} { return issue.Category },
+	"labels":       func(issue *api.Issue) interface{} { return issue.Labels },
+	"assignee_id":  func(issue *api.Issue) interface{} { return issue.AssigneeId },
+	"votes":        func(issue *api.Issue) interface{} { return issue.Votes },
+	"content":      func(issue *api.Issue) interface{} { return issue.Content },
+	"due_at":       func(issue *api.Issue) interface{} { return issue.DueAt },
+	"milestone_id": func(issue *api.Issue) interface{} { return issue.MilestoneId },
+	"created_at":   func(issue *api.Issue) interface{} { return issue.CreatedAt },
+	"updated_at":   func(issue *api.Issue) interface{} { return issue.UpdatedAt },
+}
+
+// ColumnError occurs when a selected column doesn't exist.
+type ColumnError struct {
+	Message string
+}
+
+func (e *ColumnError) Error() string {
+	return fmt.Sprintf("invalid columns: %s", e.Message)
+}
+
+// ParseIssueColumns parse a comma separated list of columns, an empty list selects the default
+// columns. Custom fields aren't checked as a missing field is written as an empty value.
+func ParseIssueColumns(value string) ([]string, error) {
+	if strings.TrimSpace(value) == "" {
+		return DefaultIssueColumns, nil
+	}
+
+	columns := []string{}
+	for _, column := range strings.Split(value, ",") {
+		column = strings.TrimSpace(column)
+
+		if strings.HasPrefix(column, customFieldPrefix) {
+			if column == customFieldPrefix {
+				return nil, &ColumnError{"custom field column has no name"}
+			}
+		} else if _, ok := issueColumns[column]; !ok {
+			return nil, &ColumnError{fmt.Sprintf("unknown column %q", column)}
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns, nil
+}
+
+// IssueWriter writes issues as rows, the header is written with the first issue or on Flush.
+type IssueWriter interface {
+	Write(issue *api.Issue) error
+	Flush() error
+}
+
+// NewIssueWriter new issue writer for the format.
+func NewIssueWriter(format string, w io.Writer, columns []string) (IssueWriter, error) {
+	switch format {
+	case FormatCSV:
+		return &csvIssueWriter{w: w, columns: columns}, nil
+	case FormatNDJSON:
+		bw := bufio.NewWriter(w)
+		enc := json.NewEncoder(bw)
+		enc.SetEscapeHTML(false)
+		return &ndjsonIssueWriter{bw: bw, enc: enc, columns: columns}, nil
+	}
+
+	return nil, fmt.Errorf("unknown issue format %s", format)
+}
+
+func columnValue(issue *api.Issue, column string) interface{} {
+	if strings.HasPrefix(column, customFieldPrefix) {
+		value, ok := issue.CustomFields.Get(strings.TrimPrefix(column, customFieldPrefix))
+		if !ok {
+			return nil
+		}
+		return value
+	}
+
+	return issueColumns[column](issue)
+}
+
+// csvIssueWriter writes issues in a form spreadsheets open as they are, with a byte order mark
+// so the file is read as UTF-8, CRLF line endings and formulas escaped.
+type csvIssueWriter struct {
+	w       io.Writer
+	cw      *csv.Writer
+	columns []string
+}
+
+func (cw *csvIssueWriter) start() error {
+	if cw.cw != nil {
+		return nil
+	}
+
+	if _, err := io.WriteString(cw.w, "\ufeff"); err != nil {
+		return err
+	}
+
+	cw.cw = csv.NewWriter(cw.w)
+	cw.cw.UseCRLF = true
+
+	return cw.cw.Write(cw.columns)
+}
+
+func (cw *csvIssueWriter) Write(issue *api.Issue) error {
+	if err := cw.start(); err != nil {
+		return err
+	}
+
+	row := make([]string, len(cw.columns))
+	for i, column := range cw.columns {
+		row[i] = csvCell(columnValue(issue, column))
+	}
+
+	return cw.cw.Write(row)
+}
+
+func (cw *csvIssueWriter) Flush() error {
+	if err := cw.start(); err != nil {
+		return err
+	}
+
+	cw.cw.Flush()
+
+	return cw.cw.Error()
+}
+
+func csvCell(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return escapeFormula(v)
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return escapeFormula(*v)
+	case []string:
+		return escapeFormula(strings.Join(v, ", "))
+	case int:
+		return strconv.Itoa(v)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	}
+
+	return ""
+}
+
+// escapeFormula prefix values a spreadsheet would run as a formula with a quote, so a subject
+// such as =HYPERLINK(...) is shown as text.
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsAny(value[:1], "=+-@\t\r") {
+		return "'" + value
+	}
+
+	return value
+}
+
+// ndjsonIssueWriter writes each issue as a JSON object of the selected columns.
+type ndjsonIssueWriter struct {
+	bw      *bufio.Writer
+	enc     *json.Encoder
+	columns []string
+}
+
+func (nw *ndjsonIssueWriter) Write(issue *api.Issue) error {
+	row := make(map[string]interface{}, len(nw.columns))
+	for _, column := range nw.columns {
+		row[column] = columnValue(issue, column)
+	}
+
+	return nw.enc.Encode(row)
+}
+
+func (nw *ndjsonIssueWriter) Flush() error {
+	return nw.bw.Flush()
+}
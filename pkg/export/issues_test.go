@@ -0,0 +1,96 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+)
+
+func testIssues() []*api.Issue {
+	key, assignee := "API-1", "3fbecb27-1f23-4ed0-91e4-68f97a1f0364"
+	created := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	first := &api.Issue{Id: "issue-1", Key: &key, Subject: "=HYPERLINK(\"http://x\")", State: "open", Labels: []string{"bug", "ui"}, AssigneeId: &assignee, Votes: 3, CreatedAt: created}
+	first.CustomFields.Set("customer", "acme, inc")
+
+	second := &api.Issue{Id: "issue-2", Subject: "works", State: "closed", Labels: []string{}, CreatedAt: created}
+
+	return []*api.Issue{first, second}
+}
+
+func TestParseIssueColumns(t *testing.T) {
+	assert := require.New(t)
+
+	columns, err := ParseIssueColumns("")
+	assert.NoError(err)
+	assert.Equal(DefaultIssueColumns, columns)
+
+	columns, err = ParseIssueColumns("key, subject,cf.customer")
+	assert.NoError(err)
+	assert.Equal([]string{"key", "subject", "cf.customer"}, columns)
+
+	_, err = ParseIssueColumns("key,reporter_password")
+	assert.IsType(&ColumnError{}, err)
+
+	_, err = ParseIssueColumns("cf.")
+	assert.IsType(&ColumnError{}, err)
+}
+
+func TestIssueWriter_CSV(t *testing.T) {
+	assert := require.New(t)
+
+	buf := &bytes.Buffer{}
+	w, err := NewIssueWriter(FormatCSV, buf, []string{"key", "subject", "labels", "assignee_id", "votes", "created_at", "cf.customer"})
+	assert.NoError(err)
+
+	for _, issue := range testIssues() {
+		assert.NoError(w.Write(issue))
+	}
+	assert.NoError(w.Flush())
+
+	assert.Equal("\ufeff"+
+		"key,subject,labels,assignee_id,votes,created_at,cf.customer\r\n"+
+		"API-1,\"'=HYPERLINK(\"\"http://x\"\")\",\"bug, ui\",3fbecb27-1f23-4ed0-91e4-68f97a1f0364,3,2026-10-19T09:00:00Z,\"acme, inc\"\r\n"+
+		",works,,,0,2026-10-19T09:00:00Z,\r\n", buf.String())
+}
+
+func TestIssueWriter_CSVEmpty(t *testing.T) {
+	assert := require.New(t)
+
+	buf := &bytes.Buffer{}
+	w, err := NewIssueWriter(FormatCSV, buf, []string{"key", "subject"})
+	assert.NoError(err)
+	assert.Equal(0, buf.Len())
+
+	assert.NoError(w.Flush())
+	assert.Equal("\ufeffkey,subject\r\n", buf.String())
+}
+
+func TestIssueWriter_NDJSON(t *testing.T) {
+	assert := require.New(t)
+
+	buf := &bytes.Buffer{}
+	w, err := NewIssueWriter(FormatNDJSON, buf, []string{"key", "labels", "votes", "cf.customer"})
+	assert.NoError(err)
+
+	for _, issue := range testIssues() {
+		assert.NoError(w.Write(issue))
+	}
+	assert.NoError(w.Flush())
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	assert.Len(lines, 2)
+
+	row := map[string]interface{}{}
+	assert.NoError(json.Unmarshal([]byte(lines[0]), &row))
+	assert.Equal(map[string]interface{}{"key": "API-1", "labels": []interface{}{"bug", "ui"}, "votes": float64(3), "cf.customer": "acme, inc"}, row)
+
+	row = map[string]interface{}{}
+	assert.NoError(json.Unmarshal([]byte(lines[1]), &row))
+	assert.Equal(map[string]interface{}{"key": nil, "labels": []interface{}{}, "votes": float64(0), "cf.customer": nil}, row)
+}
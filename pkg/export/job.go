@@ -0,0 +1,76 @@
+package export
+
+import (
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/wolfeidau/exitus/pkg/jobs"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// Payload the payload of an export job, all the projects of the customer are exported unless a
+// project id is given.
+type Payload struct {
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// Result the result of an export job.
+type Result struct {
+	Version int            `json:"version"`
+	Size    int64          `json:"size"`
+	Counts  map[string]int `json:"counts"`
+}
+
+// Job exports a customer to a gzipped archive which is downloaded using the id of the job, an
+// export which fails is started again from the beginning.
+var Job = jobs.NewType[Payload]("export", 3)
+
+// Register the export job with the runner.
+func Register(r *jobs.Runner, exports store.Exports) {
+	Job.Handle(r, func(ctx context.Context, job *jobs.Job, payload Payload) (interface{}, error) {
+		return run(ctx, exports, job, payload)
+	})
+}
+
+func run(ctx context.Context, exports store.Exports, job *jobs.Job, payload Payload) (*Result, error) {
+	// the archive is spooled to disk as its size must be known before it is stored
+	tmp, err := os.CreateTemp("", "exitus-export-*.ndjson.gz")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create export archive: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	gz := gzip.NewWriter(tmp)
+
+	footer, err := Write(ctx, gz, exports, &store.ExportOptions{CustomerID: job.CustomerID, ProjectID: payload.ProjectID}, time.Now())
+	if err != nil {
+		if _, ok := err.(*store.ProjectNotFoundError); ok {
+			return nil, jobs.Permanent(err)
+		}
+		return nil, err
+	}
+
+	if err := gz.Close(); err != nil {
+		return nil, fmt.Errorf("failed to write export archive: %w", err)
+	}
+
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	if err := exports.SaveArchive(ctx, job.ID, job.CustomerID, tmp, size); err != nil {
+		return nil, err
+	}
+
+	return &Result{Version: Version, Size: size, Counts: footer.Counts}, nil
+}
@@ -0,0 +1,225 @@
+package importer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/wolfeidau/exitus/pkg/api"
+)
+
+// csvTable a csv file with a header row, columns are found by header ignoring case and repeated
+// headers are allowed as Jira repeats them for multi value fields.
+type csvTable struct {
+	r      *csv.Reader
+	header map[string][]int
+	line   int
+}
+
+func newCSVTable(r io.Reader) (*csvTable, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	header, err := cr.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, &ValidationError{"csv file is empty"}
+		}
+		return nil, &ValidationError{fmt.Sprintf("failed to read csv header: %s", err)}
+	}
+
+	t := &csvTable{r: cr, header: map[string][]int{}, line: 1}
+	for i, name := range header {
+		// excel adds a byte order mark to the start of the file
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		t.header[name] = append(t.header[name], i)
+	}
+
+	return t, nil
+}
+
+// column the index of a column, a column which isn't mapped is -1.
+func (t *csvTable) column(name *string) (int, error) {
+	if name == nil || *name == "" {
+		return -1, nil
+	}
+
+	idx, ok := t.header[strings.ToLower(strings.TrimSpace(*name))]
+	if !ok {
+		return -1, &ValidationError{fmt.Sprintf("csv has no column %s", *name)}
+	}
+
+	return idx[0], nil
+}
+
+// next read the next row, this returns io.EOF at the end of the file.
+func (t *csvTable) next() ([]string, error) {
+	row, err := t.r.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, &ValidationError{fmt.Sprintf("failed to read csv: %s", err)}
+	}
+
+	t.line++
+
+	return row, nil
+}
+
+func cell(row []string, idx int) string {
+	if idx < 0 || idx >= len(row) {
+		return ""
+	}
+
+	return strings.TrimSpace(row[idx])
+}
+
+func splitLabels(value string) []string {
+	labels := []string{}
+	for _, label := range strings.Split(value, ",") {
+		if label = strings.TrimSpace(label); label != "" {
+			labels = append(labels, label)
+		}
+	}
+
+	return labels
+}
+
+// ParseCSV read issues from a csv file using the columns to find each field, issues are
+// identified by the external id column or by their row number.
+func ParseCSV(r io.Reader, columns *api.ImportColumns) ([]Issue, error) {
+	t, err := newCSVTable(r)
+	if err != nil {
+		return nil, err
+	}
+
+	subject := columns.Subject
+	idx := map[string]int{}
+	for name, column := range map[string]*string{
+		"external_id": columns.ExternalId,
+		"subject":     &subject,
+		"content":     columns.Content,
+		"state":       columns.State,
+		"severity":    columns.Severity,
+		"category":    columns.Category,
+		"labels":      columns.Labels,
+		"reporter":    columns.Reporter,
+		"assignee":    columns.Assignee,
+	} {
+		if idx[name], err = t.column(column); err != nil {
+			return nil, err
+		}
+	}
+
+	if idx["subject"] < 0 {
+		return nil, &ValidationError{"the subject column is required"}
+	}
+
+	issues := []Issue{}
+	for {
+		row, err := t.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		issue := Issue{
+			ExternalID: cell(row, idx["external_id"]),
+			Subject:    cell(row, idx["subject"]),
+			Content:    cell(row, idx["content"]),
+			State:      cell(row, idx["state"]),
+			Severity:   cell(row, idx["severity"]),
+			Category:   cell(row, idx["category"]),
+			Labels:     splitLabels(cell(row, idx["labels"])),
+			Reporter:   cell(row, idx["reporter"]),
+			Assignee:   cell(row, idx["assignee"]),
+		}
+
+		if idx["external_id"] < 0 {
+			issue.ExternalID = strconv.Itoa(len(issues) + 1)
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+// ParseJiraCSV read issues from a Jira csv export, each comment column holds the time, author and
+// body of a comment separated by semicolons.
+func ParseJiraCSV(r io.Reader) ([]Issue, error) {
+	t, err := newCSVTable(r)
+	if err != nil {
+		return nil, err
+	}
+
+	col := func(name string) int {
+		if idx, ok := t.header[name]; ok {
+			return idx[0]
+		}
+		return -1
+	}
+
+	key, summary := col("issue key"), col("summary")
+	if key < 0 || summary < 0 {
+		return nil, &ValidationError{"jira csv must have issue key and summary columns"}
+	}
+
+	issues := []Issue{}
+	for {
+		row, err := t.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		issue := Issue{
+			ExternalID: cell(row, key),
+			Subject:    cell(row, summary),
+			Content:    cell(row, col("description")),
+			State:      cell(row, col("status")),
+			Severity:   cell(row, col("priority")),
+			Category:   cell(row, col("issue type")),
+			Labels:     []string{},
+			Reporter:   cell(row, col("reporter")),
+			Assignee:   cell(row, col("assignee")),
+		}
+
+		if issue.ExternalID == "" {
+			return nil, &ValidationError{fmt.Sprintf("jira csv line %d has no issue key", t.line)}
+		}
+
+		for _, i := range t.header["labels"] {
+			if label := cell(row, i); label != "" {
+				issue.Labels = append(issue.Labels, label)
+			}
+		}
+
+		// comments have no id in the csv so they are identified by their position
+		for _, i := range t.header["comment"] {
+			value := cell(row, i)
+			if value == "" {
+				continue
+			}
+
+			comment := Comment{ExternalID: fmt.Sprintf("%s#%d", issue.ExternalID, len(issue.Comments)+1), Content: value}
+			if parts := strings.SplitN(value, ";", 3); len(parts) == 3 {
+				comment.Author, comment.Content = strings.TrimSpace(parts[1]), strings.TrimSpace(parts[2])
+			}
+
+			issue.Comments = append(issue.Comments, comment)
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
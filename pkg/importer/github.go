@@ -0,0 +1,114 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+type githubComment struct {
+	ID      int64       `json:"id"`
+	HTMLURL string      `json:"html_url"`
+	User    *githubUser `json:"user"`
+	Body    string      `json:"body"`
+}
+
+type githubIssue struct {
+	Number      int             `json:"number"`
+	HTMLURL     string          `json:"html_url"`
+	Title       string          `json:"title"`
+	Body        *string         `json:"body"`
+	State       string          `json:"state"`
+	User        *githubUser     `json:"user"`
+	Assignee    *githubUser     `json:"assignee"`
+	Labels      []githubLabel   `json:"labels"`
+	PullRequest json.RawMessage `json:"pull_request"`
+	Comments    json.RawMessage `json:"comments"`
+}
+
+type githubLabel struct {
+	Name string `json:"name"`
+}
+
+func (u *githubUser) login() string {
+	if u == nil {
+		return ""
+	}
+
+	return u.Login
+}
+
+// ParseGitHub read issues from a JSON array of issues as returned by the GitHub issues API. The
+// API only returns a count of comments, an array of comments from the issue comments API in its
+// place is imported with the issue. Pull requests are skipped.
+func ParseGitHub(r io.Reader) ([]Issue, error) {
+	dec := json.NewDecoder(r)
+
+	// the issues are decoded one at a time rather than reading the whole array
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return nil, &ValidationError{"github issues must be a JSON array"}
+	}
+
+	issues := []Issue{}
+	for dec.More() {
+		gi := githubIssue{}
+		if err := dec.Decode(&gi); err != nil {
+			return nil, &ValidationError{fmt.Sprintf("failed to read github issue: %s", err)}
+		}
+
+		if len(gi.PullRequest) > 0 && !bytes.Equal(gi.PullRequest, []byte("null")) {
+			continue
+		}
+
+		issue := Issue{
+			ExternalID: gi.HTMLURL,
+			Subject:    gi.Title,
+			State:      gi.State,
+			Labels:     []string{},
+			Reporter:   gi.User.login(),
+			Assignee:   gi.Assignee.login(),
+		}
+
+		if issue.ExternalID == "" {
+			issue.ExternalID = strconv.Itoa(gi.Number)
+		}
+
+		if gi.Body != nil {
+			issue.Content = *gi.Body
+		}
+
+		for _, label := range gi.Labels {
+			issue.Labels = append(issue.Labels, label.Name)
+		}
+
+		if bytes.HasPrefix(bytes.TrimSpace(gi.Comments), []byte("[")) {
+			comments := []githubComment{}
+			if err := json.Unmarshal(gi.Comments, &comments); err != nil {
+				return nil, &ValidationError{fmt.Sprintf("failed to read comments of github issue %d: %s", gi.Number, err)}
+			}
+
+			for _, gc := range comments {
+				comment := Comment{ExternalID: gc.HTMLURL, Author: gc.User.login(), Content: gc.Body}
+				if comment.ExternalID == "" {
+					comment.ExternalID = strconv.FormatInt(gc.ID, 10)
+				}
+
+				issue.Comments = append(issue.Comments, comment)
+			}
+		}
+
+		issues = append(issues, issue)
+	}
+
+	if _, err := dec.Token(); err != nil {
+		return nil, &ValidationError{fmt.Sprintf("failed to read github issues: %s", err)}
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,529 @@
+// Package importer imports issues and comments from CSV files and from GitHub and Jira exports,
+// the records are created through the issue and comment stores so they are validated, notified
+// and revisioned like any other issue.
+package importer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// usersPageSize the number of users read at a time when matching users.
+const usersPageSize = 500
+
+// defaultStates maps the states used by GitHub and the default Jira workflows to exitus states,
+// states are matched ignoring case.
+var defaultStates = map[string]string{
+	"created":     store.StateCreated,
+	"new":         store.StateOpen,
+	"open":        store.StateOpen,
+	"opened":      store.StateOpen,
+	"reopened":    store.StateOpen,
+	"to do":       store.StateOpen,
+	"todo":        store.StateOpen,
+	"backlog":     store.StateOpen,
+	"in progress": store.StateInProgress,
+	"in_progress": store.StateInProgress,
+	"in review":   store.StateInProgress,
+	"resolved":    store.StateResolved,
+	"done":        store.StateClosed,
+	"closed":      store.StateClosed,
+}
+
+// Importer imports issues into a project.
+type Importer struct {
+	stores *store.Stores
+}
+
+// New new importer.
+func New(stores *store.Stores) *Importer {
+	return &Importer{stores: stores}
+}
+
+// run the state of one import.
+type run struct {
+	opt        *api.ImportOptions
+	source     string
+	customerId string
+	actor      string
+	projectId  string
+	taxonomy   *api.Taxonomy
+	users      map[string]string
+	unmapped   map[string]bool
+	report     *api.ImportReport
+}
+
+// Import read the issues from the file and create the ones which weren't imported before, a dry
+// run reports what would be created. Invalid issues are reported rather than failing the import,
+// issues and comments by users who aren't matched are created by the actor.
+func (im *Importer) Import(ctx context.Context, opt *api.ImportOptions, r io.Reader, customerId, actor string) (*api.ImportReport, error) {
+	issues, err := Parse(opt.Format, r, opt.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	ir := &run{
+		opt:        opt,
+		source:     source(opt.Format),
+		customerId: customerId,
+		actor:      actor,
+		unmapped:   map[string]bool{},
+		report: &api.ImportReport{
+			DryRun:        opt.DryRun != nil && *opt.DryRun,
+			NewLabels:     []string{},
+			UnmappedUsers: []string{},
+			Issues:        []api.ImportIssueResult{},
+		},
+	}
+
+	if err := im.loadUsers(ctx, ir); err != nil {
+		return nil, err
+	}
+
+	project, err := im.resolveProject(ctx, ir)
+	if err != nil {
+		return nil, err
+	}
+
+	if project != nil {
+		ir.projectId = project.Id
+		ir.report.ProjectId = project.Id
+		ir.taxonomy, err = im.stores.Taxonomies.GetByProjectID(ctx, project.Id, customerId)
+	} else {
+		ir.taxonomy, err = im.stores.Taxonomies.GetByCustomerID(ctx, customerId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := im.addLabels(ctx, ir, project, issues); err != nil {
+		return nil, err
+	}
+
+	existingIssues, existingComments, err := im.lookupExisting(ctx, ir, issues)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, issue := range issues {
+		res := api.ImportIssueResult{ExternalId: issue.ExternalID}
+
+		switch {
+		case issue.ExternalID == "":
+			res.Status, res.Error = api.ImportIssueResultStatusInvalid, strPtr("the issue has no external id")
+		case seen[issue.ExternalID]:
+			res.Status, res.Error = api.ImportIssueResultStatusInvalid, strPtr("the external id is repeated in the file")
+		default:
+			if err := im.importIssue(ctx, ir, &issue, existingIssues, existingComments, &res); err != nil {
+				return nil, err
+			}
+		}
+
+		seen[issue.ExternalID] = true
+
+		switch res.Status {
+		case api.ImportIssueResultStatusCreated:
+			ir.report.Created++
+		case api.ImportIssueResultStatusExists:
+			ir.report.Existing++
+		case api.ImportIssueResultStatusInvalid:
+			ir.report.Invalid++
+		}
+		ir.report.CommentsCreated += res.Comments
+
+		ir.report.Issues = append(ir.report.Issues, res)
+	}
+
+	for user := range ir.unmapped {
+		ir.report.UnmappedUsers = append(ir.report.UnmappedUsers, user)
+	}
+	sort.Strings(ir.report.UnmappedUsers)
+
+	return ir.report, nil
+}
+
+// loadUsers index the users of the customer by login and email, mapped users take precedence.
+func (im *Importer) loadUsers(ctx context.Context, ir *run) error {
+	ir.users = map[string]string{}
+
+	for offset := 0; ; offset += usersPageSize {
+		users, err := im.stores.Users.List(ctx, store.NewUsersListOptions("", offset, usersPageSize), ir.customerId)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			ir.users[strings.ToLower(user.Login)] = user.Id
+			if user.Email != "" {
+				ir.users[strings.ToLower(user.Email)] = user.Id
+			}
+		}
+
+		if len(users) < usersPageSize {
+			break
+		}
+	}
+
+	if ir.opt.Users != nil {
+		for name, userId := range ir.opt.Users.AdditionalProperties {
+			if _, err := uuid.FromString(userId); err != nil {
+				return &ValidationError{fmt.Sprintf("user %s is mapped to %s which is not a valid user identifier", name, userId)}
+			}
+			ir.users[strings.ToLower(name)] = userId
+		}
+	}
+
+	return nil
+}
+
+// resolveProject find the project to import into, a project which is named but doesn't exist is
+// created unless this is a dry run.
+func (im *Importer) resolveProject(ctx context.Context, ir *run) (*api.Project, error) {
+	if ir.opt.ProjectId != nil && *ir.opt.ProjectId != "" {
+		return im.stores.Projects.GetByID(ctx, *ir.opt.ProjectId, ir.customerId)
+	}
+
+	if ir.opt.ProjectName == nil || strings.TrimSpace(*ir.opt.ProjectName) == "" {
+		return nil, &ValidationError{"project_id or project_name is required"}
+	}
+
+	name := strings.TrimSpace(*ir.opt.ProjectName)
+
+	projects, err := im.stores.Projects.List(ctx, store.NewProjectsListOptions(name, 0, 100), ir.customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range projects {
+		if strings.EqualFold(project.Name, name) {
+			return &project, nil
+		}
+	}
+
+	ir.report.ProjectCreated = true
+
+	if ir.report.DryRun {
+		return nil, nil
+	}
+
+	return im.stores.Projects.Create(ctx, &api.NewProject{Name: name, Labels: []string{}}, ir.customerId)
+}
+
+// addLabels add the labels used by the issues which the project doesn't have yet.
+func (im *Importer) addLabels(ctx context.Context, ir *run, project *api.Project, issues []Issue) error {
+	labels := []string{}
+	if project != nil {
+		labels = append(labels, project.Labels...)
+	}
+
+	known := map[string]bool{}
+	for _, label := range labels {
+		known[label] = true
+	}
+
+	for _, issue := range issues {
+		for _, label := range ir.mapLabels(issue.Labels) {
+			if !known[label] {
+				known[label] = true
+				ir.report.NewLabels = append(ir.report.NewLabels, label)
+			}
+		}
+	}
+
+	if ir.report.DryRun || len(ir.report.NewLabels) == 0 {
+		return nil
+	}
+
+	_, err := im.stores.Projects.Update(ctx, &api.UpdatedProject{NewProject: api.NewProject{
+		Name:        project.Name,
+		Description: project.Description,
+		Labels:      append(labels, ir.report.NewLabels...),
+	}}, project.Id, ir.customerId)
+
+	return err
+}
+
+// lookupExisting the issues and comments imported into the project before.
+func (im *Importer) lookupExisting(ctx context.Context, ir *run, issues []Issue) (map[string]string, map[string]string, error) {
+	if ir.projectId == "" {
+		return map[string]string{}, map[string]string{}, nil
+	}
+
+	issueIds, commentIds := []string{}, []string{}
+	for _, issue := range issues {
+		issueIds = append(issueIds, issue.ExternalID)
+		for _, comment := range issue.Comments {
+			commentIds = append(commentIds, comment.ExternalID)
+		}
+	}
+
+	existingIssues, err := im.stores.ExternalIDs.Lookup(ctx, ir.projectId, ir.source, store.ExternalKindIssue, issueIds, ir.customerId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existingComments, err := im.stores.ExternalIDs.Lookup(ctx, ir.projectId, ir.source, store.ExternalKindComment, commentIds, ir.customerId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return existingIssues, existingComments, nil
+}
+
+// importIssue create an issue and its comments, comments added to an issue imported before are
+// created on the existing issue.
+func (im *Importer) importIssue(ctx context.Context, ir *run, issue *Issue, existingIssues, existingComments map[string]string, res *api.ImportIssueResult) error {
+	if issueId, ok := existingIssues[issue.ExternalID]; ok {
+		res.Status = api.ImportIssueResultStatusExists
+		res.IssueId = &issueId
+
+		return im.importComments(ctx, ir, issueId, issue.Comments, existingComments, res)
+	}
+
+	newIssue, state, err := ir.newIssue(issue, res)
+	if err != nil {
+		res.Status, res.Error = api.ImportIssueResultStatusInvalid, strPtr(err.Error())
+		return nil
+	}
+
+	res.Status = api.ImportIssueResultStatusCreated
+
+	if ir.report.DryRun {
+		res.Comments = len(issue.Comments)
+		return nil
+	}
+
+	ext := &store.ExternalID{ProjectID: ir.projectId, Source: ir.source, Kind: store.ExternalKindIssue, ExternalID: issue.ExternalID}
+
+	created, err := im.stores.ExternalIDs.CreateIssue(ctx, ext, newIssue, ir.customerId, ir.user(issue.Reporter))
+	if err != nil {
+		// another import created the issue after it was looked up
+		if err == store.ErrExternalIDAlreadyImported {
+			res.Status = api.ImportIssueResultStatusExists
+			return nil
+		}
+		if _, ok := err.(*store.IssueValidationError); ok {
+			res.Status, res.Error = api.ImportIssueResultStatusInvalid, strPtr(err.Error())
+			return nil
+		}
+		return err
+	}
+
+	res.IssueId, res.Key = &created.Id, created.Key
+
+	if state != store.StateCreated {
+		_, err := im.stores.Issues.Transition(ctx, &api.IssueTransition{State: api.IssueTransitionState(state)}, created.Id, ir.projectId, ir.customerId)
+		if err != nil {
+			res.Warnings = appendWarning(res.Warnings, fmt.Sprintf("failed to move the issue to %s: %s", state, err))
+		}
+	}
+
+	return im.importComments(ctx, ir, created.Id, issue.Comments, existingComments, res)
+}
+
+func (im *Importer) importComments(ctx context.Context, ir *run, issueId string, comments []Comment, existingComments map[string]string, res *api.ImportIssueResult) error {
+	for _, comment := range comments {
+		if _, ok := existingComments[comment.ExternalID]; ok {
+			continue
+		}
+
+		content := ir.attribute(comment.Author, "Comment", comment.Content)
+		if strings.TrimSpace(comment.Content) == "" {
+			res.Warnings = appendWarning(res.Warnings, fmt.Sprintf("comment %s is empty", comment.ExternalID))
+			continue
+		}
+
+		if ir.report.DryRun {
+			res.Comments++
+			continue
+		}
+
+		ext := &store.ExternalID{ProjectID: ir.projectId, Source: ir.source, Kind: store.ExternalKindComment, ExternalID: comment.ExternalID}
+
+		_, err := im.stores.ExternalIDs.CreateComment(ctx, ext, &api.NewComment{Content: content}, issueId, ir.customerId, ir.user(comment.Author))
+		if err != nil {
+			if err == store.ErrExternalIDAlreadyImported {
+				continue
+			}
+			return err
+		}
+
+		res.Comments++
+	}
+
+	return nil
+}
+
+// newIssue map an issue read from the source to a new issue and the state it is moved to.
+func (ir *run) newIssue(issue *Issue, res *api.ImportIssueResult) (*api.NewIssue, string, error) {
+	subject := strings.TrimSpace(issue.Subject)
+	if subject == "" {
+		return nil, "", &ValidationError{"the issue has no subject"}
+	}
+
+	severities := []string{}
+	for _, severity := range ir.taxonomy.Severities {
+		severities = append(severities, severity.Name)
+	}
+
+	severity, err := ir.mapValue("severity", issue.Severity, ir.opt.Severities, ir.opt.DefaultSeverity, severities, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	category, err := ir.mapValue("category", issue.Category, ir.opt.Categories, ir.opt.DefaultCategory, ir.taxonomy.Categories, res)
+	if err != nil {
+		return nil, "", err
+	}
+
+	newIssue := &api.NewIssue{
+		Subject:  subject,
+		Content:  ir.attribute(issue.Reporter, "Reported", issue.Content),
+		Severity: severity,
+		Category: category,
+		Labels:   ir.mapLabels(issue.Labels),
+	}
+
+	if issue.Assignee != "" {
+		if assigneeId, ok := ir.users[strings.ToLower(issue.Assignee)]; ok {
+			newIssue.AssigneeId = &assigneeId
+		} else {
+			ir.unmapped[issue.Assignee] = true
+			res.Warnings = appendWarning(res.Warnings, fmt.Sprintf("assignee %s is not a user", issue.Assignee))
+		}
+	}
+
+	return newIssue, ir.mapState(issue.State, res), nil
+}
+
+// mapValue map a severity or category, a value which isn't allowed by the taxonomy is replaced by
+// the default when there is one.
+func (ir *run) mapValue(kind, value string, mapping *api.ImportMapping, defaultValue *string, allowed []string, res *api.ImportIssueResult) (string, error) {
+	if mapping != nil {
+		if mapped, ok := mapping.AdditionalProperties[value]; ok {
+			value = mapped
+		}
+	}
+
+	if len(allowed) == 0 || containsString(allowed, value) {
+		if value == "" && defaultValue != nil {
+			return *defaultValue, nil
+		}
+		return value, nil
+	}
+
+	if defaultValue != nil && containsString(allowed, *defaultValue) {
+		if value != "" {
+			res.Warnings = appendWarning(res.Warnings, fmt.Sprintf("%s %s is not allowed, using %s", kind, value, *defaultValue))
+		}
+		return *defaultValue, nil
+	}
+
+	if value == "" {
+		return "", &ValidationError{fmt.Sprintf("the issue has no %s", kind)}
+	}
+
+	return "", &ValidationError{fmt.Sprintf("%s %s is not allowed", kind, value)}
+}
+
+// mapState map the state of an issue, states which aren't known are imported as open.
+func (ir *run) mapState(state string, res *api.ImportIssueResult) string {
+	if ir.opt.States != nil {
+		if mapped, ok := ir.opt.States.AdditionalProperties[state]; ok {
+			state = mapped
+		}
+	}
+
+	if state == "" {
+		return store.StateOpen
+	}
+
+	if mapped, ok := defaultStates[strings.ToLower(state)]; ok {
+		return mapped
+	}
+
+	res.Warnings = appendWarning(res.Warnings, fmt.Sprintf("state %s is not known, using %s", state, store.StateOpen))
+
+	return store.StateOpen
+}
+
+// mapLabels map labels, labels mapped to an empty string are dropped.
+func (ir *run) mapLabels(labels []string) []string {
+	mapped := []string{}
+	for _, label := range labels {
+		if ir.opt.Labels != nil {
+			if to, ok := ir.opt.Labels.AdditionalProperties[label]; ok {
+				label = to
+			}
+		}
+
+		if label != "" && !containsString(mapped, label) {
+			mapped = append(mapped, label)
+		}
+	}
+
+	return mapped
+}
+
+// user the id of a user in the source, users who don't match are replaced by the actor.
+func (ir *run) user(name string) string {
+	if userId, ok := ir.users[strings.ToLower(name)]; ok {
+		return userId
+	}
+
+	if name != "" {
+		ir.unmapped[name] = true
+	}
+
+	return ir.actor
+}
+
+// attribute credit content to its author when they don't match a user, as it is created by the
+// actor instead.
+func (ir *run) attribute(name, verb, content string) string {
+	if name == "" {
+		return content
+	}
+
+	if _, ok := ir.users[strings.ToLower(name)]; ok {
+		return content
+	}
+
+	if content == "" {
+		return fmt.Sprintf("_%s by %s_", verb, name)
+	}
+
+	return fmt.Sprintf("_%s by %s_\n\n%s", verb, name, content)
+}
+
+func appendWarning(warnings *[]string, warning string) *[]string {
+	if warnings == nil {
+		warnings = &[]string{}
+	}
+
+	res := append(*warnings, warning)
+
+	return &res
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func strPtr(s string) *string {
+	return &s
+}
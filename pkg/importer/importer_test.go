@@ -0,0 +1,208 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+const (
+	testCustomerId = "3b5d27e3-3524-4c34-a189-2c0cc30765f9"
+	testActor      = "34a20135-1c9b-4c4d-b590-7771207ed847"
+	testUser       = "3fbecb27-1f23-4ed0-91e4-68f97a1f0364"
+)
+
+func TestParseCSV(t *testing.T) {
+	assert := require.New(t)
+
+	data := "\ufeffKey,Title,Body,Tags\nOPS-1,Disk full,\"the disk, is full\",\"ops, disk\"\nOPS-2,Restart,,\n"
+
+	columns := &api.ImportColumns{Subject: "title", ExternalId: strPtr("Key"), Content: strPtr("Body"), Labels: strPtr("tags")}
+
+	issues, err := ParseCSV(strings.NewReader(data), columns)
+	assert.NoError(err)
+	assert.Len(issues, 2)
+	assert.Equal("OPS-1", issues[0].ExternalID)
+	assert.Equal("Disk full", issues[0].Subject)
+	assert.Equal("the disk, is full", issues[0].Content)
+	assert.Equal([]string{"ops", "disk"}, issues[0].Labels)
+	assert.Equal([]string{}, issues[1].Labels)
+
+	// without an external id column issues are identified by row
+	issues, err = ParseCSV(strings.NewReader(data), &api.ImportColumns{Subject: "Title"})
+	assert.NoError(err)
+	assert.Equal("1", issues[0].ExternalID)
+	assert.Equal("2", issues[1].ExternalID)
+
+	_, err = ParseCSV(strings.NewReader(data), &api.ImportColumns{Subject: "summary"})
+	assert.IsType(&ValidationError{}, err)
+
+	_, err = Parse(api.ImportOptionsFormatCsv, strings.NewReader(data), nil)
+	assert.IsType(&ValidationError{}, err)
+}
+
+func TestParseJiraCSV(t *testing.T) {
+	assert := require.New(t)
+
+	data := "Summary,Issue key,Status,Priority,Issue Type,Reporter,Assignee,Labels,Labels,Comment,Comment\n" +
+		"Login fails,WEB-7,In Progress,High,Bug,jane,bob,auth,web,\"01/Oct/26 9:00 AM;bob;looking, now\",\n"
+
+	issues, err := ParseJiraCSV(strings.NewReader(data))
+	assert.NoError(err)
+	assert.Len(issues, 1)
+
+	issue := issues[0]
+	assert.Equal("WEB-7", issue.ExternalID)
+	assert.Equal("In Progress", issue.State)
+	assert.Equal("High", issue.Severity)
+	assert.Equal("Bug", issue.Category)
+	assert.Equal("jane", issue.Reporter)
+	assert.Equal([]string{"auth", "web"}, issue.Labels)
+	assert.Equal([]Comment{{ExternalID: "WEB-7#1", Author: "bob", Content: "looking, now"}}, issue.Comments)
+}
+
+func TestParseGitHub(t *testing.T) {
+	assert := require.New(t)
+
+	data := `[
+		{"number": 1, "html_url": "https://github.com/o/r/issues/1", "title": "Crash", "body": "it crashes", "state": "closed",
+		 "user": {"login": "jane"}, "assignee": null, "labels": [{"name": "bug"}],
+		 "comments": [{"id": 11, "html_url": "https://github.com/o/r/issues/1#issuecomment-11", "user": {"login": "bob"}, "body": "fixed"}]},
+		{"number": 2, "html_url": "https://github.com/o/r/pull/2", "title": "Fix", "state": "open", "pull_request": {"url": "x"}, "comments": 0},
+		{"number": 3, "title": "Slow", "body": null, "state": "open", "comments": 4}
+	]`
+
+	issues, err := ParseGitHub(strings.NewReader(data))
+	assert.NoError(err)
+	assert.Len(issues, 2)
+
+	assert.Equal("https://github.com/o/r/issues/1", issues[0].ExternalID)
+	assert.Equal("closed", issues[0].State)
+	assert.Equal("jane", issues[0].Reporter)
+	assert.Equal([]string{"bug"}, issues[0].Labels)
+	assert.Equal([]Comment{{ExternalID: "https://github.com/o/r/issues/1#issuecomment-11", Author: "bob", Content: "fixed"}}, issues[0].Comments)
+
+	assert.Equal("3", issues[1].ExternalID)
+	assert.Empty(issues[1].Comments)
+
+	_, err = ParseGitHub(strings.NewReader(`{"number": 1}`))
+	assert.IsType(&ValidationError{}, err)
+}
+
+func TestParseJiraXML(t *testing.T) {
+	assert := require.New(t)
+
+	data := `<rss version="0.92"><channel><title>Jira</title>
+		<item>
+			<title>[WEB-7] Login fails</title>
+			<key id="10007">WEB-7</key>
+			<summary>Login fails</summary>
+			<description>&lt;p&gt;cannot log in&lt;/p&gt;</description>
+			<type id="1">Bug</type>
+			<priority id="2">High</priority>
+			<status id="3">Done</status>
+			<assignee username="-1">Unassigned</assignee>
+			<reporter username="jane">Jane Doe</reporter>
+			<labels><label>auth</label></labels>
+			<comments><comment id="200" author="bob" created="Thu, 1 Oct 2026">works now</comment></comments>
+		</item>
+	</channel></rss>`
+
+	issues, err := ParseJiraXML(strings.NewReader(data))
+	assert.NoError(err)
+	assert.Len(issues, 1)
+
+	issue := issues[0]
+	assert.Equal("WEB-7", issue.ExternalID)
+	assert.Equal("Login fails", issue.Subject)
+	assert.Equal("<p>cannot log in</p>", issue.Content)
+	assert.Equal("Done", issue.State)
+	assert.Equal("jane", issue.Reporter)
+	assert.Equal("", issue.Assignee)
+	assert.Equal([]string{"auth"}, issue.Labels)
+	assert.Equal([]Comment{{ExternalID: "200", Author: "bob", Content: "works now"}}, issue.Comments)
+}
+
+func TestImporter_Import(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	data := "id,subject,state,labels,reporter,comment\n" +
+		"1,Disk full,closed,\"ops, infra\",jane,\n" +
+		"2,Restart,in progress,,sam,\n" +
+		"2,Repeated,,,,\n" +
+		"3,,open,,,\n"
+
+	opt := &api.ImportOptions{
+		Format:      api.ImportOptionsFormatCsv,
+		ProjectName: strPtr("imported"),
+		DryRun:      boolPtr(true),
+		Columns:     &api.ImportColumns{Subject: "subject", ExternalId: strPtr("id"), State: strPtr("state"), Labels: strPtr("labels"), Reporter: strPtr("reporter")},
+		Users:       &api.ImportMapping{AdditionalProperties: map[string]string{"jane": testUser}},
+		Labels:      &api.ImportMapping{AdditionalProperties: map[string]string{"infra": ""}},
+	}
+
+	im := New(stores)
+
+	report, err := im.Import(ctx, opt, strings.NewReader(data), testCustomerId, testActor)
+	assert.NoError(err)
+	assert.True(report.DryRun)
+	assert.True(report.ProjectCreated)
+	assert.Equal("", report.ProjectId)
+	assert.Equal(2, report.Created)
+	assert.Equal(2, report.Invalid)
+	assert.Equal([]string{"ops"}, report.NewLabels)
+	assert.Equal([]string{"sam"}, report.UnmappedUsers)
+
+	projects, err := stores.Projects.List(ctx, store.NewProjectsListOptions("imported", 0, 10), testCustomerId)
+	assert.NoError(err)
+	assert.Empty(projects)
+
+	opt.DryRun = nil
+
+	report, err = im.Import(ctx, opt, strings.NewReader(data), testCustomerId, testActor)
+	assert.NoError(err)
+	assert.True(report.ProjectCreated)
+	assert.Equal(2, report.Created)
+	assert.Equal(api.ImportIssueResultStatusCreated, report.Issues[0].Status)
+
+	issue, err := stores.Issues.GetByID(ctx, *report.Issues[0].IssueId, report.ProjectId, testCustomerId)
+	assert.NoError(err)
+	assert.Equal("closed", issue.State)
+	assert.Equal([]string{"ops"}, issue.Labels)
+
+	issue, err = stores.Issues.GetByID(ctx, *report.Issues[1].IssueId, report.ProjectId, testCustomerId)
+	assert.NoError(err)
+	assert.Equal("in_progress", issue.State)
+	assert.Equal("_Reported by sam_", *issue.Content)
+
+	// importing again finds the issues created before
+	report, err = im.Import(ctx, opt, strings.NewReader(data), testCustomerId, testActor)
+	assert.NoError(err)
+	assert.False(report.ProjectCreated)
+	assert.Equal(0, report.Created)
+	assert.Equal(2, report.Existing)
+	assert.Empty(report.NewLabels)
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
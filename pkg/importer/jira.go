@@ -0,0 +1,109 @@
+package importer
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type jiraUser struct {
+	Username string `xml:"username,attr"`
+	Name     string `xml:",chardata"`
+}
+
+type jiraComment struct {
+	ID     string `xml:"id,attr"`
+	Author string `xml:"author,attr"`
+	Body   string `xml:",chardata"`
+}
+
+type jiraItem struct {
+	Key         string        `xml:"key"`
+	Summary     string        `xml:"summary"`
+	Description string        `xml:"description"`
+	Type        string        `xml:"type"`
+	Priority    string        `xml:"priority"`
+	Status      string        `xml:"status"`
+	Assignee    jiraUser      `xml:"assignee"`
+	Reporter    jiraUser      `xml:"reporter"`
+	Labels      []string      `xml:"labels>label"`
+	Comments    []jiraComment `xml:"comments>comment"`
+}
+
+// user the username of a Jira user falling back to their display name, unassigned issues have
+// the username -1.
+func (u jiraUser) user() string {
+	if u.Username == "-1" {
+		return ""
+	}
+
+	if u.Username != "" {
+		return u.Username
+	}
+
+	return strings.TrimSpace(u.Name)
+}
+
+// ParseJiraXML read issues from a Jira XML export, the descriptions and comments are HTML which
+// is kept as it is since the content is sanitised when it is rendered.
+func ParseJiraXML(r io.Reader) ([]Issue, error) {
+	dec := xml.NewDecoder(r)
+
+	issues := []Issue{}
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, &ValidationError{fmt.Sprintf("failed to read jira xml: %s", err)}
+		}
+
+		// each item is decoded as it is reached rather than reading the whole export
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "item" {
+			continue
+		}
+
+		item := jiraItem{}
+		if err := dec.DecodeElement(&item, &start); err != nil {
+			return nil, &ValidationError{fmt.Sprintf("failed to read jira item: %s", err)}
+		}
+
+		issue := Issue{
+			ExternalID: strings.TrimSpace(item.Key),
+			Subject:    strings.TrimSpace(item.Summary),
+			Content:    strings.TrimSpace(item.Description),
+			State:      strings.TrimSpace(item.Status),
+			Severity:   strings.TrimSpace(item.Priority),
+			Category:   strings.TrimSpace(item.Type),
+			Labels:     []string{},
+			Reporter:   item.Reporter.user(),
+			Assignee:   item.Assignee.user(),
+		}
+
+		if issue.ExternalID == "" {
+			return nil, &ValidationError{"jira item has no key"}
+		}
+
+		for _, label := range item.Labels {
+			if label = strings.TrimSpace(label); label != "" {
+				issue.Labels = append(issue.Labels, label)
+			}
+		}
+
+		for i, jc := range item.Comments {
+			comment := Comment{ExternalID: jc.ID, Author: jc.Author, Content: strings.TrimSpace(jc.Body)}
+			if comment.ExternalID == "" {
+				comment.ExternalID = fmt.Sprintf("%s#%d", issue.ExternalID, i+1)
+			}
+
+			issue.Comments = append(issue.Comments, comment)
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
@@ -0,0 +1,68 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/wolfeidau/exitus/pkg/api"
+)
+
+// Issue an issue read from a source.
+type Issue struct {
+	ExternalID string
+	Subject    string
+	Content    string
+	State      string
+	Severity   string
+	Category   string
+	Labels     []string
+	Reporter   string
+	Assignee   string
+	Comments   []Comment
+}
+
+// Comment a comment on an issue read from a source.
+type Comment struct {
+	ExternalID string
+	Author     string
+	Content    string
+}
+
+// ValidationError occurs when the options or the file being imported are invalid.
+type ValidationError struct {
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid import: %s", e.Message)
+}
+
+// source the source recorded with the external ids of a format, both Jira formats share the
+// issue keys as their ids.
+func source(format api.ImportOptionsFormat) string {
+	switch format {
+	case api.ImportOptionsFormatJiraXml, api.ImportOptionsFormatJiraCsv:
+		return "jira"
+	}
+
+	return string(format)
+}
+
+// Parse read the issues from a file in the format, the columns are only used by csv.
+func Parse(format api.ImportOptionsFormat, r io.Reader, columns *api.ImportColumns) ([]Issue, error) {
+	switch format {
+	case api.ImportOptionsFormatCsv:
+		if columns == nil {
+			return nil, &ValidationError{"columns are required to import csv"}
+		}
+		return ParseCSV(r, columns)
+	case api.ImportOptionsFormatGithub:
+		return ParseGitHub(r)
+	case api.ImportOptionsFormatJiraXml:
+		return ParseJiraXML(r)
+	case api.ImportOptionsFormatJiraCsv:
+		return ParseJiraCSV(r)
+	}
+
+	return nil, &ValidationError{fmt.Sprintf("unknown format %s", format)}
+}
@@ -0,0 +1,253 @@
+package inbound
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/store"
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+var (
+	// replyPrefixPattern matches the Re: and Fwd: prefixes mail clients add to the subject.
+	replyPrefixPattern = regexp.MustCompile(`(?i)^\s*((re|fw|fwd|aw)\s*(\[\d+\])?:\s*)+`)
+
+	// messageIDPattern matches the message ids in In-Reply-To and References headers.
+	messageIDPattern = regexp.MustCompile(`<([^<>\s]+)>`)
+
+	// recipientHeaders the headers which may hold the address an email was routed with, relays
+	// set Delivered-To or X-Original-To when the address isn't in To or Cc.
+	recipientHeaders = []string{"To", "Cc", "Delivered-To", "X-Original-To", "Envelope-To"}
+)
+
+// ParseError occurs when a message isn't a valid RFC 5322 email.
+type ParseError struct {
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid email message: %s", e.Message)
+}
+
+// Parse read a raw RFC 5322 message, the plain text body is preferred over HTML and quoted text
+// and signatures are stripped from it. Messages without a Message-ID are given one derived from
+// their content so redelivery is still detected.
+func Parse(r io.Reader) (*store.InboundEmail, error) {
+	msg, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, &ParseError{err.Error()}
+	}
+
+	from, err := msg.Header.AddressList("From")
+	if err != nil || len(from) == 0 {
+		return nil, &ParseError{"missing from address"}
+	}
+
+	email := &store.InboundEmail{
+		From:    strings.ToLower(from[0].Address),
+		Subject: decodeHeader(msg.Header.Get("Subject")),
+	}
+
+	email.Subject = strings.TrimSpace(replyPrefixPattern.ReplaceAllString(email.Subject, ""))
+
+	for _, name := range recipientHeaders {
+		addrs, err := msg.Header.AddressList(name)
+		if err != nil {
+			continue
+		}
+
+		for _, addr := range addrs {
+			email.Recipients = append(email.Recipients, strings.ToLower(addr.Address))
+		}
+	}
+
+	email.References = messageIDs(msg.Header.Get("In-Reply-To"))
+	references := messageIDs(msg.Header.Get("References"))
+	for i := len(references) - 1; i >= 0; i-- {
+		if len(email.References) == 0 || email.References[0] != references[i] {
+			email.References = append(email.References, references[i])
+		}
+	}
+
+	text, err := textBody(msg.Header.Get("Content-Type"), msg.Header.Get("Content-Transfer-Encoding"), msg.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	email.Body = StripReply(text)
+
+	if ids := messageIDs(msg.Header.Get("Message-Id")); len(ids) > 0 {
+		email.MessageID = ids[0]
+	} else {
+		sum := sha256.Sum256([]byte(strings.Join([]string{email.From, msg.Header.Get("Date"), email.Subject, text}, "\n")))
+		email.MessageID = fmt.Sprintf("%x@exitus.generated", sum[:16])
+	}
+
+	return email, nil
+}
+
+func decodeHeader(value string) string {
+	decoded, err := (&mime.WordDecoder{CharsetReader: charsetReader}).DecodeHeader(value)
+	if err != nil {
+		return toText([]byte(value), "")
+	}
+
+	return toText([]byte(decoded), "")
+}
+
+// charsetReader decodes the charsets of encoded words which aren't UTF-8.
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	enc, err := htmlindex.Get(charset)
+	if err != nil {
+		return nil, err
+	}
+
+	return enc.NewDecoder().Reader(input), nil
+}
+
+// toText converts text in a charset to UTF-8 which can be stored, unknown charsets are read as
+// UTF-8 and the bytes which aren't valid are replaced along with NUL which postgres rejects.
+func toText(data []byte, charset string) string {
+	if charset != "" && !strings.EqualFold(charset, "utf-8") {
+		if enc, err := htmlindex.Get(charset); err == nil {
+			if decoded, err := enc.NewDecoder().Bytes(data); err == nil {
+				data = decoded
+			}
+		}
+	}
+
+	return strings.ReplaceAll(strings.ToValidUTF8(string(data), "\uFFFD"), "\x00", "")
+}
+
+// messageIDs the message ids in a header without angle brackets.
+func messageIDs(value string) []string {
+	ids := []string{}
+	for _, match := range messageIDPattern.FindAllStringSubmatch(value, -1) {
+		ids = append(ids, match[1])
+	}
+
+	return ids
+}
+
+// textBody finds the plain text of a body, walking multipart bodies and skipping attachments. HTML
+// is converted to text when there is no plain text part.
+func textBody(contentType, encoding string, body io.Reader) (string, error) {
+	plain, htmlText, err := bodyParts(contentType, encoding, body)
+	if err != nil {
+		return "", err
+	}
+
+	if plain == "" && htmlText != "" {
+		return htmlToText(htmlText), nil
+	}
+
+	return plain, nil
+}
+
+func bodyParts(contentType, encoding string, body io.Reader) (plain, htmlText string, err error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	switch strings.ToLower(encoding) {
+	case "base64":
+		body = base64.NewDecoder(base64.StdEncoding, &newlineStripper{r: body})
+	case "quoted-printable":
+		body = quotedprintable.NewReader(body)
+	}
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := mr.NextPart()
+			if err == io.EOF {
+				return plain, htmlText, nil
+			}
+			if err != nil {
+				return "", "", &ParseError{err.Error()}
+			}
+
+			if disposition, _, _ := mime.ParseMediaType(part.Header.Get("Content-Disposition")); disposition == "attachment" {
+				continue
+			}
+
+			partPlain, partHTML, err := bodyParts(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part)
+			if err != nil {
+				return "", "", err
+			}
+
+			// the first text of each kind wins, later parts are usually forwarded or attached messages
+			if plain == "" {
+				plain = partPlain
+			}
+			if htmlText == "" {
+				htmlText = partHTML
+			}
+		}
+	case mediaType == "text/plain", mediaType == "text/html":
+		data, err := ioutil.ReadAll(body)
+		if err != nil {
+			return "", "", &ParseError{errors.Wrap(err, "failed to read body").Error()}
+		}
+
+		if mediaType == "text/html" {
+			return "", toText(data, params["charset"]), nil
+		}
+		return toText(data, params["charset"]), "", nil
+	}
+
+	return "", "", nil
+}
+
+// newlineStripper removes the line breaks from base64 encoded bodies.
+type newlineStripper struct {
+	r io.Reader
+}
+
+func (ns *newlineStripper) Read(p []byte) (int, error) {
+	n, err := ns.r.Read(p)
+	j := 0
+	for _, b := range p[:n] {
+		if b != '\r' && b != '\n' {
+			p[j] = b
+			j++
+		}
+	}
+
+	return j, err
+}
+
+var (
+	htmlDropPattern   = regexp.MustCompile(`(?is)<(style|script|head)[^>]*>.*?</(style|script|head)>|<blockquote[^>]*>.*?</blockquote>|<!--.*?-->`)
+	htmlBreakPattern  = regexp.MustCompile(`(?i)<br\s*/?>|</(p|div|li|tr|h[1-6])>`)
+	htmlTagPattern    = regexp.MustCompile(`<[^>]*>`)
+	blankLinesPattern = regexp.MustCompile(`\n{3,}`)
+)
+
+// htmlToText converts an HTML body to plain text, quoted blockquotes are dropped along with the markup.
+func htmlToText(body string) string {
+	body = htmlDropPattern.ReplaceAllString(body, "")
+	body = htmlBreakPattern.ReplaceAllString(body, "\n")
+	body = htmlTagPattern.ReplaceAllString(body, "")
+	body = html.UnescapeString(body)
+
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+
+	return blankLinesPattern.ReplaceAllString(strings.Join(lines, "\n"), "\n\n")
+}
@@ -0,0 +1,171 @@
+package inbound
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Plain(t *testing.T) {
+	assert := require.New(t)
+
+	raw := strings.Join([]string{
+		"From: Jane Customer <Jane@Example.com>",
+		"To: support+desk@support.example.com",
+		"Cc: someone@example.com",
+		"Subject: =?utf-8?q?Re:_printer_=C3=A9rror?=",
+		"Message-ID: <reply-1@mail.example.com>",
+		"In-Reply-To: <first@mail.example.com>",
+		"References: <zero@mail.example.com> <first@mail.example.com>",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		"It is still broken.",
+		"",
+		"On Mon, 19 Oct 2026 at 10:00, Support <support@example.com> wrote:",
+		"> Have you tried turning it off?",
+		"",
+	}, "\r\n")
+
+	email, err := Parse(strings.NewReader(raw))
+	assert.NoError(err)
+	assert.Equal("reply-1@mail.example.com", email.MessageID)
+	assert.Equal([]string{"first@mail.example.com", "zero@mail.example.com"}, email.References)
+	assert.Equal("jane@example.com", email.From)
+	assert.Equal([]string{"support+desk@support.example.com", "someone@example.com"}, email.Recipients)
+	assert.Equal("printer érror", email.Subject)
+	assert.Equal("It is still broken.", email.Body)
+}
+
+func TestParse_Multipart(t *testing.T) {
+	assert := require.New(t)
+
+	raw := strings.Join([]string{
+		"From: jane@example.com",
+		"To: desk@support.example.com",
+		"Subject: help",
+		"Content-Type: multipart/mixed; boundary=outer",
+		"",
+		"--outer",
+		"Content-Type: multipart/alternative; boundary=inner",
+		"",
+		"--inner",
+		"Content-Type: text/html; charset=utf-8",
+		"",
+		"<p>html body</p>",
+		"--inner",
+		"Content-Type: text/plain; charset=utf-8",
+		"Content-Transfer-Encoding: quoted-printable",
+		"",
+		"caf=C3=A9 is =",
+		"closed",
+		"--inner--",
+		"--outer",
+		"Content-Type: text/plain",
+		"Content-Disposition: attachment; filename=log.txt",
+		"Content-Transfer-Encoding: base64",
+		"",
+		"bG9ncw==",
+		"--outer--",
+		"",
+	}, "\r\n")
+
+	email, err := Parse(strings.NewReader(raw))
+	assert.NoError(err)
+	assert.Equal("café is closed", email.Body)
+
+	// a message id is derived from the content when it is missing
+	again, err := Parse(strings.NewReader(raw))
+	assert.NoError(err)
+	assert.NotEmpty(email.MessageID)
+	assert.Equal(email.MessageID, again.MessageID)
+}
+
+func TestParse_HTML(t *testing.T) {
+	assert := require.New(t)
+
+	raw := strings.Join([]string{
+		"From: jane@example.com",
+		"To: desk@support.example.com",
+		"Subject: help",
+		"Content-Type: text/html; charset=utf-8",
+		"Content-Transfer-Encoding: base64",
+		"",
+		"PGh0bWw+PGhlYWQ+PHN0eWxlPnB7fTwvc3R5bGU+PC9oZWFkPjxib2R5PjxwPkEgJmFtcDsgQjwv",
+		"cD48YmxvY2txdW90ZT5xdW90ZWQ8L2Jsb2NrcXVvdGU+PC9ib2R5PjwvaHRtbD4=",
+		"",
+	}, "\r\n")
+
+	email, err := Parse(strings.NewReader(raw))
+	assert.NoError(err)
+	assert.Equal("A & B", email.Body)
+}
+
+func TestParse_Charsets(t *testing.T) {
+	assert := require.New(t)
+
+	raw := strings.Join([]string{
+		"From: jane@example.com",
+		"To: desk@support.example.com",
+		"Subject: =?iso-8859-1?q?caf=E9?=",
+		"Content-Type: multipart/alternative; boundary=parts",
+		"",
+		"--parts",
+		"Content-Type: text/plain; charset=iso-8859-1",
+		"",
+		"caf\xe9 cr\xe8me",
+		"--parts--",
+		"",
+	}, "\r\n")
+
+	email, err := Parse(strings.NewReader(raw))
+	assert.NoError(err)
+	assert.Equal("café", email.Subject)
+	assert.Equal("café crème", email.Body)
+
+	// invalid UTF-8 without a charset is replaced rather than stored
+	raw = strings.Join([]string{
+		"From: jane@example.com",
+		"Subject: help",
+		"Content-Type: text/plain",
+		"",
+		"caf\xe9\x00",
+		"",
+	}, "\r\n")
+
+	email, err = Parse(strings.NewReader(raw))
+	assert.NoError(err)
+	assert.Equal("caf\uFFFD", email.Body)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := Parse(strings.NewReader("not an email"))
+	assert.IsType(&ParseError{}, err)
+
+	_, err = Parse(strings.NewReader("Subject: no sender\r\n\r\nbody\r\n"))
+	assert.IsType(&ParseError{}, err)
+}
+
+func TestStripReply(t *testing.T) {
+	assert := require.New(t)
+
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"signature", "Thanks for the fix.\n\n-- \nJane Customer\nACME", "Thanks for the fix."},
+		{"wrapped attribution", "Works now.\n\nOn Mon, 19 Oct 2026 at 10:00, Support Team <\nsupport@example.com> wrote:\n> old", "Works now."},
+		{"outlook", "See below.\r\n\r\n-----Original Message-----\r\nFrom: Support\r\nSent: Monday", "See below."},
+		{"outlook headers", "Agreed.\n\nFrom: Support <support@example.com>\nSent: Monday\nSubject: help", "Agreed."},
+		{"mobile", "ok\n\nSent from my phone", "ok"},
+		{"interleaved quotes", "> question one\nanswer one\n> question two\nanswer two", "answer one\nanswer two"},
+		{"forwarded", "FYI\n\n---------- Forwarded message ---------\nFrom: customer\n\nit broke", "FYI\n\n---------- Forwarded message ---------\nFrom: customer\n\nit broke"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(tt.want, StripReply(tt.text), tt.name)
+	}
+}
@@ -0,0 +1,67 @@
+package inbound
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// attributionPattern matches the line mail clients add above quoted text, such as
+	// "On Mon, 1 Jan 2026 at 10:00, Jane <jane@example.com> wrote:".
+	attributionPattern = regexp.MustCompile(`(?i)^on\s.+\swrote:$`)
+
+	// originalMessagePattern matches the separators Outlook and others add above the original message.
+	originalMessagePattern = regexp.MustCompile(`(?i)^(-{2,}\s*original message\s*-{2,}|_{20,})$`)
+
+	// outlookHeaderPattern matches the start of the header block Outlook quotes the original message with.
+	outlookHeaderPattern = regexp.MustCompile(`(?i)^from:\s.+`)
+
+	// mobileSignaturePattern matches the signatures mobile mail clients add.
+	mobileSignaturePattern = regexp.MustCompile(`(?i)^sent from my .+$`)
+)
+
+// StripReply removes quoted text and signatures from the plain text of an email, everything from
+// the first attribution line, original message separator or signature delimiter is dropped along
+// with lines quoted with >. Forwarded messages are kept as they are usually the content.
+func StripReply(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	lines := strings.Split(text, "\n")
+
+	kept := []string{}
+
+lines:
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "--":
+			break lines
+		case attributionPattern.MatchString(trimmed):
+			break lines
+		case strings.HasPrefix(strings.ToLower(trimmed), "on ") && i+1 < len(lines) && attributionPattern.MatchString(trimmed+" "+strings.TrimSpace(lines[i+1])):
+			// long attribution lines are wrapped by some clients
+			break lines
+		case originalMessagePattern.MatchString(trimmed), mobileSignaturePattern.MatchString(trimmed):
+			break lines
+		case outlookHeaderPattern.MatchString(trimmed) && i+1 < len(lines) && isOutlookHeader(lines[i+1]):
+			break lines
+		case strings.HasPrefix(trimmed, ">"):
+			continue
+		}
+
+		kept = append(kept, strings.TrimRight(line, " \t"))
+	}
+
+	return strings.TrimSpace(blankLinesPattern.ReplaceAllString(strings.Join(kept, "\n"), "\n\n"))
+}
+
+func isOutlookHeader(line string) bool {
+	line = strings.ToLower(strings.TrimSpace(line))
+	for _, prefix := range []string{"sent:", "date:", "to:"} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,94 @@
+// Package jobs runs background jobs queued in the jobs table, other packages define a typed job
+// with NewType, enqueue it from a request and register a handler with the runner started by the
+// backend.
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// Job a claimed job passed to its handler.
+type Job struct {
+	ID          string
+	CustomerID  string
+	Type        string
+	Attempt     int
+	MaxAttempts int
+	Payload     json.RawMessage
+}
+
+// Decode the payload of the job into v.
+func (j *Job) Decode(v interface{}) error {
+	return json.Unmarshal(j.Payload, v)
+}
+
+// HandlerFunc runs a job, the result is encoded as JSON and stored with the job. Returning an error
+// retries the job with a backoff unless the error is Permanent.
+type HandlerFunc func(ctx context.Context, job *Job) (interface{}, error)
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent marks an error which won't be fixed by running the job again, the job is failed
+// without using its remaining attempts.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &permanentError{err: err}
+}
+
+// IsPermanent reports whether the error was marked as Permanent.
+func IsPermanent(err error) bool {
+	var perr *permanentError
+	return errors.As(err, &perr)
+}
+
+// Type a job type with a payload of P.
+type Type[P any] struct {
+	name        string
+	maxAttempts int
+}
+
+// NewType new job type, a maxAttempts of zero uses the configured default.
+func NewType[P any](name string, maxAttempts int) *Type[P] {
+	return &Type[P]{name: name, maxAttempts: maxAttempts}
+}
+
+// Name the name of the job type as stored with the job.
+func (t *Type[P]) Name() string {
+	return t.name
+}
+
+// Enqueue queue a job with the payload to run as soon as a worker is free.
+func (t *Type[P]) Enqueue(ctx context.Context, jobs store.Jobs, payload P, customerId string, createdBy *string) (*api.Job, error) {
+	return jobs.Enqueue(ctx, &store.NewJob{
+		Type:        t.name,
+		Payload:     payload,
+		MaxAttempts: t.maxAttempts,
+		CreatedBy:   createdBy,
+	}, customerId)
+}
+
+// Handle register the function which runs jobs of this type with the runner.
+func (t *Type[P]) Handle(r *Runner, fn func(ctx context.Context, job *Job, payload P) (interface{}, error)) {
+	r.Register(t.name, func(ctx context.Context, job *Job) (interface{}, error) {
+		var payload P
+		if err := job.Decode(&payload); err != nil {
+			return nil, Permanent(err)
+		}
+
+		return fn(ctx, job, payload)
+	})
+}
@@ -0,0 +1,327 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// fakeJobs an in memory jobs store which records the outcome of each job.
+type fakeJobs struct {
+	mu       sync.Mutex
+	queued   []store.QueuedJob
+	outcomes map[string]outcome
+	enqueued []*store.NewJob
+}
+
+type outcome struct {
+	status string
+	reason string
+	result json.RawMessage
+	runAt  time.Time
+}
+
+func newFakeJobs(queued ...store.QueuedJob) *fakeJobs {
+	return &fakeJobs{queued: queued, outcomes: map[string]outcome{}}
+}
+
+func (fj *fakeJobs) Enqueue(ctx context.Context, newJob *store.NewJob, customerId string) (*api.Job, error) {
+	fj.mu.Lock()
+	defer fj.mu.Unlock()
+
+	fj.enqueued = append(fj.enqueued, newJob)
+
+	return &api.Job{Id: "job-1", Type: newJob.Type, Status: api.JobStatusQueued}, nil
+}
+
+func (fj *fakeJobs) GetByID(ctx context.Context, id, customerId string) (*api.Job, error) {
+	return nil, &store.JobNotFoundError{Message: id}
+}
+
+func (fj *fakeJobs) List(ctx context.Context, opt *store.JobListOptions, customerId string) ([]api.Job, error) {
+	return nil, nil
+}
+
+func (fj *fakeJobs) Claim(ctx context.Context, limit int, lease time.Duration, types []string) ([]store.QueuedJob, error) {
+	fj.mu.Lock()
+	defer fj.mu.Unlock()
+
+	if len(fj.queued) == 0 {
+		return nil, nil
+	}
+
+	job := fj.queued[0]
+	fj.queued = fj.queued[1:]
+	job.Attempts++
+
+	return []store.QueuedJob{job}, nil
+}
+
+func (fj *fakeJobs) Heartbeat(ctx context.Context, job *store.QueuedJob, lease time.Duration) error {
+	return nil
+}
+
+func (fj *fakeJobs) Complete(ctx context.Context, job *store.QueuedJob, result json.RawMessage) error {
+	return fj.record(job, outcome{status: "succeeded", result: result})
+}
+
+func (fj *fakeJobs) Retry(ctx context.Context, job *store.QueuedJob, reason string, runAt time.Time) error {
+	return fj.record(job, outcome{status: "queued", reason: reason, runAt: runAt})
+}
+
+func (fj *fakeJobs) Fail(ctx context.Context, job *store.QueuedJob, reason string) error {
+	return fj.record(job, outcome{status: "failed", reason: reason})
+}
+
+func (fj *fakeJobs) record(job *store.QueuedJob, o outcome) error {
+	fj.mu.Lock()
+	defer fj.mu.Unlock()
+
+	fj.outcomes[job.ID] = o
+
+	return nil
+}
+
+func (fj *fakeJobs) outcome(id string) (outcome, bool) {
+	fj.mu.Lock()
+	defer fj.mu.Unlock()
+
+	o, ok := fj.outcomes[id]
+
+	return o, ok
+}
+
+func testConfig() *conf.Config {
+	return &conf.Config{
+		JobWorkers:         2,
+		JobPollInterval:    10 * time.Millisecond,
+		JobLease:           time.Minute,
+		JobMaxAttempts:     5,
+		JobShutdownTimeout: time.Second,
+	}
+}
+
+type greeting struct {
+	Name string `json:"name"`
+}
+
+var greetType = NewType[greeting]("greet", 3)
+
+func TestType_Enqueue(t *testing.T) {
+	assert := require.New(t)
+
+	fj := newFakeJobs()
+
+	job, err := greetType.Enqueue(context.Background(), fj, greeting{Name: "exitus"}, "customer-1", nil)
+	assert.NoError(err)
+	assert.Equal("greet", job.Type)
+
+	assert.Len(fj.enqueued, 1)
+	assert.Equal("greet", fj.enqueued[0].Type)
+	assert.Equal(3, fj.enqueued[0].MaxAttempts)
+	assert.Equal(greeting{Name: "exitus"}, fj.enqueued[0].Payload)
+}
+
+func TestRunner_Process(t *testing.T) {
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		job        store.QueuedJob
+		handler    func(ctx context.Context, job *Job, payload greeting) (interface{}, error)
+		wantStatus string
+		wantReason string
+		wantResult string
+		wantRunAt  time.Time
+	}{
+		{
+			name: "succeeded",
+			job:  store.QueuedJob{ID: "1", Type: "greet", Payload: json.RawMessage(`{"name":"exitus"}`), MaxAttempts: 3},
+			handler: func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+				return map[string]string{"greeting": "hello " + payload.Name}, nil
+			},
+			wantStatus: "succeeded",
+			wantResult: `{"greeting":"hello exitus"}`,
+		},
+		{
+			name: "retried with backoff",
+			job:  store.QueuedJob{ID: "2", Type: "greet", Payload: json.RawMessage(`{}`), Attempts: 1, MaxAttempts: 3},
+			handler: func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+				return nil, errors.New("unavailable")
+			},
+			wantStatus: "queued",
+			wantReason: "unavailable",
+			wantRunAt:  now.Add(time.Minute),
+		},
+		{
+			name: "failed on last attempt",
+			job:  store.QueuedJob{ID: "3", Type: "greet", Payload: json.RawMessage(`{}`), Attempts: 2, MaxAttempts: 3},
+			handler: func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+				return nil, errors.New("unavailable")
+			},
+			wantStatus: "failed",
+			wantReason: "unavailable",
+		},
+		{
+			name: "failed on permanent error",
+			job:  store.QueuedJob{ID: "4", Type: "greet", Payload: json.RawMessage(`{}`), MaxAttempts: 3},
+			handler: func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+				return nil, Permanent(errors.New("no such project"))
+			},
+			wantStatus: "failed",
+			wantReason: "no such project",
+		},
+		{
+			name: "failed on invalid payload",
+			job:  store.QueuedJob{ID: "5", Type: "greet", Payload: json.RawMessage(`[]`), MaxAttempts: 3},
+			handler: func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+				return nil, nil
+			},
+			wantStatus: "failed",
+			wantReason: "json: cannot unmarshal array into Go value of type jobs.greeting",
+		},
+		{
+			name: "retried after panic",
+			job:  store.QueuedJob{ID: "6", Type: "greet", Payload: json.RawMessage(`{}`), MaxAttempts: 3},
+			handler: func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+				panic("boom")
+			},
+			wantStatus: "queued",
+			wantReason: "job panicked: boom",
+			wantRunAt:  now.Add(30 * time.Second),
+		},
+		{
+			name: "failed after exceeding max attempts",
+			job:  store.QueuedJob{ID: "7", Type: "greet", Payload: json.RawMessage(`{}`), Attempts: 3, MaxAttempts: 3},
+			handler: func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+				t.Fatal("job should not run")
+				return nil, nil
+			},
+			wantStatus: "failed",
+			wantReason: "exceeded max attempts",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			fj := newFakeJobs(tt.job)
+
+			r := NewRunner(testConfig(), fj)
+			r.now = func() time.Time { return now }
+			greetType.Handle(r, tt.handler)
+
+			claimed, err := fj.Claim(context.Background(), 1, time.Minute, []string{"greet"})
+			assert.NoError(err)
+
+			r.process(context.Background(), &claimed[0])
+
+			o, ok := fj.outcome(tt.job.ID)
+			assert.True(ok)
+			assert.Equal(tt.wantStatus, o.status)
+			assert.Equal(tt.wantReason, o.reason)
+			assert.Equal(tt.wantRunAt, o.runAt)
+			if tt.wantResult != "" {
+				assert.JSONEq(tt.wantResult, string(o.result))
+			}
+		})
+	}
+}
+
+func TestRunner_Run(t *testing.T) {
+	assert := require.New(t)
+
+	fj := newFakeJobs(
+		store.QueuedJob{ID: "1", Type: "greet", Payload: json.RawMessage(`{"name":"a"}`), MaxAttempts: 3},
+		store.QueuedJob{ID: "2", Type: "greet", Payload: json.RawMessage(`{"name":"b"}`), MaxAttempts: 3},
+	)
+
+	r := NewRunner(testConfig(), fj)
+	greetType.Handle(r, func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+		return nil, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		r.Run(ctx)
+		close(done)
+	}()
+
+	assert.Eventually(func() bool {
+		_, ok1 := fj.outcome("1")
+		_, ok2 := fj.outcome("2")
+		return ok1 && ok2
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+}
+
+func TestRunner_Run_Shutdown(t *testing.T) {
+	tests := []struct {
+		name       string
+		timeout    time.Duration
+		wantStatus string
+	}{
+		{name: "running jobs finish", timeout: time.Second, wantStatus: "succeeded"},
+		{name: "running jobs are interrupted", timeout: 10 * time.Millisecond, wantStatus: "queued"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := require.New(t)
+
+			fj := newFakeJobs(store.QueuedJob{ID: "1", Type: "greet", Payload: json.RawMessage(`{}`), MaxAttempts: 3})
+
+			cfg := testConfig()
+			cfg.JobShutdownTimeout = tt.timeout
+
+			started := make(chan struct{})
+
+			r := NewRunner(cfg, fj)
+			greetType.Handle(r, func(ctx context.Context, job *Job, payload greeting) (interface{}, error) {
+				close(started)
+				select {
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				case <-time.After(100 * time.Millisecond):
+					return nil, nil
+				}
+			})
+
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				r.Run(ctx)
+				close(done)
+			}()
+
+			<-started
+			cancel()
+			<-done
+
+			o, ok := fj.outcome("1")
+			assert.True(ok)
+			assert.Equal(tt.wantStatus, o.status)
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal(30*time.Second, backoff(0))
+	assert.Equal(30*time.Second, backoff(1))
+	assert.Equal(time.Minute, backoff(2))
+	assert.Equal(32*time.Minute, backoff(7))
+	assert.Equal(time.Hour, backoff(8))
+	assert.Equal(time.Hour, backoff(100))
+}
@@ -0,0 +1,242 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+const (
+	// minBackoff the wait after the first failed attempt of a job.
+	minBackoff = 30 * time.Second
+
+	// maxBackoff the longest wait between attempts of a job.
+	maxBackoff = time.Hour
+)
+
+// Runner runs the registered job types with a pool of workers, each worker claims one job at a
+// time so jobs are shared between all the replicas of the backend.
+type Runner struct {
+	cfg      *conf.Config
+	jobs     store.Jobs
+	handlers map[string]HandlerFunc
+	now      func() time.Time
+}
+
+// NewRunner new job runner.
+func NewRunner(cfg *conf.Config, jobs store.Jobs) *Runner {
+	return &Runner{cfg: cfg, jobs: jobs, handlers: map[string]HandlerFunc{}, now: time.Now}
+}
+
+// Register the handler for a job type, this must be done before the runner is started.
+func (r *Runner) Register(name string, fn HandlerFunc) {
+	r.handlers[name] = fn
+}
+
+// Run the workers until the context is cancelled. Once cancelled no more jobs are claimed and the
+// running jobs are given the shutdown timeout to finish before their context is cancelled, jobs
+// which are interrupted are queued again. This returns once all the workers have stopped.
+func (r *Runner) Run(ctx context.Context) {
+	types := make([]string, 0, len(r.handlers))
+	for name := range r.handlers {
+		types = append(types, name)
+	}
+	sort.Strings(types)
+
+	// jobs run with their own context so they can finish after the runner is cancelled
+	jobCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wg := &sync.WaitGroup{}
+	for i := 0; i < r.cfg.JobWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.work(ctx, jobCtx, types)
+		}()
+	}
+
+	log.Info().Int("workers", r.cfg.JobWorkers).Strs("types", types).Msg("started job workers")
+
+	<-ctx.Done()
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(r.cfg.JobShutdownTimeout):
+		log.Warn().Dur("timeout", r.cfg.JobShutdownTimeout).Msg("cancelling running jobs")
+		cancel()
+		<-done
+	}
+
+	log.Info().Msg("stopped job workers")
+}
+
+// work claim and run jobs until the context is cancelled, waiting for the poll interval whenever
+// there are no jobs due.
+func (r *Runner) work(ctx, jobCtx context.Context, types []string) {
+	for ctx.Err() == nil {
+		claimed, err := r.jobs.Claim(ctx, 1, r.cfg.JobLease, types)
+		if err != nil && ctx.Err() == nil {
+			log.Error().Err(err).Msg("failed to claim jobs")
+		}
+
+		if len(claimed) > 0 {
+			r.process(jobCtx, &claimed[0])
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(r.cfg.JobPollInterval):
+		}
+	}
+}
+
+// process run a claimed job and record the outcome. Outcomes are recorded with a background
+// context so they are kept when the job is cancelled by a shutdown.
+func (r *Runner) process(ctx context.Context, qj *store.QueuedJob) {
+	logger := log.With().Str("job_id", qj.ID).Str("type", qj.Type).Int("attempt", qj.Attempts).Logger()
+
+	// a job whose worker died mid run is claimed again once the lease expires, this stops a job
+	// which kills its worker from being retried forever
+	if qj.Attempts > qj.MaxAttempts {
+		logger.Warn().Msg("job exceeded max attempts")
+		r.finish(qj, r.jobs.Fail(context.Background(), qj, "exceeded max attempts"))
+		return
+	}
+
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stopped := make(chan struct{})
+	heartbeat := &sync.WaitGroup{}
+	heartbeat.Add(1)
+	go func() {
+		defer heartbeat.Done()
+		r.heartbeat(cancel, stopped, qj)
+	}()
+
+	job := &Job{
+		ID:          qj.ID,
+		CustomerID:  qj.CustomerID,
+		Type:        qj.Type,
+		Attempt:     qj.Attempts,
+		MaxAttempts: qj.MaxAttempts,
+		Payload:     qj.Payload,
+	}
+
+	started := r.now()
+	res, err := r.call(runCtx, job)
+
+	close(stopped)
+	heartbeat.Wait()
+
+	if err == nil {
+		var result json.RawMessage
+		if res != nil {
+			result, err = json.Marshal(res)
+			if err != nil {
+				err = Permanent(fmt.Errorf("failed to encode result: %w", err))
+			}
+		}
+
+		if err == nil {
+			logger.Info().Dur("duration", r.now().Sub(started)).Msg("job succeeded")
+			r.finish(qj, r.jobs.Complete(context.Background(), qj, result))
+			return
+		}
+	}
+
+	switch {
+	case ctx.Err() != nil:
+		// interrupted by a shutdown so run it again straight away
+		logger.Warn().Err(err).Msg("job interrupted")
+		r.finish(qj, r.jobs.Retry(context.Background(), qj, "interrupted: "+err.Error(), r.now()))
+	case IsPermanent(err) || qj.Attempts >= qj.MaxAttempts:
+		logger.Error().Err(err).Msg("job failed")
+		r.finish(qj, r.jobs.Fail(context.Background(), qj, err.Error()))
+	default:
+		logger.Warn().Err(err).Msg("job attempt failed")
+		r.finish(qj, r.jobs.Retry(context.Background(), qj, err.Error(), r.now().Add(backoff(qj.Attempts))))
+	}
+}
+
+// call run the handler for the job, a panic is returned as an error so it is retried like any
+// other failure.
+func (r *Runner) call(ctx context.Context, job *Job) (res interface{}, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			res, err = nil, fmt.Errorf("job panicked: %v", rec)
+		}
+	}()
+
+	return r.handlers[job.Type](ctx, job)
+}
+
+// heartbeat extend the lease of the job until it is stopped, losing the lease cancels the job as
+// another worker may now be running it.
+func (r *Runner) heartbeat(cancel context.CancelFunc, stopped <-chan struct{}, qj *store.QueuedJob) {
+	ticker := time.NewTicker(r.cfg.JobLease / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopped:
+			return
+		case <-ticker.C:
+		}
+
+		err := r.jobs.Heartbeat(context.Background(), qj, r.cfg.JobLease)
+		if err == store.ErrJobLeaseLost {
+			log.Warn().Str("job_id", qj.ID).Msg("job lease lost, cancelling job")
+			cancel()
+			return
+		}
+		if err != nil {
+			log.Error().Err(err).Str("job_id", qj.ID).Msg("failed to extend job lease")
+		}
+	}
+}
+
+// finish log a failure to record the outcome of a job, a lost lease means another worker has
+// claimed the job and will record its own outcome.
+func (r *Runner) finish(qj *store.QueuedJob, err error) {
+	if err == store.ErrJobLeaseLost {
+		log.Warn().Str("job_id", qj.ID).Msg("job lease lost before recording outcome")
+		return
+	}
+	if err != nil {
+		log.Error().Err(err).Str("job_id", qj.ID).Msg("failed to record job outcome")
+	}
+}
+
+// backoff doubles the wait after each failed attempt starting at thirty seconds.
+func backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if attempts > 8 {
+		return maxBackoff
+	}
+
+	wait := minBackoff << uint(attempts-1)
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+
+	return wait
+}
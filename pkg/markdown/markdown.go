@@ -0,0 +1,167 @@
+// Package markdown renders issue and comment content to HTML which is safe to embed in a page.
+package markdown
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+
+	"github.com/gomarkdown/markdown"
+	"github.com/gomarkdown/markdown/ast"
+	"github.com/gomarkdown/markdown/html"
+	"github.com/gomarkdown/markdown/parser"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// Version of the rendered output, this is bumped whenever a change to the renderer or the sanitiser
+// policy changes the html so previously rendered content is rendered again.
+const Version = 1
+
+// extensions CommonMark along with fenced code blocks, tables, strikethrough and autolinked urls.
+const extensions = parser.NoIntraEmphasis | parser.Tables | parser.FencedCode | parser.Autolink |
+	parser.Strikethrough | parser.SpaceHeadings | parser.BackslashLineBreak
+
+var (
+	// issueKeyPattern matches issue keys such as API-123, the project part follows the rules for project keys.
+	issueKeyPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]{0,9}-[1-9][0-9]*\b`)
+
+	// taskPattern matches the box at the start of a task list item.
+	taskPattern = regexp.MustCompile(`^\[([ xX])\]\s+`)
+
+	policy = newPolicy()
+)
+
+// Render renders markdown to sanitised html.
+func Render(content string) string {
+	if content == "" {
+		return ""
+	}
+
+	p := parser.NewWithExtensions(extensions)
+
+	r := html.NewRenderer(html.RendererOptions{
+		Flags:          html.FlagsNone,
+		RenderNodeHook: renderHook,
+	})
+
+	out := markdown.ToHTML([]byte(content), p, r)
+
+	// raw html in the content is passed through by the renderer so everything is sanitised afterwards
+	return string(policy.SanitizeBytes(out))
+}
+
+// newPolicy the user generated content policy along with the markup produced for task lists and
+// the language of code blocks.
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).OnElements("code")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^issue-link$`)).OnElements("a")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^task-list-item$`)).OnElements("li")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	p.AllowAttrs("checked", "disabled").Matching(regexp.MustCompile(`^$`)).OnElements("input")
+	p.RequireNoReferrerOnLinks(true)
+
+	return p
+}
+
+func renderHook(w io.Writer, node ast.Node, entering bool) (ast.WalkStatus, bool) {
+	switch n := node.(type) {
+	case *ast.ListItem:
+		if !entering || taskText(n) == nil {
+			return ast.GoToNext, false
+		}
+		io.WriteString(w, `<li class="task-list-item">`)
+		return ast.GoToNext, true
+	case *ast.Text:
+		renderText(w, n)
+		return ast.GoToNext, true
+	}
+
+	return ast.GoToNext, false
+}
+
+// renderText escapes text linking any issue keys, the box at the start of a task is rendered as a
+// disabled checkbox.
+func renderText(w io.Writer, text *ast.Text) {
+	literal := text.Literal
+
+	if li := listItemOf(text); li != nil && taskText(li) == text {
+		m := taskPattern.FindSubmatch(literal)
+		if bytes.Equal(m[1], []byte(" ")) {
+			io.WriteString(w, `<input type="checkbox" disabled=""> `)
+		} else {
+			io.WriteString(w, `<input type="checkbox" checked="" disabled=""> `)
+		}
+		literal = literal[len(m[0]):]
+	}
+
+	// text in links is already linked and the text of images is their alt attribute
+	if inLinkOrImage(text) {
+		html.EscapeHTML(w, literal)
+		return
+	}
+
+	last := 0
+	for _, loc := range issueKeyPattern.FindAllIndex(literal, -1) {
+		html.EscapeHTML(w, literal[last:loc[0]])
+		key := literal[loc[0]:loc[1]]
+		io.WriteString(w, `<a class="issue-link" href="/issues/`)
+		w.Write(key)
+		io.WriteString(w, `">`)
+		w.Write(key)
+		io.WriteString(w, `</a>`)
+		last = loc[1]
+	}
+	html.EscapeHTML(w, literal[last:])
+}
+
+// taskText the text holding the box of a task list item, or nil when the item isn't a task.
+func taskText(li *ast.ListItem) *ast.Text {
+	var node ast.Node = li
+	for {
+		children := node.GetChildren()
+		if len(children) == 0 {
+			return nil
+		}
+		switch child := children[0].(type) {
+		case *ast.Paragraph:
+			node = child
+		case *ast.Text:
+			if taskPattern.Match(child.Literal) {
+				return child
+			}
+			return nil
+		default:
+			return nil
+		}
+	}
+}
+
+// listItemOf the list item the text starts, or nil when the text doesn't start one.
+func listItemOf(text *ast.Text) *ast.ListItem {
+	if ast.GetPrevNode(text) != nil {
+		return nil
+	}
+
+	parent := text.Parent
+	if p, ok := parent.(*ast.Paragraph); ok {
+		if ast.GetPrevNode(p) != nil {
+			return nil
+		}
+		parent = p.Parent
+	}
+
+	li, _ := parent.(*ast.ListItem)
+	return li
+}
+
+func inLinkOrImage(node ast.Node) bool {
+	for p := node.GetParent(); p != nil; p = p.GetParent() {
+		switch p.(type) {
+		case *ast.Link, *ast.Image:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,163 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "empty",
+			content: "",
+			want:    "",
+		},
+		{
+			name:    "emphasis",
+			content: "**bold** and _em_",
+			want:    "<p><strong>bold</strong> and <em>em</em></p>\n",
+		},
+		{
+			name:    "task list",
+			content: "- [ ] todo\n- [x] done\n- plain",
+			want:    "<ul>\n<li class=\"task-list-item\"><input type=\"checkbox\" disabled=\"\"> todo</li>\n<li class=\"task-list-item\"><input type=\"checkbox\" checked=\"\" disabled=\"\"> done</li>\n<li>plain</li>\n</ul>\n",
+		},
+		{
+			name:    "fenced code",
+			content: "```go\nfmt.Println(\"API-1\")\n```\n",
+			want:    "<pre><code class=\"language-go\">fmt.Println(&#34;API-1&#34;)\n</code></pre>\n",
+		},
+		{
+			name:    "issue keys",
+			content: "fixed by API-12, see `API-3` and [API-4](https://example.com)",
+			want:    "<p>fixed by <a class=\"issue-link\" href=\"/issues/API-12\" rel=\"nofollow noreferrer\">API-12</a>, see <code>API-3</code> and <a href=\"https://example.com\" rel=\"nofollow noreferrer\">API-4</a></p>\n",
+		},
+		{
+			name:    "not issue keys",
+			content: "api-1 ISO-0 A-1B API- ABCDEFGHIJK-1",
+			want:    "<p>api-1 ISO-0 A-1B API- ABCDEFGHIJK-1</p>\n",
+		},
+		{
+			name:    "autolink",
+			content: "see https://example.com/a?b=c",
+			want:    "<p>see <a href=\"https://example.com/a?b=c\" rel=\"nofollow noreferrer\">https://example.com/a?b=c</a></p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Render(tt.content))
+		})
+	}
+}
+
+// TestRender_XSS regression tests for content which would run script if it was rendered as is.
+func TestRender_XSS(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "script tag",
+			content: "<script>alert(1)</script>",
+			want:    "<p></p>\n",
+		},
+		{
+			name:    "javascript link",
+			content: "[click](javascript:alert(1))",
+			want:    "<p>click</p>\n",
+		},
+		{
+			name:    "mixed case javascript link",
+			content: "[click](JaVaScRiPt:alert(1))",
+			want:    "<p>click</p>\n",
+		},
+		{
+			name:    "data link",
+			content: "[click](data:text/html;base64,PHNjcmlwdD5hbGVydCgxKTwvc2NyaXB0Pg==)",
+			want:    "<p>click</p>\n",
+		},
+		{
+			name:    "javascript image",
+			content: "![img](javascript:alert(1))",
+			want:    "<p><img alt=\"img\"/></p>\n",
+		},
+		{
+			name:    "event handler",
+			content: "<img src=x onerror=alert(1)>",
+			want:    "<p><img src=\"x\"></p>\n",
+		},
+		{
+			name:    "link event handler",
+			content: "<a href=\"#\" onclick=\"alert(1)\">x</a>",
+			want:    "<p>x</p>\n",
+		},
+		{
+			name:    "iframe",
+			content: "<iframe src=\"https://evil.example\"></iframe>",
+			want:    "<p></p>\n",
+		},
+		{
+			name:    "svg",
+			content: "<svg onload=alert(1)>",
+			want:    "<p></p>\n",
+		},
+		{
+			name:    "style",
+			content: "<div style=\"background:url(javascript:alert(1))\">x</div>",
+			want:    "<p><div>x</div></p>\n",
+		},
+		{
+			name:    "link title",
+			content: "[x](http://example.com \"title\\\" onmouseover=\\\"alert(1)\")",
+			want:    "<p><a href=\"http://example.com\" rel=\"nofollow noreferrer\">x</a></p>\n",
+		},
+		{
+			name:    "code block language",
+			content: "```\"><script>alert(1)</script>\nx\n```\n",
+			want:    "<pre><code>&#34;&gt;x\n</code></pre>\n",
+		},
+		{
+			name:    "inline code",
+			content: "`<script>`",
+			want:    "<p><code>&lt;script&gt;</code></p>\n",
+		},
+		{
+			name:    "text input",
+			content: "<input type=\"text\" autofocus onfocus=alert(1)>",
+			want:    "<p></p>\n",
+		},
+		{
+			name:    "checkbox input",
+			content: "<input type=\"checkbox\" onclick=alert(1)>",
+			want:    "<p><input type=\"checkbox\"></p>\n",
+		},
+		{
+			name:    "issue key followed by markup",
+			content: "API-1\"><script>alert(1)</script>",
+			want:    "<p><a class=\"issue-link\" href=\"/issues/API-1\" rel=\"nofollow noreferrer\">API-1</a>&#34;&gt;</p>\n",
+		},
+		{
+			name:    "issue key in alt text",
+			content: "![API-1\" onerror=\"alert(1)](x.png)",
+			want:    "<p><img src=\"x.png\"/></p>\n",
+		},
+		{
+			name:    "form",
+			content: "<form action=\"https://evil.example\"><button>go</button></form>",
+			want:    "<p>go</p>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Render(tt.content))
+		})
+	}
+}
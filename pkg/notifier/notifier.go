@@ -0,0 +1,160 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+const (
+	// batchSize the number of emails claimed from the outbox at a time.
+	batchSize = 100
+
+	// leaseDuration how long claimed emails are held before another sender may retry them.
+	leaseDuration = 5 * time.Minute
+
+	// maxBackoff the longest wait between attempts to send an email.
+	maxBackoff = time.Hour
+)
+
+// Notifier delivers the notifications queued in the email outbox, notifications for the same
+// user are batched into one email.
+type Notifier struct {
+	cfg    *conf.Config
+	outbox store.Outbox
+	sender Sender
+	now    func() time.Time
+}
+
+// NewNotifier new notifier.
+func NewNotifier(cfg *conf.Config, outbox store.Outbox, sender Sender) *Notifier {
+	return &Notifier{cfg: cfg, outbox: outbox, sender: sender, now: time.Now}
+}
+
+// Run deliver email until the context is cancelled.
+func (n *Notifier) Run(ctx context.Context) {
+	ticker := time.NewTicker(n.cfg.EmailPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// keep going until the outbox is drained before waiting for the next tick
+		for {
+			sent, err := n.ProcessBatch(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to process email outbox")
+				break
+			}
+			if sent < batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch claim a batch of due emails and send them, this returns the number claimed.
+func (n *Notifier) ProcessBatch(ctx context.Context) (int, error) {
+	now := n.now()
+
+	emails, err := n.outbox.Claim(ctx, batchSize, leaseDuration, digestCutoff(now, n.cfg.EmailDigestHour))
+	if err != nil {
+		return 0, err
+	}
+
+	for _, batch := range groupByRecipient(emails) {
+		ids := make([]string, len(batch))
+		attempts := 0
+		for i, email := range batch {
+			ids[i] = email.ID
+			if email.Attempts > attempts {
+				attempts = email.Attempts
+			}
+		}
+
+		err := n.send(ctx, batch)
+		if err == nil {
+			err = n.outbox.MarkSent(ctx, ids)
+			if err != nil {
+				return len(emails), err
+			}
+			continue
+		}
+
+		log.Warn().Err(err).Str("user_id", batch[0].UserID).Int("attempts", attempts).Msg("failed to send email")
+
+		err = n.outbox.MarkFailed(ctx, ids, err.Error(), now.Add(backoff(attempts)), n.cfg.EmailMaxAttempts)
+		if err != nil {
+			return len(emails), err
+		}
+	}
+
+	return len(emails), nil
+}
+
+func (n *Notifier) send(ctx context.Context, batch []store.OutboxEmail) error {
+	msg, err := render(batch)
+	if err != nil {
+		return err
+	}
+
+	return n.sender.Send(ctx, msg)
+}
+
+// groupByRecipient splits the emails into batches for each user and mode, keeping the order they
+// were claimed in.
+func groupByRecipient(emails []store.OutboxEmail) [][]store.OutboxEmail {
+	batches := [][]store.OutboxEmail{}
+	index := map[string]int{}
+
+	for _, email := range emails {
+		key := email.CustomerID + "/" + email.UserID + "/" + string(email.Mode)
+
+		i, ok := index[key]
+		if !ok {
+			i = len(batches)
+			index[key] = i
+			batches = append(batches, nil)
+		}
+
+		batches[i] = append(batches[i], email)
+	}
+
+	return batches
+}
+
+// digestCutoff the time of the most recent daily digest, daily emails queued before it are due.
+func digestCutoff(now time.Time, hour int) time.Time {
+	now = now.UTC()
+
+	cutoff := time.Date(now.Year(), now.Month(), now.Day(), hour, 0, 0, 0, time.UTC)
+	if now.Before(cutoff) {
+		cutoff = cutoff.AddDate(0, 0, -1)
+	}
+
+	return cutoff
+}
+
+// backoff doubles the wait after each failed attempt starting at one minute.
+func backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	if attempts > 7 {
+		return maxBackoff
+	}
+
+	wait := time.Minute << uint(attempts-1)
+	if wait > maxBackoff {
+		return maxBackoff
+	}
+
+	return wait
+}
@@ -0,0 +1,226 @@
+package notifier
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// fakeSMTP a minimal SMTP server which records the messages it receives.
+type fakeSMTP struct {
+	ln       net.Listener
+	mu       sync.Mutex
+	fail     bool
+	messages []string
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal("failed to listen")
+	}
+
+	fs := &fakeSMTP{ln: ln}
+	t.Cleanup(func() { ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go fs.serve(conn)
+		}
+	}()
+
+	return fs
+}
+
+func (fs *fakeSMTP) serve(conn net.Conn) {
+	defer conn.Close()
+
+	tp := textproto.NewConn(conn)
+	_ = tp.PrintfLine("220 localhost ESMTP")
+
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+
+		switch cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0]); cmd {
+		case "EHLO", "HELO":
+			_ = tp.PrintfLine("250 localhost")
+		case "DATA":
+			fs.mu.Lock()
+			fail := fs.fail
+			fs.mu.Unlock()
+
+			if fail {
+				_ = tp.PrintfLine("451 try again later")
+				continue
+			}
+
+			_ = tp.PrintfLine("354 go ahead")
+
+			lines, err := tp.ReadDotLines()
+			if err != nil {
+				return
+			}
+
+			fs.mu.Lock()
+			fs.messages = append(fs.messages, strings.Join(lines, "\n"))
+			fs.mu.Unlock()
+
+			_ = tp.PrintfLine("250 OK")
+		case "QUIT":
+			_ = tp.PrintfLine("221 bye")
+			return
+		default:
+			_ = tp.PrintfLine("250 OK")
+		}
+	}
+}
+
+func (fs *fakeSMTP) received() []string {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	return append([]string{}, fs.messages...)
+}
+
+// fakeOutbox returns the emails once and records how they were marked.
+type fakeOutbox struct {
+	emails  []store.OutboxEmail
+	sent    []string
+	failed  []string
+	retryAt time.Time
+}
+
+func (fo *fakeOutbox) Claim(ctx context.Context, limit int, lease time.Duration, digestCutoff time.Time) ([]store.OutboxEmail, error) {
+	emails := fo.emails
+	fo.emails = nil
+	return emails, nil
+}
+
+func (fo *fakeOutbox) MarkSent(ctx context.Context, ids []string) error {
+	fo.sent = append(fo.sent, ids...)
+	return nil
+}
+
+func (fo *fakeOutbox) MarkFailed(ctx context.Context, ids []string, reason string, retryAt time.Time, maxAttempts int) error {
+	fo.failed = append(fo.failed, ids...)
+	fo.retryAt = retryAt
+	return nil
+}
+
+func testEmails() []store.OutboxEmail {
+	key := "API-1"
+	created := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+
+	return []store.OutboxEmail{
+		{
+			ID: "1", CustomerID: "c", UserID: "alice", Email: "alice@example.com", Name: "Alice", Mode: api.NotificationPreferencesEmailImmediate, Attempts: 1,
+			IssueKey: &key, IssueSubject: "<b>broken</b>",
+			Notification: api.Notification{Type: api.NotificationTypeAssigned, IssueId: "i1", CreatedAt: created},
+		},
+		{
+			ID: "2", CustomerID: "c", UserID: "bob", Email: "bob@example.com", Name: "Bob", Mode: api.NotificationPreferencesEmailDaily, Attempts: 1,
+			IssueKey: &key, IssueSubject: "<b>broken</b>",
+			Notification: api.Notification{Type: api.NotificationTypeCommented, IssueId: "i1", CreatedAt: created},
+		},
+		{
+			ID: "3", CustomerID: "c", UserID: "bob", Email: "bob@example.com", Name: "Bob", Mode: api.NotificationPreferencesEmailDaily, Attempts: 1,
+			IssueKey: &key, IssueSubject: "<b>broken</b>",
+			Notification: api.Notification{
+				Type: api.NotificationTypeStateChanged, IssueId: "i1", CreatedAt: created,
+				Details: api.NotificationDetails{AdditionalProperties: map[string]string{"from": "open", "to": "closed"}},
+			},
+		},
+	}
+}
+
+func TestNotifier_ProcessBatch(t *testing.T) {
+	assert := require.New(t)
+
+	fs := newFakeSMTP(t)
+	cfg := &conf.Config{SMTPAddr: fs.ln.Addr().String(), SMTPFrom: "exitus@localhost", EmailDigestHour: 8, EmailMaxAttempts: 5}
+
+	outbox := &fakeOutbox{emails: testEmails()}
+	ntf := NewNotifier(cfg, outbox, NewSMTPSender(cfg))
+
+	claimed, err := ntf.ProcessBatch(context.Background())
+	assert.NoError(err)
+	assert.Equal(3, claimed)
+	assert.Equal([]string{"1", "2", "3"}, outbox.sent)
+
+	// the two daily notifications for bob are sent in one digest
+	messages := fs.received()
+	assert.Len(messages, 2)
+	assert.Contains(messages[0], "To: <alice@example.com>")
+	assert.Contains(messages[0], "Subject: [API-1] <b>broken</b>")
+	assert.Contains(messages[0], "Content-Type: text/plain; charset=utf-8")
+	assert.Contains(messages[0], "&lt;b&gt;broken&lt;/b&gt;")
+	assert.Contains(messages[1], "Subject: Your daily digest: 2 notifications")
+	assert.Contains(messages[1], "The state changed from open to closed.")
+}
+
+func TestSMTPSender_HeaderInjection(t *testing.T) {
+	assert := require.New(t)
+
+	fs := newFakeSMTP(t)
+	cfg := &conf.Config{SMTPAddr: fs.ln.Addr().String(), SMTPFrom: "exitus@localhost"}
+	sender := NewSMTPSender(cfg)
+
+	for _, to := range []string{"alice@example.com\r\nBcc: mallory@example.com", "alice@example.com\nSubject: hi", "not an address"} {
+		err := sender.Send(context.Background(), &Message{To: to, Subject: "hello", Text: "hello", HTML: "hello"})
+		assert.Error(err, to)
+	}
+
+	err := sender.Send(context.Background(), &Message{To: "alice@example.com", Subject: "hello\r\nBcc: mallory@example.com", Text: "hello", HTML: "hello"})
+	assert.NoError(err)
+
+	messages := fs.received()
+	assert.Len(messages, 1)
+	assert.NotContains(messages[0], "\r\nBcc:")
+}
+
+func TestNotifier_Retry(t *testing.T) {
+	assert := require.New(t)
+
+	fs := newFakeSMTP(t)
+	fs.fail = true
+
+	cfg := &conf.Config{SMTPAddr: fs.ln.Addr().String(), SMTPFrom: "exitus@localhost", EmailDigestHour: 8, EmailMaxAttempts: 5}
+
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	outbox := &fakeOutbox{emails: testEmails()[:1]}
+	ntf := NewNotifier(cfg, outbox, NewSMTPSender(cfg))
+	ntf.now = func() time.Time { return now }
+
+	_, err := ntf.ProcessBatch(context.Background())
+	assert.NoError(err)
+	assert.Empty(outbox.sent)
+	assert.Equal([]string{"1"}, outbox.failed)
+	assert.Equal(now.Add(time.Minute), outbox.retryAt)
+	assert.Empty(fs.received())
+}
+
+func TestNotifier_DigestCutoff(t *testing.T) {
+	assert := require.New(t)
+
+	assert.Equal(time.Date(2026, 10, 19, 8, 0, 0, 0, time.UTC), digestCutoff(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC), 8))
+	assert.Equal(time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC), digestCutoff(time.Date(2026, 10, 19, 7, 59, 0, 0, time.UTC), 8))
+	assert.Equal(2*time.Minute, backoff(2))
+	assert.Equal(time.Hour, backoff(10))
+}
@@ -0,0 +1,125 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/conf"
+)
+
+// Message an email with a plain text and HTML body.
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Sender delivers email.
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// SMTPSender delivers email using an SMTP server.
+type SMTPSender struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPSender new SMTP sender using the server in the config, credentials are only used when
+// a username is configured.
+func NewSMTPSender(cfg *conf.Config) *SMTPSender {
+	var auth smtp.Auth
+	if cfg.SMTPUsername != "" {
+		host := cfg.SMTPAddr
+		if i := strings.LastIndex(host, ":"); i > 0 {
+			host = host[:i]
+		}
+		auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, host)
+	}
+
+	return &SMTPSender{addr: cfg.SMTPAddr, from: cfg.SMTPFrom, auth: auth}
+}
+
+// Send send a message as multipart/alternative so clients can pick the text or HTML body.
+func (ss *SMTPSender) Send(ctx context.Context, msg *Message) error {
+	from, err := mail.ParseAddress(ss.from)
+	if err != nil {
+		return errors.Wrapf(err, "invalid from address %q", ss.from)
+	}
+
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return errors.Wrapf(err, "invalid to address %q", msg.To)
+	}
+
+	data, err := ss.encode(msg, from, to)
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(ss.addr, ss.auth, from.Address, []string{to.Address}, data)
+}
+
+func (ss *SMTPSender) encode(msg *Message, from, to *mail.Address) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	mw := multipart.NewWriter(buf)
+
+	headers := []string{
+		"From: " + from.String(),
+		"To: " + (&mail.Address{Address: to.Address}).String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"Date: " + time.Now().Format(time.RFC1123Z),
+		fmt.Sprintf("Message-ID: <%s@exitus>", uuid.NewV4()),
+		"MIME-Version: 1.0",
+		"Content-Type: multipart/alternative; boundary=" + mw.Boundary(),
+	}
+
+	// a line break in a value would start a new header
+	for _, header := range headers {
+		if strings.ContainsAny(header, "\r\n") {
+			return nil, errors.Errorf("invalid header %q: contains a line break", header)
+		}
+	}
+
+	buf.WriteString(strings.Join(headers, "\r\n") + "\r\n\r\n")
+
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=utf-8", msg.Text},
+		{"text/html; charset=utf-8", msg.HTML},
+	}
+
+	for _, part := range parts {
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"8bit"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := pw.Write([]byte(strings.ReplaceAll(part.body, "\n", "\r\n"))); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
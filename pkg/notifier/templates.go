@@ -0,0 +1,114 @@
+package notifier
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var (
+	textTemplate = texttemplate.Must(texttemplate.ParseFS(templateFS, "templates/email.txt.tmpl"))
+	htmlTemplate = htmltemplate.Must(htmltemplate.ParseFS(templateFS, "templates/email.html.tmpl"))
+)
+
+// emailData the values available to the email templates.
+type emailData struct {
+	Name   string
+	Digest bool
+	Items  []emailItem
+}
+
+type emailItem struct {
+	Issue     string
+	Subject   string
+	Summary   string
+	CreatedAt time.Time
+}
+
+// render builds the message for a batch of emails to the same user, the html template escapes
+// values such as the issue subject which are entered by users.
+func render(emails []store.OutboxEmail) (*Message, error) {
+	data := emailData{
+		Name:   emails[0].Name,
+		Digest: emails[0].Mode == api.NotificationPreferencesEmailDaily,
+	}
+
+	for _, email := range emails {
+		issue := email.Notification.IssueId
+		if email.IssueKey != nil {
+			issue = *email.IssueKey
+		}
+
+		data.Items = append(data.Items, emailItem{
+			Issue:     issue,
+			Subject:   email.IssueSubject,
+			Summary:   summary(email.Notification),
+			CreatedAt: email.Notification.CreatedAt,
+		})
+	}
+
+	text := new(bytes.Buffer)
+	if err := textTemplate.Execute(text, data); err != nil {
+		return nil, err
+	}
+
+	html := new(bytes.Buffer)
+	if err := htmlTemplate.Execute(html, data); err != nil {
+		return nil, err
+	}
+
+	return &Message{To: emails[0].Email, Subject: subject(data), Text: text.String(), HTML: html.String()}, nil
+}
+
+func subject(data emailData) string {
+	switch {
+	case data.Digest:
+		return fmt.Sprintf("Your daily digest: %d notifications", len(data.Items))
+	case len(data.Items) == 1:
+		return fmt.Sprintf("[%s] %s", data.Items[0].Issue, data.Items[0].Subject)
+	default:
+		return fmt.Sprintf("%d new notifications", len(data.Items))
+	}
+}
+
+func summary(notification api.Notification) string {
+	switch notification.Type {
+	case api.NotificationTypeAssigned:
+		return "The issue was assigned to you."
+	case api.NotificationTypeMentioned:
+		if notification.CommentId != nil {
+			return "You were mentioned in a comment."
+		}
+		return "You were mentioned in the issue."
+	case api.NotificationTypeStateChanged:
+		details := notification.Details.AdditionalProperties
+		return fmt.Sprintf("The state changed from %s to %s.", details["from"], details["to"])
+	case api.NotificationTypeCommented:
+		return "A new comment was added."
+	case api.NotificationTypeSla:
+		details := notification.Details.AdditionalProperties
+		clock := strings.ReplaceAll(details["clock"], "_", " ")
+		if details["status"] == string(api.SlaClockStatusBreached) {
+			return fmt.Sprintf("The %s SLA target was breached.", clock)
+		}
+		return fmt.Sprintf("The %s SLA target is at risk of being breached.", clock)
+	case api.NotificationTypeDue:
+		details := notification.Details.AdditionalProperties
+		if details["reminder"] == "overdue" {
+			return fmt.Sprintf("The issue was due %s and is overdue.", details["due_at"])
+		}
+		return fmt.Sprintf("The issue is due %s.", details["due_at"])
+	}
+
+	return string(notification.Type)
+}
@@ -0,0 +1,55 @@
+package reminders
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// Sweeper reminds the assignee and watchers of issues which are due soon or overdue.
+type Sweeper struct {
+	cfg       *conf.Config
+	reminders store.DueReminders
+	now       func() time.Time
+}
+
+// NewSweeper new due reminder sweeper.
+func NewSweeper(cfg *conf.Config, reminders store.DueReminders) *Sweeper {
+	return &Sweeper{cfg: cfg, reminders: reminders, now: time.Now}
+}
+
+// Run sweep every interval until the context is cancelled, this is safe to run on every
+// replica as each reminder is only sent once.
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.DueReminderInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Sweep(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to sweep due reminders")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Sweep send the reminders for issues which became due soon or overdue since the last sweep.
+func (s *Sweeper) Sweep(ctx context.Context) error {
+	sent, err := s.reminders.Sweep(ctx, s.now(), s.cfg.DueSoonWindow)
+	if err != nil {
+		return err
+	}
+
+	if sent > 0 {
+		log.Info().Int("sent", sent).Msg("sent due reminders")
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// multipartOverhead allowance for the form fields and boundaries of an upload.
+const multipartOverhead = 1 << 20
+
+// UploadAttachment Upload an attachment. (POST /projects/{project_id}/issues/{issue_id}/attachments).
+func (sv *Server) UploadAttachment(ctx echo.Context, projectId string, issueId string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, sv.cfg.AttachmentMaxSize+multipartOverhead)
+
+	// large files are spooled to disk by the multipart reader rather than held in memory
+	if err := req.ParseMultipartForm(multipartOverhead); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, store.ErrAttachmentTooLarge.Error())
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	defer req.MultipartForm.RemoveAll()
+
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "missing file")
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultAuthor)
+	if err != nil {
+		return err
+	}
+
+	file, err := fh.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	upload := &store.AttachmentUpload{
+		Filename:    fh.Filename,
+		ContentType: fh.Header.Get("Content-Type"),
+		Checksum:    ctx.FormValue("sha256"),
+		Size:        fh.Size,
+		Body:        file,
+		UploadedBy:  userId,
+	}
+
+	if commentId := ctx.FormValue("comment_id"); commentId != "" {
+		upload.CommentId = &commentId
+	}
+
+	resAttachment, err := sv.stores.Attachments.Create(req.Context(), upload, issueId, projectId, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrAttachmentTooLarge {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, err.Error())
+		}
+		switch err.(type) {
+		case *store.IssueNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.AttachmentValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, resAttachment)
+}
+
+// Attachments Get a list of attachments. (GET /projects/{project_id}/issues/{issue_id}/attachments).
+func (sv *Server) Attachments(ctx echo.Context, projectId string, issueId string, params api.AttachmentsParams) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	opt := &store.AttachmentListOptions{CommentId: params.CommentId}
+
+	resAttachments, err := sv.stores.Attachments.List(ctx.Request().Context(), opt, issueId, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.AttachmentsPage{Attachments: resAttachments})
+}
+
+// GetAttachment Get an attachment. (GET /projects/{project_id}/issues/{issue_id}/attachments/{id}).
+func (sv *Server) GetAttachment(ctx echo.Context, projectId string, issueId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resAttachment, err := sv.stores.Attachments.GetByID(ctx.Request().Context(), id, issueId, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.AttachmentNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resAttachment)
+}
+
+// DownloadAttachment Download an attachment. (GET /projects/{project_id}/issues/{issue_id}/attachments/{id}/content).
+func (sv *Server) DownloadAttachment(ctx echo.Context, projectId string, issueId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resAttachment, rc, err := sv.stores.Attachments.Open(ctx.Request().Context(), id, issueId, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.AttachmentNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+	defer rc.Close()
+
+	// uploads are always downloaded rather than rendered so they can't run script in the api origin
+	header := ctx.Response().Header()
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": resAttachment.Filename}))
+	header.Set("Content-Length", strconv.FormatInt(resAttachment.Size, 10))
+	header.Set("ETag", strconv.Quote(resAttachment.Sha256))
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	return ctx.Stream(http.StatusOK, resAttachment.ContentType, rc)
+}
+
+// DeleteAttachment Delete an attachment. (DELETE /projects/{project_id}/issues/{issue_id}/attachments/{id}).
+func (sv *Server) DeleteAttachment(ctx echo.Context, projectId string, issueId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	err := sv.stores.Attachments.Delete(ctx.Request().Context(), id, issueId, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.AttachmentNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
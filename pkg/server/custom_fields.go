@@ -0,0 +1,109 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// CustomFields Get a list of custom fields. (GET /projects/{project_id}/fields).
+func (sv *Server) CustomFields(ctx echo.Context, projectId string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resFields, err := sv.stores.CustomFields.List(ctx.Request().Context(), projectId, DefaultCustomerID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.CustomFieldsPage{Fields: resFields})
+}
+
+// NewCustomField Create a custom field. (POST /projects/{project_id}/fields).
+func (sv *Server) NewCustomField(ctx echo.Context, projectId string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	newField := new(api.NewCustomField)
+	if err := ctx.Bind(newField); err != nil {
+		return err
+	}
+
+	resField, err := sv.stores.CustomFields.Create(ctx.Request().Context(), newField, projectId, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrCustomFieldNameAlreadyExists {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		if _, ok := err.(*store.CustomFieldValidationError); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, resField)
+}
+
+// GetCustomField (GET /projects/{project_id}/fields/{id}).
+func (sv *Server) GetCustomField(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resField, err := sv.stores.CustomFields.GetByID(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.CustomFieldNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resField)
+}
+
+// UpdateCustomField (PUT /projects/{project_id}/fields/{id}).
+func (sv *Server) UpdateCustomField(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	upField := new(api.UpdatedCustomField)
+	if err := ctx.Bind(upField); err != nil {
+		return err
+	}
+
+	resField, err := sv.stores.CustomFields.Update(ctx.Request().Context(), upField, id, projectId, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrCustomFieldNameAlreadyExists {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		switch err.(type) {
+		case *store.CustomFieldNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.CustomFieldValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resField)
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/export"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// StartExport Start an export. (POST /exports).
+func (sv *Server) StartExport(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	newExport := new(api.NewExport)
+	if err := ctx.Bind(newExport); err != nil {
+		return err
+	}
+
+	payload := export.Payload{}
+
+	if newExport.ProjectId != nil && *newExport.ProjectId != "" {
+		_, err := sv.stores.Projects.GetByID(ctx.Request().Context(), *newExport.ProjectId, DefaultCustomerID)
+		if err != nil {
+			if _, ok := err.(*store.ProjectNotFoundError); ok {
+				return echo.NewHTTPError(http.StatusNotFound, err.Error())
+			}
+			return err
+		}
+
+		payload.ProjectID = *newExport.ProjectId
+	}
+
+	userId, err := sv.currentUserID(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	var createdBy *string
+	if userId != "" {
+		createdBy = &userId
+	}
+
+	resJob, err := export.Job.Enqueue(ctx.Request().Context(), sv.stores.Jobs, payload, DefaultCustomerID, createdBy)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusAccepted, resJob)
+}
+
+// DownloadExport Download an export. (GET /exports/{id}/archive).
+func (sv *Server) DownloadExport(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resJob, err := sv.stores.Jobs.GetByID(ctx.Request().Context(), id, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.JobNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	if resJob.Type != export.Job.Name() {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("export not found: id %s", id))
+	}
+
+	if resJob.Status != api.JobStatusSucceeded {
+		return echo.NewHTTPError(http.StatusConflict, fmt.Sprintf("export is %s", resJob.Status))
+	}
+
+	rc, err := sv.stores.Exports.OpenArchive(ctx.Request().Context(), id, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.ExportArchiveNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+	defer rc.Close()
+
+	header := ctx.Response().Header()
+	header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": "exitus-export-" + id + ".ndjson.gz"}))
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	return ctx.Stream(http.StatusOK, "application/gzip", rc)
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/importer"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// ImportIssues Import issues. (POST /imports).
+func (sv *Server) ImportIssues(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	req := ctx.Request()
+	req.Body = http.MaxBytesReader(ctx.Response(), req.Body, sv.cfg.ImportMaxSize+multipartOverhead)
+
+	if err := req.ParseMultipartForm(multipartOverhead); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, "import file is too large")
+		}
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	defer req.MultipartForm.RemoveAll()
+
+	opt := new(api.ImportOptions)
+	if err := json.Unmarshal([]byte(ctx.FormValue("options")), opt); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid import options")
+	}
+
+	fh, err := ctx.FormFile("file")
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "missing file")
+	}
+
+	file, err := fh.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// issues by users who aren't matched are reported by whoever runs the import
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resReport, err := importer.New(sv.stores).Import(req.Context(), opt, file, DefaultCustomerID, userId)
+	if err != nil {
+		switch err.(type) {
+		case *store.ProjectNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *importer.ValidationError, *store.ProjectValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resReport)
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/inbound"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// maxInboundEmailSize the largest raw message accepted from the mail relay.
+const maxInboundEmailSize = 10 << 20
+
+// ReceiveEmail Receive an inbound email. (POST /inbound/email).
+func (sv *Server) ReceiveEmail(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	raw, err := ioutil.ReadAll(http.MaxBytesReader(ctx.Response(), ctx.Request().Body, maxInboundEmailSize))
+	if err != nil {
+		if _, ok := err.(*http.MaxBytesError); ok {
+			return echo.NewHTTPError(http.StatusRequestEntityTooLarge, err.Error())
+		}
+		return err
+	}
+
+	email, err := inbound.Parse(bytes.NewReader(raw))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	resEmail, created, err := sv.stores.InboundEmails.Receive(ctx.Request().Context(), email, DefaultCustomerID, DefaultReporter)
+	if err != nil {
+		switch err.(type) {
+		case *store.InboundEmailRouteError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.InboundEmailValidationError, *store.IssueValidationError, *store.CommentValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	if !created {
+		return ctx.JSON(http.StatusOK, resEmail)
+	}
+
+	return ctx.JSON(http.StatusCreated, resEmail)
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// BulkUpdateIssues Change many issues at once. (POST /projects/{project_id}/issues:bulk).
+func (sv *Server) BulkUpdateIssues(ctx echo.Context, projectId string) error {
+	// echo treats the colon in the path as the start of a parameter, so the route also matches
+	// any other path starting with issues.
+	if ctx.Param("bulk") != ":bulk" {
+		return echo.ErrNotFound
+	}
+
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	bulk := new(api.IssueBulkUpdate)
+	if err := ctx.Bind(bulk); err != nil {
+		return err
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultAuthor)
+	if err != nil {
+		return err
+	}
+
+	resResults, err := sv.stores.Issues.BulkUpdate(ctx.Request().Context(), bulk, projectId, DefaultCustomerID, userId)
+	if err != nil {
+		switch err.(type) {
+		case *store.IssueValidationError, *store.FilterError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resResults)
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/export"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// issueListContentTypes the content types a list of issues can be streamed as.
+var issueListContentTypes = map[string]string{
+	"text/csv":             export.FormatCSV,
+	"application/x-ndjson": export.FormatNDJSON,
+	"application/ndjson":   export.FormatNDJSON,
+}
+
+// issueListFormat the export format asked for by the accept header, this is empty when the
+// issues should be returned as a JSON page.
+func issueListFormat(accept string) string {
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+
+		if format, ok := issueListContentTypes[mediaType]; ok {
+			return format
+		}
+	}
+
+	return ""
+}
+
+// streamIssues write the issues as they are read from the database, once the first row is
+// written errors can only be logged as the response has started.
+func (sv *Server) streamIssues(ctx echo.Context, format string, opt *store.IssueListOptions, projectId string, columns *api.IssueColumns) error {
+	selected, err := export.ParseIssueColumns(toString(columns, ""))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	res := ctx.Response()
+
+	w, err := export.NewIssueWriter(format, res, selected)
+	if err != nil {
+		return err
+	}
+
+	contentType, ext := "text/csv; charset=utf-8", "csv"
+	if format == export.FormatNDJSON {
+		contentType, ext = "application/x-ndjson", "ndjson"
+	}
+
+	res.Header().Set(echo.HeaderContentType, contentType)
+	res.Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{
+		"filename": fmt.Sprintf("%s-issues.%s", projectId, ext),
+	}))
+
+	err = sv.stores.Issues.Stream(ctx.Request().Context(), opt, projectId, DefaultCustomerID, w.Write)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		if res.Committed {
+			log.Error().Err(err).Str("projectId", projectId).Msg("failed to stream issues")
+			return nil
+		}
+
+		res.Header().Del(echo.HeaderContentDisposition)
+
+		switch err.(type) {
+		case *store.SortFieldError, *store.FilterError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// IssueLinks Get a list of links from an issue. (GET /projects/{project_id}/issues/{id}/links).
+func (sv *Server) IssueLinks(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resLinks, err := sv.stores.IssueLinks.List(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.IssueLinksPage{Links: resLinks})
+}
+
+// NewIssueLink Link an issue to another issue. (POST /projects/{project_id}/issues/{id}/links).
+func (sv *Server) NewIssueLink(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	newLink := new(api.NewIssueLink)
+	if err := ctx.Bind(newLink); err != nil {
+		return err
+	}
+
+	resLink, err := sv.stores.IssueLinks.Create(ctx.Request().Context(), newLink, id, projectId, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrIssueLinkAlreadyExists || err == store.ErrIssueLinkCycle {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		switch err.(type) {
+		case *store.IssueNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.InvalidTransitionError:
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		case *store.IssueValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, resLink)
+}
+
+// DeleteIssueLink Remove a link and its inverse. (DELETE /projects/{project_id}/issues/{id}/links/{link_id}).
+func (sv *Server) DeleteIssueLink(ctx echo.Context, projectId string, id string, linkId string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	err := sv.stores.IssueLinks.Delete(ctx.Request().Context(), linkId, id, projectId, DefaultCustomerID)
+	if err != nil {
+		switch err.(type) {
+		case *store.IssueNotFoundError, *store.IssueLinkNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// TransitionIssue Move an issue to a new state. (PUT /projects/{project_id}/issues/{id}/state).
+func (sv *Server) TransitionIssue(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	transition := new(api.IssueTransition)
+	if err := ctx.Bind(transition); err != nil {
+		return err
+	}
+
+	resIssue, err := sv.stores.Issues.Transition(ctx.Request().Context(), transition, id, projectId, DefaultCustomerID)
+	if err != nil {
+		switch err.(type) {
+		case *store.IssueNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.InvalidTransitionError:
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		case *store.IssueValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resIssue)
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// Jobs Get a list of jobs. (GET /jobs).
+func (sv *Server) Jobs(ctx echo.Context, params api.JobsParams) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	_, limit, offset := listArgs(nil, params.Limit, params.Offset)
+
+	opt := &store.JobListOptions{
+		LimitOffset: &store.LimitOffset{Limit: limit, Offset: offset},
+	}
+
+	if params.Type != nil {
+		opt.Type = *params.Type
+	}
+
+	if params.Status != nil {
+		opt.Status = string(*params.Status)
+	}
+
+	resJobs, err := sv.stores.Jobs.List(ctx.Request().Context(), opt, DefaultCustomerID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.JobsPage{Jobs: resJobs})
+}
+
+// GetJob Get a job. (GET /jobs/{id}).
+func (sv *Server) GetJob(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resJob, err := sv.stores.Jobs.GetByID(ctx.Request().Context(), id, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.JobNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resJob)
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// Milestones Get a list of milestones. (GET /projects/{project_id}/milestones).
+func (sv *Server) Milestones(ctx echo.Context, projectId string, params api.MilestonesParams) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	var state string
+	if params.State != nil {
+		state = string(*params.State)
+	}
+
+	resMilestones, err := sv.stores.Milestones.List(ctx.Request().Context(), state, projectId, DefaultCustomerID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.MilestonesPage{Milestones: resMilestones})
+}
+
+// NewMilestone Create a milestone. (POST /projects/{project_id}/milestones).
+func (sv *Server) NewMilestone(ctx echo.Context, projectId string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	newMilestone := new(api.NewMilestone)
+	if err := ctx.Bind(newMilestone); err != nil {
+		return err
+	}
+
+	resMilestone, err := sv.stores.Milestones.Create(ctx.Request().Context(), newMilestone, projectId, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrMilestoneTitleAlreadyExists {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		if _, ok := err.(*store.MilestoneValidationError); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, resMilestone)
+}
+
+// GetMilestone (GET /projects/{project_id}/milestones/{id}).
+func (sv *Server) GetMilestone(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resMilestone, err := sv.stores.Milestones.GetByID(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.MilestoneNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resMilestone)
+}
+
+// UpdateMilestone (PUT /projects/{project_id}/milestones/{id}).
+func (sv *Server) UpdateMilestone(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	upMilestone := new(api.UpdatedMilestone)
+	if err := ctx.Bind(upMilestone); err != nil {
+		return err
+	}
+
+	resMilestone, err := sv.stores.Milestones.Update(ctx.Request().Context(), upMilestone, id, projectId, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrMilestoneTitleAlreadyExists {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		switch err.(type) {
+		case *store.MilestoneNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.MilestoneValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resMilestone)
+}
+
+// TransitionMilestone Close or reopen a milestone. (PUT /projects/{project_id}/milestones/{id}/state).
+func (sv *Server) TransitionMilestone(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	transition := new(api.MilestoneTransition)
+	if err := ctx.Bind(transition); err != nil {
+		return err
+	}
+
+	resMilestone, err := sv.stores.Milestones.Transition(ctx.Request().Context(), transition, id, projectId, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrMilestoneHasOpenIssues {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		switch err.(type) {
+		case *store.MilestoneNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.MilestoneValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resMilestone)
+}
@@ -0,0 +1,164 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// Notifications Get a list of notifications. (GET /me/notifications).
+func (sv *Server) Notifications(ctx echo.Context, params api.NotificationsParams) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	_, limit, offset := listArgs(nil, params.Limit, params.Offset)
+
+	opt := &store.NotificationListOptions{
+		Unread:      params.Unread != nil && *params.Unread,
+		LimitOffset: &store.LimitOffset{Limit: limit, Offset: offset},
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resNotifications, err := sv.stores.Notifications.List(ctx.Request().Context(), opt, DefaultCustomerID, userId)
+	if err != nil {
+		return err
+	}
+
+	unread, err := sv.stores.Notifications.CountUnread(ctx.Request().Context(), DefaultCustomerID, userId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.NotificationsPage{Notifications: resNotifications, Unread: unread})
+}
+
+// ReadAllNotifications Mark all notifications as read. (PUT /me/notifications/read).
+func (sv *Server) ReadAllNotifications(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	err = sv.stores.Notifications.MarkAllRead(ctx.Request().Context(), DefaultCustomerID, userId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// ReadNotification Mark a notification as read. (PUT /me/notifications/{id}/read).
+func (sv *Server) ReadNotification(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	return sv.markNotification(ctx, true, id)
+}
+
+// UnreadNotification Mark a notification as unread. (DELETE /me/notifications/{id}/read).
+func (sv *Server) UnreadNotification(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	return sv.markNotification(ctx, false, id)
+}
+
+func (sv *Server) markNotification(ctx echo.Context, read bool, id string) error {
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	err = sv.stores.Notifications.MarkRead(ctx.Request().Context(), read, id, DefaultCustomerID, userId)
+	if err != nil {
+		if _, ok := err.(*store.NotificationNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// NotificationPreferences Get notification preferences. (GET /me/notification-preferences).
+func (sv *Server) NotificationPreferences(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resPrefs, err := sv.stores.Notifications.GetPreferences(ctx.Request().Context(), DefaultCustomerID, userId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resPrefs)
+}
+
+// UpdateNotificationPreferences Update notification preferences. (PUT /me/notification-preferences).
+func (sv *Server) UpdateNotificationPreferences(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	prefs := new(api.NotificationPreferences)
+	if err := ctx.Bind(prefs); err != nil {
+		return err
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resPrefs, err := sv.stores.Notifications.UpdatePreferences(ctx.Request().Context(), prefs, DefaultCustomerID, userId)
+	if err != nil {
+		if _, ok := err.(*store.NotificationValidationError); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resPrefs)
+}
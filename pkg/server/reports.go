@@ -0,0 +1,97 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// GetProjectReport Get the flow report of a project. (GET /projects/{project_id}/reports).
+func (sv *Server) GetProjectReport(ctx echo.Context, projectId string, params api.GetProjectReportParams) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	opt := &store.ReportOptions{Interval: toString(params.Interval, store.ReportIntervalDay)}
+	if params.From != nil {
+		opt.From = *params.From
+	}
+	if params.To != nil {
+		opt.To = *params.To
+	}
+
+	resReport, err := sv.stores.Reports.Get(ctx.Request().Context(), opt, projectId, DefaultCustomerID)
+	if err != nil {
+		switch err.(type) {
+		case *store.ProjectNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.ReportValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	data, err := json.Marshal(resReport)
+	if err != nil {
+		return err
+	}
+
+	// the report is private to the customer, clients may reuse it for the max age and then
+	// revalidate it with the etag
+	sum := sha256.Sum256(data)
+	etag := fmt.Sprintf(`"%s"`, hex.EncodeToString(sum[:16]))
+
+	res := ctx.Response()
+	res.Header().Set("ETag", etag)
+	res.Header().Set("Cache-Control", fmt.Sprintf("private, max-age=%d", int(sv.cfg.ReportMaxAge.Seconds())))
+
+	if params.IfNoneMatch != nil && etagMatches(*params.IfNoneMatch, etag) {
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	return ctx.JSONBlob(http.StatusOK, data)
+}
+
+// etagMatches check if an If-None-Match header lists the etag, weak tags match the same value.
+func etagMatches(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == etag || tag == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetSlaReport Get the SLA report of a project. (GET /projects/{project_id}/reports/sla).
+func (sv *Server) GetSlaReport(ctx echo.Context, projectId string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resReport, err := sv.stores.Reports.GetSLA(ctx.Request().Context(), projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.ProjectNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resReport)
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rs/zerolog/log"
@@ -166,9 +167,12 @@ func (sv *Server) NewProject(ctx echo.Context) error {
 
 	resProj, err := sv.stores.Projects.Create(ctx.Request().Context(), newProj, DefaultCustomerID)
 	if err != nil {
-		if err == store.ErrProjectNameAlreadyExists {
+		if err == store.ErrProjectNameAlreadyExists || err == store.ErrProjectKeyAlreadyExists {
 			return echo.NewHTTPError(http.StatusConflict, err.Error())
 		}
+		if _, ok := err.(*store.ProjectValidationError); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return err
 	}
 
@@ -213,6 +217,15 @@ func (sv *Server) UpdateProject(ctx echo.Context, id string) error {
 
 	resProj, err := sv.stores.Projects.Update(ctx.Request().Context(), upProj, id, DefaultCustomerID)
 	if err != nil {
+		if err == store.ErrProjectNameAlreadyExists || err == store.ErrProjectKeyAlreadyExists {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		switch err.(type) {
+		case *store.ProjectNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.ProjectValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return err
 	}
 
@@ -234,8 +247,39 @@ func (sv *Server) Issues(ctx echo.Context, projectId string, params api.IssuesPa
 
 	opt := store.NewIssueListOptions(query, offset, limit)
 
+	sortOpt, err := store.NewIssueSortOptions(toString(params.Sort, ""))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	opt.IssueSortOptions = sortOpt
+
+	filters := []string{}
+	if params.Filter != nil {
+		filters = *params.Filter
+	}
+
+	filterOpt, err := store.NewIssueFilterOptions(filters)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	filterOpt.DueBefore = params.DueBefore
+	filterOpt.Overdue = params.Overdue != nil && *params.Overdue
+	opt.IssueFilterOptions = filterOpt
+
+	if format := issueListFormat(ctx.Request().Header.Get(echo.HeaderAccept)); format != "" {
+		// exports include every matching issue unless a page is asked for
+		if params.Limit == nil {
+			opt.LimitOffset = nil
+		}
+		return sv.streamIssues(ctx, format, opt, projectId, params.Columns)
+	}
+
 	resIssues, err := sv.stores.Issues.List(ctx.Request().Context(), opt, projectId, DefaultCustomerID)
 	if err != nil {
+		switch err.(type) {
+		case *store.SortFieldError, *store.FilterError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return err
 	}
 
@@ -257,8 +301,16 @@ func (sv *Server) NewIssue(ctx echo.Context, projectId string) error {
 		return err
 	}
 
-	resIssue, err := sv.stores.Issues.Create(ctx.Request().Context(), newIssue, projectId, DefaultCustomerID, DefaultReporter)
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resIssue, err := sv.stores.Issues.Create(ctx.Request().Context(), newIssue, projectId, DefaultCustomerID, userId)
 	if err != nil {
+		if _, ok := err.(*store.IssueValidationError); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return err
 	}
 
@@ -282,6 +334,12 @@ func (sv *Server) UpdateIssue(ctx echo.Context, projectId string, id string) err
 
 	resIssue, err := sv.stores.Issues.Update(ctx.Request().Context(), upIssue, id, projectId, DefaultCustomerID)
 	if err != nil {
+		switch err.(type) {
+		case *store.IssueNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.IssueValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
 		return err
 	}
 
@@ -301,7 +359,56 @@ func (sv *Server) GetIssue(ctx echo.Context, projectId string, id string) error
 	resIssue, err := sv.stores.Issues.GetByID(ctx.Request().Context(), id, projectId, DefaultCustomerID)
 	if err != nil {
 		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return sv.redirectMovedIssue(ctx, err, id, projectId)
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resIssue)
+}
+
+// redirectMovedIssue redirects to the project an issue has moved to, otherwise returns not found.
+func (sv *Server) redirectMovedIssue(ctx echo.Context, notFound error, id, projectId string) error {
+	targetProjectId, err := sv.stores.Issues.GetRedirect(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, notFound.Error())
+		}
+		return err
+	}
+
+	location := strings.Replace(ctx.Request().URL.Path, "/projects/"+projectId+"/", "/projects/"+targetProjectId+"/", 1)
+
+	return ctx.Redirect(http.StatusMovedPermanently, location)
+}
+
+// MoveIssue Move an issue to another project. (POST /projects/{project_id}/issues/{id}/move).
+func (sv *Server) MoveIssue(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	move := new(api.IssueMove)
+	if err := ctx.Bind(move); err != nil {
+		return err
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resIssue, err := sv.stores.Issues.Move(ctx.Request().Context(), move, id, projectId, DefaultCustomerID, userId)
+	if err != nil {
+		switch err.(type) {
+		case *store.IssueNotFoundError, *store.ProjectNotFoundError:
 			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.IssueValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 		return err
 	}
@@ -309,6 +416,89 @@ func (sv *Server) GetIssue(ctx echo.Context, projectId string, id string) error
 	return ctx.JSON(http.StatusOK, resIssue)
 }
 
+// IssueActivity Get the activity of an issue. (GET /projects/{project_id}/issues/{id}/activity).
+func (sv *Server) IssueActivity(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resActivity, err := sv.stores.Activity.List(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.ActivityPage{Activity: resActivity})
+}
+
+// IssueChildren Get a list of child issues. (GET /projects/{project_id}/issues/{id}/children).
+func (sv *Server) IssueChildren(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resIssues, err := sv.stores.Issues.ListChildren(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.IssuesPage{Issues: resIssues})
+}
+
+// NewChildIssue Create a child issue. (POST /projects/{project_id}/issues/{id}/children).
+func (sv *Server) NewChildIssue(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	newIssue := new(api.NewIssue)
+	if err := ctx.Bind(newIssue); err != nil {
+		return err
+	}
+
+	_, err := sv.stores.Issues.GetByID(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	newIssue.ParentId = &id
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resIssue, err := sv.stores.Issues.Create(ctx.Request().Context(), newIssue, projectId, DefaultCustomerID, userId)
+	if err != nil {
+		if _, ok := err.(*store.IssueValidationError); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, resIssue)
+}
+
 // Comments Get a list of Comments. (GET /projects/{project_id}/issues/{issue_id}/comments).
 func (sv *Server) Comments(ctx echo.Context, projectId string, issueId string, params api.CommentsParams) error {
 	// Validate access token.
@@ -347,11 +537,19 @@ func (sv *Server) NewComment(ctx echo.Context, projectId string, issueId string)
 		return err
 	}
 
-	resComment, err := sv.stores.Comments.Create(ctx.Request().Context(), newComment, issueId, projectId, DefaultCustomerID, DefaultAuthor)
+	userId, err := sv.currentUserID(ctx, DefaultAuthor)
 	if err != nil {
 		return err
 	}
 
+	resComment, err := sv.stores.Comments.Create(ctx.Request().Context(), newComment, issueId, projectId, DefaultCustomerID, userId)
+	if err != nil {
+		if _, ok := err.(*store.CommentValidationError); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
 	return ctx.JSON(http.StatusCreated, resComment)
 }
 
@@ -372,6 +570,9 @@ func (sv *Server) UpdateComment(ctx echo.Context, projectId string, issueId stri
 
 	resComment, err := sv.stores.Comments.Update(ctx.Request().Context(), upComment, id, issueId, projectId, DefaultCustomerID)
 	if err != nil {
+		if _, ok := err.(*store.CommentNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
 		return err
 	}
 
@@ -399,6 +600,98 @@ func (sv *Server) GetComment(ctx echo.Context, projectId string, issueId string,
 	return ctx.JSON(http.StatusOK, resComment)
 }
 
+// DeleteComment (DELETE /projects/{project_id}/issues/{issue_id}/comments/{id}).
+func (sv *Server) DeleteComment(ctx echo.Context, projectId string, issueId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	err := sv.stores.Comments.Delete(ctx.Request().Context(), id, issueId, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.CommentNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// CommentRevisions Get the revisions of a comment. (GET /projects/{project_id}/issues/{issue_id}/comments/{id}/revisions).
+func (sv *Server) CommentRevisions(ctx echo.Context, projectId string, issueId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resRevisions, err := sv.stores.Comments.ListRevisions(ctx.Request().Context(), id, issueId, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.CommentNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.CommentRevisionsPage{Revisions: resRevisions})
+}
+
+// IssueRevisions Get the revisions of an issue. (GET /projects/{project_id}/issues/{id}/revisions).
+func (sv *Server) IssueRevisions(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resRevisions, err := sv.stores.Issues.ListRevisions(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.IssueRevisionsPage{Revisions: resRevisions})
+}
+
+// NewUser Create a user. (POST /users).
+func (sv *Server) NewUser(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	newUser := new(api.NewUser)
+	if err := ctx.Bind(newUser); err != nil {
+		return err
+	}
+
+	resUser, err := sv.stores.Users.Create(ctx.Request().Context(), newUser, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrUserLoginAlreadyExists || err == store.ErrUserSubjectAlreadyExists {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		if _, ok := err.(*store.UserValidationError); ok {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, resUser)
+}
+
 // Users Get a list of users. (GET /users).
 func (sv *Server) Users(ctx echo.Context, params api.UsersParams) error {
 	// Validate access token.
@@ -409,7 +702,15 @@ func (sv *Server) Users(ctx echo.Context, params api.UsersParams) error {
 		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
 	}
 
-	return ctx.JSON(http.StatusNotImplemented, "not implemented yet")
+	query, limit, offset := listArgs(params.Q, params.Limit, params.Offset)
+	log.Info().Str("query", query).Int("offset", offset).Int("limit", limit).Msg("UsersListOptions")
+
+	resUsers, err := sv.stores.Users.List(ctx.Request().Context(), store.NewUsersListOptions(query, offset, limit), DefaultCustomerID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.UsersPage{Users: resUsers})
 }
 
 // GetUser (GET /users/{id}).
@@ -422,7 +723,40 @@ func (sv *Server) GetUser(ctx echo.Context, id string) error {
 		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
 	}
 
-	return ctx.JSON(http.StatusNotImplemented, "not implemented yet")
+	resUser, err := sv.stores.Users.GetByID(ctx.Request().Context(), id, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.UserNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resUser)
+}
+
+// Mentions Get a list of mentions. (GET /me/mentions).
+func (sv *Server) Mentions(ctx echo.Context, params api.MentionsParams) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	_, limit, offset := listArgs(nil, params.Limit, params.Offset)
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resMentions, err := sv.stores.Mentions.List(ctx.Request().Context(), &store.LimitOffset{Limit: limit, Offset: offset}, DefaultCustomerID, userId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.MentionsPage{Mentions: resMentions})
 }
 
 func userHasAccess(ctx echo.Context) bool {
@@ -442,3 +776,33 @@ func userHasAccess(ctx echo.Context) bool {
 
 	return user.HasScope(scopes)
 }
+
+// currentSubject the subject of the access token of the authenticated user, this is only called after
+// userHasAccess has loaded the user.
+func currentSubject(ctx echo.Context) string {
+	user, err := auth.LoadUserFromContext(ctx)
+	if err != nil {
+		return ""
+	}
+
+	return user.ID
+}
+
+// currentUserID the identifier of the user linked to the subject of the access token, subjects aren't
+// always uuids so they are never recorded as a user, the fallback is used when no user is linked.
+func (sv *Server) currentUserID(ctx echo.Context, fallback string) (string, error) {
+	subject := currentSubject(ctx)
+	if subject == "" {
+		return fallback, nil
+	}
+
+	user, err := sv.stores.Users.GetBySubject(ctx.Request().Context(), subject, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.UserNotFoundError); ok {
+			return fallback, nil
+		}
+		return "", err
+	}
+
+	return user.Id, nil
+}
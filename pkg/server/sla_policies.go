@@ -0,0 +1,130 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// SlaPolicies Get a list of SLA policies. (GET /sla-policies).
+func (sv *Server) SlaPolicies(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resPolicies, err := sv.stores.SLAPolicies.List(ctx.Request().Context(), DefaultCustomerID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.SlaPoliciesPage{SlaPolicies: resPolicies})
+}
+
+// NewSlaPolicy Create an SLA policy. (POST /sla-policies).
+func (sv *Server) NewSlaPolicy(ctx echo.Context) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	newPolicy := new(api.NewSlaPolicy)
+	if err := ctx.Bind(newPolicy); err != nil {
+		return err
+	}
+
+	resPolicy, err := sv.stores.SLAPolicies.Create(ctx.Request().Context(), newPolicy, DefaultCustomerID)
+	if err != nil {
+		if err == store.ErrSLAPolicyAlreadyExists {
+			return echo.NewHTTPError(http.StatusConflict, err.Error())
+		}
+		switch err.(type) {
+		case *store.ProjectNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.SLAPolicyValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, resPolicy)
+}
+
+// GetSlaPolicy (GET /sla-policies/{id}).
+func (sv *Server) GetSlaPolicy(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resPolicy, err := sv.stores.SLAPolicies.GetByID(ctx.Request().Context(), id, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.SLAPolicyNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resPolicy)
+}
+
+// UpdateSlaPolicy (PUT /sla-policies/{id}).
+func (sv *Server) UpdateSlaPolicy(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	updatedPolicy := new(api.UpdatedSlaPolicy)
+	if err := ctx.Bind(updatedPolicy); err != nil {
+		return err
+	}
+
+	resPolicy, err := sv.stores.SLAPolicies.Update(ctx.Request().Context(), updatedPolicy, id, DefaultCustomerID)
+	if err != nil {
+		switch err.(type) {
+		case *store.SLAPolicyNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.SLAPolicyValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resPolicy)
+}
+
+// DeleteSlaPolicy (DELETE /sla-policies/{id}).
+func (sv *Server) DeleteSlaPolicy(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	err := sv.stores.SLAPolicies.Delete(ctx.Request().Context(), id, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.SLAPolicyNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
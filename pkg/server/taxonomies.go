@@ -0,0 +1,109 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// GetCustomerTaxonomy Get the issue taxonomy of a customer. (GET /customers/{id}/taxonomy).
+func (sv *Server) GetCustomerTaxonomy(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resTax, err := sv.stores.Taxonomies.GetByCustomerID(ctx.Request().Context(), id)
+	if err != nil {
+		if _, ok := err.(*store.CustomerNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resTax)
+}
+
+// UpdateCustomerTaxonomy Update the issue taxonomy of a customer. (PUT /customers/{id}/taxonomy).
+func (sv *Server) UpdateCustomerTaxonomy(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	upTax := new(api.UpdatedTaxonomy)
+	if err := ctx.Bind(upTax); err != nil {
+		return err
+	}
+
+	resTax, err := sv.stores.Taxonomies.UpdateByCustomerID(ctx.Request().Context(), upTax, id)
+	if err != nil {
+		switch err.(type) {
+		case *store.CustomerNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.TaxonomyValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resTax)
+}
+
+// GetProjectTaxonomy Get the issue taxonomy of a project. (GET /projects/{id}/taxonomy).
+func (sv *Server) GetProjectTaxonomy(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resTax, err := sv.stores.Taxonomies.GetByProjectID(ctx.Request().Context(), id, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.ProjectNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resTax)
+}
+
+// UpdateProjectTaxonomy Update the issue taxonomy of a project. (PUT /projects/{id}/taxonomy).
+func (sv *Server) UpdateProjectTaxonomy(ctx echo.Context, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	upTax := new(api.UpdatedTaxonomy)
+	if err := ctx.Bind(upTax); err != nil {
+		return err
+	}
+
+	resTax, err := sv.stores.Taxonomies.UpdateByProjectID(ctx.Request().Context(), upTax, id, DefaultCustomerID)
+	if err != nil {
+		switch err.(type) {
+		case *store.ProjectNotFoundError:
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case *store.TaxonomyValidationError:
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resTax)
+}
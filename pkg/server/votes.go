@@ -0,0 +1,96 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// VoteIssue Vote for an issue. (PUT /projects/{project_id}/issues/{id}/vote).
+func (sv *Server) VoteIssue(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resIssue, err := sv.stores.Issues.Vote(ctx.Request().Context(), id, projectId, DefaultCustomerID, currentSubject(ctx))
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resIssue)
+}
+
+// UnvoteIssue Remove a vote for an issue. (DELETE /projects/{project_id}/issues/{id}/vote).
+func (sv *Server) UnvoteIssue(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resIssue, err := sv.stores.Issues.Unvote(ctx.Request().Context(), id, projectId, DefaultCustomerID, currentSubject(ctx))
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, resIssue)
+}
+
+// AddReaction React to a comment. (PUT /projects/{project_id}/issues/{issue_id}/comments/{id}/reactions/{reaction}).
+func (sv *Server) AddReaction(ctx echo.Context, projectId string, issueId string, id string, reaction string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resComment, err := sv.stores.Comments.AddReaction(ctx.Request().Context(), reaction, id, issueId, projectId, DefaultCustomerID, currentSubject(ctx))
+	if err != nil {
+		return reactionError(err)
+	}
+
+	return ctx.JSON(http.StatusOK, resComment)
+}
+
+// RemoveReaction Remove a reaction from a comment. (DELETE /projects/{project_id}/issues/{issue_id}/comments/{id}/reactions/{reaction}).
+func (sv *Server) RemoveReaction(ctx echo.Context, projectId string, issueId string, id string, reaction string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resComment, err := sv.stores.Comments.RemoveReaction(ctx.Request().Context(), reaction, id, issueId, projectId, DefaultCustomerID, currentSubject(ctx))
+	if err != nil {
+		return reactionError(err)
+	}
+
+	return ctx.JSON(http.StatusOK, resComment)
+}
+
+func reactionError(err error) error {
+	switch err.(type) {
+	case *store.CommentNotFoundError:
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case *store.CommentValidationError:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return err
+}
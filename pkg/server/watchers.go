@@ -0,0 +1,107 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// WatchIssue Watch an issue. (PUT /projects/{project_id}/issues/{id}/watch).
+func (sv *Server) WatchIssue(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	err = sv.stores.Watchers.Watch(ctx.Request().Context(), id, projectId, DefaultCustomerID, userId)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// UnwatchIssue Stop watching an issue. (DELETE /projects/{project_id}/issues/{id}/watch).
+func (sv *Server) UnwatchIssue(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	err = sv.stores.Watchers.Unwatch(ctx.Request().Context(), id, projectId, DefaultCustomerID, userId)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// IssueWatchers Get the watchers of an issue. (GET /projects/{project_id}/issues/{id}/watchers).
+func (sv *Server) IssueWatchers(ctx echo.Context, projectId string, id string) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	resWatchers, err := sv.stores.Watchers.List(ctx.Request().Context(), id, projectId, DefaultCustomerID)
+	if err != nil {
+		if _, ok := err.(*store.IssueNotFoundError); ok {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.WatchersPage{Watchers: resWatchers})
+}
+
+// WatchedIssues Get a list of watched issues. (GET /me/watching).
+func (sv *Server) WatchedIssues(ctx echo.Context, params api.WatchedIssuesParams) error {
+	// Validate access token.
+	//
+	// 🚨 SECURITY: It's important we check for the correct scopes to know what this token
+	// is allowed to do.
+	if !userHasAccess(ctx) {
+		return echo.NewHTTPError(http.StatusForbidden, "Insufficient scope")
+	}
+
+	_, limit, offset := listArgs(nil, params.Limit, params.Offset)
+
+	userId, err := sv.currentUserID(ctx, DefaultReporter)
+	if err != nil {
+		return err
+	}
+
+	resIssues, err := sv.stores.Issues.ListWatched(ctx.Request().Context(), &store.LimitOffset{Limit: limit, Offset: offset}, DefaultCustomerID, userId)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &api.IssuesPage{Issues: resIssues})
+}
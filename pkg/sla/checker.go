@@ -0,0 +1,56 @@
+package sla
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+// Checker flags the SLA clocks of issues which are at risk or breached. The due and at risk times
+// are computed as issues change, so each check only compares them with the current time.
+type Checker struct {
+	cfg      *conf.Config
+	policies store.SLAPolicies
+	now      func() time.Time
+}
+
+// NewChecker new SLA checker.
+func NewChecker(cfg *conf.Config, policies store.SLAPolicies) *Checker {
+	return &Checker{cfg: cfg, policies: policies, now: time.Now}
+}
+
+// Run check the clocks every interval until the context is cancelled, this is safe to run on
+// every replica as each clock is only flagged once.
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.cfg.SLACheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Check(ctx); err != nil {
+			log.Error().Err(err).Msg("failed to check sla clocks")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check flag the clocks which passed their at risk or due time.
+func (c *Checker) Check(ctx context.Context) error {
+	res, err := c.policies.Check(ctx, c.now())
+	if err != nil {
+		return err
+	}
+
+	if res.AtRisk > 0 || res.Breached > 0 {
+		log.Info().Int("atRisk", res.AtRisk).Int("breached", res.Breached).Msg("flagged sla clocks")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// The actions recorded in the activity of an issue.
+const (
+	ActionMoved       = "moved"
+	ActionBulkUpdated = "bulk_updated"
+)
+
+// Activity provides a store for the audit trail of changes made to issues.
+type Activity interface {
+	List(ctx context.Context, issueId, projectId, customerId string) ([]api.Activity, error)
+}
+
+// ActivityPG provides a activity store for postgresql.
+type ActivityPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewActivity new activity store.
+func NewActivity(dbconn *sql.DB, cfg *conf.Config) Activity {
+	return &ActivityPG{dbconn: dbconn, cfg: cfg}
+}
+
+// List list the activity of an issue, oldest first.
+func (as *ActivityPG) List(ctx context.Context, issueId, projectId, customerId string) ([]api.Activity, error) {
+	if err := issueExists(ctx, as.dbconn, issueId, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	rows, err := as.dbconn.QueryContext(ctx, "SELECT id, action, actor, details, created_at FROM issue_activity WHERE issue_id=$1 AND customer_id=$2 ORDER BY created_at ASC, id ASC", issueId, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list activity by issueId: %s customerId: %s", issueId, customerId)
+	}
+
+	activity := []api.Activity{}
+	defer rows.Close()
+	for rows.Next() {
+		act := api.Activity{}
+		details := hstore.Hstore{}
+
+		err := rows.Scan(&act.Id, &act.Action, &act.Actor, &details, &act.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		act.Details = api.ActivityDetails{}
+		for k, v := range details.Map {
+			act.Details.Set(k, v.String)
+		}
+
+		activity = append(activity, act)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+// recordActivity add an entry to the activity of an issue as part of the transaction making the change.
+func recordActivity(ctx context.Context, tx db.Transaction, action, issueId, customerId, actor string, details map[string]string) error {
+	_, err := tx.ExecContext(ctx, "INSERT INTO issue_activity(customer_id, issue_id, actor, action, details) VALUES($1, $2, $3, $4, $5)",
+		customerId, issueId, actor, action, toHstore(details))
+	return err
+}
@@ -0,0 +1,449 @@
+package store
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"unicode"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/pkg/errors"
+	"github.com/rs/zerolog/log"
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/blob"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// ErrAttachmentTooLarge attachment is larger than the configured limit.
+var ErrAttachmentTooLarge = errors.New("attachment is too large")
+
+// AttachmentNotFoundError occurs when an attachment is not found.
+type AttachmentNotFoundError struct {
+	Message string
+}
+
+func (e *AttachmentNotFoundError) Error() string {
+	return fmt.Sprintf("attachment not found: %s", e.Message)
+}
+
+// AttachmentValidationError occurs when an upload isn't allowed or doesn't match its checksum.
+type AttachmentValidationError struct {
+	Message string
+}
+
+func (e *AttachmentValidationError) Error() string {
+	return fmt.Sprintf("invalid attachment: %s", e.Message)
+}
+
+// sniffLen the number of bytes used to detect the content type of an upload.
+const sniffLen = 512
+
+// AttachmentUpload a file uploaded to an issue or comment, the body is read twice so the checksum
+// and content type are verified before anything is stored.
+type AttachmentUpload struct {
+	CommentId   *string
+	Filename    string
+	ContentType string
+	// Checksum the hex encoded SHA-256 the content must match, this isn't checked when empty.
+	Checksum   string
+	Size       int64
+	Body       io.ReadSeeker
+	UploadedBy string
+}
+
+// AttachmentListOptions specifies the options for listing attachments.
+type AttachmentListOptions struct {
+	// CommentId only list the attachments of a comment.
+	CommentId *string
+}
+
+// Attachments provides a store for the files attached to issues and comments.
+type Attachments interface {
+	Create(ctx context.Context, upload *AttachmentUpload, issueId, projectId, customerId string) (*api.Attachment, error)
+	GetByID(ctx context.Context, id, issueId, projectId, customerId string) (*api.Attachment, error)
+	Open(ctx context.Context, id, issueId, projectId, customerId string) (*api.Attachment, io.ReadCloser, error)
+	List(ctx context.Context, opt *AttachmentListOptions, issueId, projectId, customerId string) ([]api.Attachment, error)
+	Delete(ctx context.Context, id, issueId, projectId, customerId string) error
+}
+
+// AttachmentsPG provides an attachments store for postgresql, the content is kept in the
+// configured blob storage.
+type AttachmentsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewAttachments new attachments store.
+func NewAttachments(dbconn *sql.DB, cfg *conf.Config) Attachments {
+	return &AttachmentsPG{dbconn: dbconn, cfg: cfg}
+}
+
+// Create store the content of an upload then record the attachment, the content is removed again
+// if the attachment can't be recorded.
+func (ats *AttachmentsPG) Create(ctx context.Context, upload *AttachmentUpload, issueId, projectId, customerId string) (*api.Attachment, error) {
+	if _, err := uuid.FromString(issueId); err != nil {
+		return nil, &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", issueId, projectId)}
+	}
+
+	if _, err := uuid.FromString(upload.UploadedBy); err != nil {
+		return nil, &AttachmentValidationError{fmt.Sprintf("uploader %q is not a user identifier", upload.UploadedBy)}
+	}
+
+	if err := issueExists(ctx, ats.dbconn, issueId, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	var commentId *string
+	if upload.CommentId != nil && *upload.CommentId != "" {
+		if err := commentExists(ctx, ats.dbconn, *upload.CommentId, issueId, customerId); err != nil {
+			return nil, err
+		}
+		commentId = upload.CommentId
+	}
+
+	if upload.Size > ats.cfg.AttachmentMaxSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	size, sum, sniffed, err := inspectUpload(upload.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read attachment")
+	}
+
+	if size > ats.cfg.AttachmentMaxSize {
+		return nil, ErrAttachmentTooLarge
+	}
+
+	if upload.Checksum != "" && !strings.EqualFold(upload.Checksum, sum) {
+		return nil, &AttachmentValidationError{fmt.Sprintf("checksum %s does not match the content %s", upload.Checksum, sum)}
+	}
+
+	contentType, err := ats.contentType(upload.ContentType, sniffed)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := upload.Body.Seek(0, io.SeekStart); err != nil {
+		return nil, errors.Wrap(err, "failed to rewind attachment")
+	}
+
+	blobs, err := blob.New(ats.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.NewV4().String()
+	key := path.Join(customerId, issueId, id)
+
+	if err := blobs.Put(ctx, key, upload.Body, size, contentType); err != nil {
+		return nil, errors.Wrapf(err, "failed to store attachment for issueId: %s customerId: %s", issueId, customerId)
+	}
+
+	attachment := &api.Attachment{}
+
+	qry := sqlf.Sprintf(`INSERT INTO attachments(id, customer_id, issue_id, comment_id, filename, content_type, size, sha256, storage_key, uploaded_by)
+		VALUES(%s, %s, %s, %s, %s, %s, %s, %s, %s, %s) RETURNING `+attachmentColumns,
+		id, customerId, issueId, commentId, cleanFilename(upload.Filename), contentType, size, sum, key, upload.UploadedBy)
+
+	err = db.WithTransaction(ctx, ats.dbconn, func(tx db.Transaction) error {
+		return scanAttachment(tx.QueryRowContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...), attachment)
+	})
+	if err != nil {
+		deleteBlobs(ctx, blobs, []string{key})
+		return nil, errors.Wrapf(err, "failed to create attachment for issueId: %s customerId: %s", issueId, customerId)
+	}
+
+	return attachment, nil
+}
+
+// GetByID get attachment by id.
+func (ats *AttachmentsPG) GetByID(ctx context.Context, id, issueId, projectId, customerId string) (*api.Attachment, error) {
+	attachment, _, err := ats.get(ctx, id, issueId, projectId, customerId)
+	return attachment, err
+}
+
+// Open get an attachment along with its content, the caller closes the reader.
+func (ats *AttachmentsPG) Open(ctx context.Context, id, issueId, projectId, customerId string) (*api.Attachment, io.ReadCloser, error) {
+	attachment, key, err := ats.get(ctx, id, issueId, projectId, customerId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blobs, err := blob.New(ats.cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rc, err := blobs.Get(ctx, key)
+	if err != nil {
+		if err == blob.ErrNotFound {
+			return nil, nil, &AttachmentNotFoundError{fmt.Sprintf("content of id %s is missing", id)}
+		}
+		return nil, nil, errors.Wrapf(err, "failed to open attachment by id: %s customerId: %s", id, customerId)
+	}
+
+	return attachment, rc, nil
+}
+
+// List list the attachments of an issue and its comments, oldest first.
+func (ats *AttachmentsPG) List(ctx context.Context, opt *AttachmentListOptions, issueId, projectId, customerId string) ([]api.Attachment, error) {
+	if opt == nil {
+		opt = &AttachmentListOptions{}
+	}
+
+	if _, err := uuid.FromString(issueId); err != nil {
+		return nil, &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", issueId, projectId)}
+	}
+
+	if err := issueExists(ctx, ats.dbconn, issueId, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	conds := []*sqlf.Query{sqlf.Sprintf("customer_id = %s AND issue_id = %s", customerId, issueId)}
+	if opt.CommentId != nil && *opt.CommentId != "" {
+		conds = append(conds, sqlf.Sprintf("comment_id::text = %s", *opt.CommentId))
+	}
+
+	qry := sqlf.Sprintf("SELECT "+attachmentColumns+" FROM attachments WHERE %s ORDER BY created_at ASC, id ASC", sqlf.Join(conds, "AND"))
+
+	rows, err := ats.dbconn.QueryContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list attachments by issueId: %s customerId: %s", issueId, customerId)
+	}
+
+	attachments := []api.Attachment{}
+	defer rows.Close()
+	for rows.Next() {
+		attachment := api.Attachment{}
+		if err := scanAttachment(rows, &attachment); err != nil {
+			return nil, err
+		}
+
+		attachments = append(attachments, attachment)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// Delete delete an attachment, the content is removed once the attachment is gone so it is never
+// referenced after it has been removed.
+func (ats *AttachmentsPG) Delete(ctx context.Context, id, issueId, projectId, customerId string) error {
+	if _, _, err := ats.get(ctx, id, issueId, projectId, customerId); err != nil {
+		return err
+	}
+
+	var key string
+
+	err := db.WithTransaction(ctx, ats.dbconn, func(tx db.Transaction) error {
+		return tx.QueryRowContext(ctx, "DELETE FROM attachments WHERE id=$1 AND issue_id=$2 AND customer_id=$3 RETURNING storage_key", id, issueId, customerId).Scan(&key)
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &AttachmentNotFoundError{fmt.Sprintf("id %s issue_id %s", id, issueId)}
+		}
+		return errors.Wrapf(err, "failed to delete attachment by id: %s customerId: %s", id, customerId)
+	}
+
+	blobs, err := blob.New(ats.cfg)
+	if err != nil {
+		return err
+	}
+
+	deleteBlobs(ctx, blobs, []string{key})
+
+	return nil
+}
+
+func (ats *AttachmentsPG) get(ctx context.Context, id, issueId, projectId, customerId string) (*api.Attachment, string, error) {
+	for _, v := range []string{id, issueId} {
+		if _, err := uuid.FromString(v); err != nil {
+			return nil, "", &AttachmentNotFoundError{fmt.Sprintf("id %s issue_id %s", id, issueId)}
+		}
+	}
+
+	var (
+		attachment = &api.Attachment{}
+		key        string
+	)
+
+	row := ats.dbconn.QueryRowContext(ctx, `SELECT `+attachmentColumns+`, storage_key FROM attachments a
+		WHERE id=$1 AND issue_id=$2 AND customer_id=$3 AND EXISTS (SELECT 1 FROM issues i WHERE i.id=a.issue_id AND i.project_id=$4 AND i.customer_id=a.customer_id)`,
+		id, issueId, customerId, projectId)
+
+	err := scanAttachment(row, attachment, &key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, "", &AttachmentNotFoundError{fmt.Sprintf("id %s issue_id %s", id, issueId)}
+		}
+		return nil, "", errors.Wrapf(err, "failed to get attachment by id: %s customerId: %s", id, customerId)
+	}
+
+	return attachment, key, nil
+}
+
+// contentType resolves the content type of an upload, the declared type is used unless it is
+// missing, and it must agree with the type detected from the content so an HTML page can't be
+// uploaded as an image.
+func (ats *AttachmentsPG) contentType(declared, sniffed string) (string, error) {
+	declared = mediaType(declared)
+	sniffed = mediaType(sniffed)
+
+	if declared == "" || declared == "application/octet-stream" {
+		declared = sniffed
+	}
+
+	// content which can't be identified, such as text formats, doesn't contradict the declared type
+	if sniffed != "application/octet-stream" && sniffed != "text/plain" && sniffed != declared {
+		return "", &AttachmentValidationError{fmt.Sprintf("content type %s does not match the content %s", declared, sniffed)}
+	}
+
+	if !containsString(ats.cfg.AttachmentContentTypes, declared) {
+		return "", &AttachmentValidationError{fmt.Sprintf("content type %s is not allowed", declared)}
+	}
+
+	return declared, nil
+}
+
+// attachmentColumns the columns scanned by scanAttachment.
+const attachmentColumns = "id, issue_id, comment_id, filename, content_type, size, sha256, uploaded_by, created_at"
+
+func scanAttachment(row rowScanner, attachment *api.Attachment, extra ...interface{}) error {
+	var commentId sql.NullString
+
+	dest := append([]interface{}{&attachment.Id, &attachment.IssueId, &commentId, &attachment.Filename, &attachment.ContentType,
+		&attachment.Size, &attachment.Sha256, &attachment.UploadedBy, &attachment.CreatedAt}, extra...)
+
+	if err := row.Scan(dest...); err != nil {
+		return err
+	}
+
+	if commentId.Valid {
+		attachment.CommentId = &commentId.String
+	}
+
+	return nil
+}
+
+// commentExists checks a comment on the issue exists and hasn't been deleted.
+func commentExists(ctx context.Context, q queryRower, id, issueId, customerId string) error {
+	if _, err := uuid.FromString(id); err != nil {
+		return &AttachmentValidationError{fmt.Sprintf("comment %s not found", id)}
+	}
+
+	var deleted bool
+
+	err := q.QueryRowContext(ctx, "SELECT deleted_at IS NOT NULL FROM comments WHERE id=$1 AND issue_id=$2 AND customer_id=$3", id, issueId, customerId).Scan(&deleted)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &AttachmentValidationError{fmt.Sprintf("comment %s not found", id)}
+		}
+		return err
+	}
+
+	if deleted {
+		return &AttachmentValidationError{fmt.Sprintf("comment %s has been deleted", id)}
+	}
+
+	return nil
+}
+
+// inspectUpload reads an upload to find its size, SHA-256 checksum and detected content type.
+func inspectUpload(r io.Reader) (int64, string, string, error) {
+	h := sha256.New()
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return 0, "", "", err
+	}
+
+	h.Write(head[:n])
+
+	rest, err := io.Copy(h, r)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	return int64(n) + rest, hex.EncodeToString(h.Sum(nil)), http.DetectContentType(head[:n]), nil
+}
+
+func mediaType(contentType string) string {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+
+	// some content is detected under its older name
+	switch mt {
+	case "application/x-gzip":
+		return "application/gzip"
+	}
+
+	return mt
+}
+
+// cleanFilename keeps the base name of an uploaded file without control characters.
+func cleanFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, `\`, "/"))
+
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name))
+
+	if runes := []rune(name); len(runes) > 255 {
+		name = string(runes[:255])
+	}
+
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+
+	return name
+}
+
+// deleteCommentAttachments removes the attachments of a comment in the transaction which deletes it,
+// this returns the keys of the content to remove once the transaction commits.
+func deleteCommentAttachments(ctx context.Context, tx db.Transaction, commentId, customerId string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, "DELETE FROM attachments WHERE comment_id=$1 AND customer_id=$2 RETURNING storage_key", commentId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	defer rows.Close()
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// deleteBlobs removes the content of attachments which are gone, failures are logged as nothing
+// references the content any more.
+func deleteBlobs(ctx context.Context, blobs blob.Store, keys []string) {
+	for _, key := range keys {
+		if err := blobs.Delete(ctx, key); err != nil {
+			log.Error().Err(err).Str("key", key).Msg("failed to delete attachment content")
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package store_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestAttachments(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	cfg.BlobDir = t.TempDir()
+	cfg.AttachmentMaxSize = 1024
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "attachments", Content: "see the log", Labels: []string{}}, testProjectId, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	newComment, err := stores.Comments.Create(ctx, &api.NewComment{Content: "screenshot attached"}, newIssue.Id, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	content := []byte("line one\nline two\n")
+	sum := sha256.Sum256(content)
+
+	upload := func(name, contentType, checksum string, data []byte, commentId *string) (*api.Attachment, error) {
+		return stores.Attachments.Create(ctx, &store.AttachmentUpload{
+			CommentId: commentId, Filename: name, ContentType: contentType, Checksum: checksum,
+			Size: int64(len(data)), Body: bytes.NewReader(data), UploadedBy: testAuthor,
+		}, newIssue.Id, testProjectId, testCustomerId)
+	}
+
+	logAttachment, err := upload(`C:\logs\build.log`, "text/plain", hex.EncodeToString(sum[:]), content, nil)
+	if err != nil {
+		t.Fatal("failed to create attachment")
+	}
+
+	assert.Equal("build.log", logAttachment.Filename)
+	assert.Equal("text/plain", logAttachment.ContentType)
+	assert.Equal(int64(len(content)), logAttachment.Size)
+	assert.Equal(hex.EncodeToString(sum[:]), logAttachment.Sha256)
+
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 32)...)
+
+	// the content type is detected when it isn't declared
+	screenshot, err := upload("screen.png", "application/octet-stream", "", png, &newComment.Id)
+	if err != nil {
+		t.Fatal("failed to create attachment")
+	}
+
+	assert.Equal("image/png", screenshot.ContentType)
+	assert.Equal(newComment.Id, *screenshot.CommentId)
+
+	_, err = upload("build.log", "text/plain", "deadbeef", content, nil)
+	assert.IsType(&store.AttachmentValidationError{}, err)
+
+	_, err = upload("page.png", "image/png", "", []byte("<html><script>alert(1)</script></html>"), nil)
+	assert.IsType(&store.AttachmentValidationError{}, err)
+
+	_, err = upload("tool.exe", "application/x-msdownload", "", []byte("MZ"), nil)
+	assert.IsType(&store.AttachmentValidationError{}, err)
+
+	_, err = upload("big.log", "text/plain", "", make([]byte, 2048), nil)
+	assert.Equal(store.ErrAttachmentTooLarge, err)
+
+	attachments, err := stores.Attachments.List(ctx, nil, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list attachments")
+	}
+
+	assert.Len(attachments, 2)
+
+	attachments, err = stores.Attachments.List(ctx, &store.AttachmentListOptions{CommentId: &newComment.Id}, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list attachments")
+	}
+
+	assert.Len(attachments, 1)
+	assert.Equal(screenshot.Id, attachments[0].Id)
+
+	_, rc, err := stores.Attachments.Open(ctx, logAttachment.Id, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to open attachment")
+	}
+
+	data, err := ioutil.ReadAll(rc)
+	assert.NoError(err)
+	assert.NoError(rc.Close())
+	assert.Equal(content, data)
+
+	err = stores.Attachments.Delete(ctx, logAttachment.Id, newIssue.Id, testProjectId, testCustomerId)
+	assert.NoError(err)
+
+	_, err = stores.Attachments.GetByID(ctx, logAttachment.Id, newIssue.Id, testProjectId, testCustomerId)
+	assert.IsType(&store.AttachmentNotFoundError{}, err)
+
+	// attachments are removed along with the comment
+	err = stores.Comments.Delete(ctx, newComment.Id, newIssue.Id, testProjectId, testCustomerId)
+	assert.NoError(err)
+
+	_, err = stores.Attachments.GetByID(ctx, screenshot.Id, newIssue.Id, testProjectId, testCustomerId)
+	assert.IsType(&store.AttachmentNotFoundError{}, err)
+
+	_, err = os.Stat(filepath.Join(cfg.BlobDir, testCustomerId, newIssue.Id, screenshot.Id))
+	assert.True(os.IsNotExist(err))
+}
+
+func TestAttachments_Uploader(t *testing.T) {
+	assert := require.New(t)
+
+	atstore := store.NewAttachments(nil, &conf.Config{})
+
+	for _, uploader := range []string{"", "auth0|5d1f2c3b4a"} {
+		_, err := atstore.Create(context.Background(), &store.AttachmentUpload{Filename: "a.txt", Body: bytes.NewReader([]byte("a")), UploadedBy: uploader}, testIssueId, testProjectId, testCustomerId)
+		assert.IsType(&store.AttachmentValidationError{}, err, uploader)
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/keegancsmith/sqlf"
 	"github.com/pkg/errors"
 	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/blob"
 	"github.com/wolfeidau/exitus/pkg/conf"
 	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/markdown"
 )
 
 // CommentNotFoundError occurs when an comment is not found.
@@ -22,12 +24,25 @@ func (e *CommentNotFoundError) Error() string {
 	return fmt.Sprintf("comment not found: %s", e.Message)
 }
 
+// CommentValidationError occurs when a comment has values which aren't allowed.
+type CommentValidationError struct {
+	Message string
+}
+
+func (e *CommentValidationError) Error() string {
+	return fmt.Sprintf("invalid comment: %s", e.Message)
+}
+
 // Comments provides a comments store.
 type Comments interface {
 	GetByID(ctx context.Context, id, issueId, projectId, customerId string) (*api.Comment, error)
 	Create(ctx context.Context, newComment *api.NewComment, issueId, projectId, customerId, author string) (*api.Comment, error)
 	Update(ctx context.Context, updatedComment *api.UpdatedComment, id, issueId, projectId, customerId string) (*api.Comment, error)
 	List(ctx context.Context, opt *CommentListOptions, issueId, projectId, customerId string) ([]api.Comment, error)
+	Delete(ctx context.Context, id, issueId, projectId, customerId string) error
+	ListRevisions(ctx context.Context, id, issueId, projectId, customerId string) ([]api.CommentRevision, error)
+	AddReaction(ctx context.Context, reaction, id, issueId, projectId, customerId, userId string) (*api.Comment, error)
+	RemoveReaction(ctx context.Context, reaction, id, issueId, projectId, customerId, userId string) (*api.Comment, error)
 }
 
 // CommentListOptions specifies the options for listing comments.
@@ -60,6 +75,19 @@ func ListContentLikeSQL(opt *ContentLikeOptions) (conds []*sqlf.Query) {
 	return conds
 }
 
+// commentThreadSQL selects the comments of an issue with their depth and a path which orders each
+// reply after the comment it replies to, replies to the same comment are ordered by creation time.
+const commentThreadSQL = `WITH RECURSIVE thread AS (
+		SELECT c.*, 0 AS depth, ARRAY[to_char(c.created_at AT TIME ZONE 'UTC', 'YYYYMMDDHH24MISSUS') || c.id::text] AS path
+		FROM comments c WHERE c.parent_id IS NULL AND c.issue_id=%s AND c.project_id=%s AND c.customer_id=%s
+		UNION ALL
+		SELECT c.*, t.depth + 1, t.path || (to_char(c.created_at AT TIME ZONE 'UTC', 'YYYYMMDDHH24MISSUS') || c.id::text)
+		FROM comments c JOIN thread t ON c.parent_id = t.id AND c.customer_id = t.customer_id
+	)
+	SELECT id, parent_id, depth, deleted_at IS NOT NULL,
+		EXISTS (SELECT 1 FROM comment_revisions r WHERE r.customer_id = thread.customer_id AND r.comment_id = thread.id AND r.revision > 1),
+		content, content_html, content_html_version, created_at, updated_at FROM thread `
+
 // CommentsPG provides a comments store for postgresql.
 type CommentsPG struct {
 	dbconn *sql.DB
@@ -73,7 +101,7 @@ func NewComments(dbconn *sql.DB, cfg *conf.Config) Comments {
 
 // GetById get comment by id.
 func (cs *CommentsPG) GetByID(ctx context.Context, id, issueId, projectId, customerId string) (*api.Comment, error) {
-	comments, err := cs.getBySQL(ctx, "WHERE id=$1 AND issue_id=$2 AND project_id=$3 AND customer_id=$4 LIMIT 1", id, issueId, projectId, customerId)
+	comments, err := cs.getBySQL(ctx, sqlf.Sprintf(commentThreadSQL+"WHERE id=%s LIMIT 1", issueId, projectId, customerId, id), customerId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get comment by id: %s issueId: %s projectId: %s customerId: %s", id, issueId, projectId, customerId)
 	}
@@ -85,32 +113,111 @@ func (cs *CommentsPG) GetByID(ctx context.Context, id, issueId, projectId, custo
 	return &comments[0], nil
 }
 
-// Create create new comment.
+// Create create new comment, replies must be to a comment on the same issue which hasn't been deleted.
 func (cs *CommentsPG) Create(ctx context.Context, newComment *api.NewComment, issueId, projectId, customerId, author string) (*api.Comment, error) {
-	comment := api.Comment{}
-
-	qry := sqlf.Sprintf("INSERT INTO comments(issue_id, project_id, customer_id, author, content) VALUES(%s, %s, %s, %s, %s)",
-		issueId, projectId, customerId, author, newComment.Content)
+	var id string
 
 	err := db.WithTransaction(ctx, cs.dbconn, func(tx db.Transaction) error {
-		return tx.QueryRowContext(
-			ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING id, content, created_at, updated_at", qry.Args()...,
-		).Scan(&comment.Id, &comment.Content, &comment.CreatedAt, &comment.UpdatedAt)
+		var err error
+		id, err = insertComment(ctx, tx, newComment, issueId, projectId, customerId, author)
+		return err
 	})
 	if err != nil {
+		if _, ok := err.(*CommentValidationError); ok {
+			return nil, err
+		}
 		return nil, errors.Wrapf(err, "failed to create comment with subject: %s issueId: %s projectId: %s customerId: %s", newComment.Content, issueId, projectId, customerId)
 	}
 
-	return &comment, nil
+	return cs.GetByID(ctx, id, issueId, projectId, customerId)
+}
+
+// insertComment inserts a comment in the transaction along with the notifications, watchers, mentions
+// and first revision which go with it, this returns the id of the comment.
+func insertComment(ctx context.Context, tx db.Transaction, newComment *api.NewComment, issueId, projectId, customerId, author string) (string, error) {
+	var parentId *string
+	if newComment.ParentId != nil && *newComment.ParentId != "" {
+		parentId = newComment.ParentId
+	}
+
+	if parentId != nil {
+		var deleted bool
+
+		err := tx.QueryRowContext(ctx, "SELECT deleted_at IS NOT NULL FROM comments WHERE id=$1 AND issue_id=$2 AND project_id=$3 AND customer_id=$4 FOR SHARE",
+			*parentId, issueId, projectId, customerId).Scan(&deleted)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return "", &CommentValidationError{fmt.Sprintf("parent comment %s not found", *parentId)}
+			}
+			return "", err
+		}
+
+		if deleted {
+			return "", &CommentValidationError{fmt.Sprintf("parent comment %s has been deleted", *parentId)}
+		}
+	}
+
+	var id string
+
+	qry := sqlf.Sprintf("INSERT INTO comments(issue_id, project_id, customer_id, author, parent_id, content, content_html, content_html_version) VALUES(%s, %s, %s, %s, %s, %s, %s, %s)",
+		issueId, projectId, customerId, author, parentId, newComment.Content, markdown.Render(newComment.Content), markdown.Version)
+
+	if err := tx.QueryRowContext(ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING id", qry.Args()...).Scan(&id); err != nil {
+		return "", err
+	}
+
+	if err := notifyWatchers(ctx, tx, api.NotificationTypeCommented, issueId, &id, customerId, author, nil); err != nil {
+		return "", err
+	}
+
+	if err := addWatcher(ctx, tx, issueId, customerId, author, api.WatcherReasonCommenter); err != nil {
+		return "", err
+	}
+
+	if err := recordMentions(ctx, tx, newComment.Content, issueId, &id, customerId, author); err != nil {
+		return "", err
+	}
+
+	// the first comment by someone other than the reporter stops the first response clock
+	if _, err := syncIssueSLA(ctx, tx, issueId, customerId, time.Now()); err != nil {
+		return "", err
+	}
+
+	return id, recordCommentRevision(ctx, tx, id, customerId)
 }
 
 // Update update an comment.
 func (cs *CommentsPG) Update(ctx context.Context, updatedComment *api.UpdatedComment, id, issueId, projectId, customerId string) (*api.Comment, error) {
-	fields := []*sqlf.Query{sqlf.Sprintf("content=%s, updated_at=%s", updatedComment.Content, time.Now())}
+	fields := []*sqlf.Query{sqlf.Sprintf("content=%s, content_html=%s, content_html_version=%s, updated_at=%s", updatedComment.Content, markdown.Render(updatedComment.Content), markdown.Version, time.Now())}
+
+	// the comment must belong to the issue in the url so its revision and mentions are recorded against it
+	qry := sqlf.Sprintf(`UPDATE comments c SET %s FROM issues i
+		WHERE c.id=%s AND c.issue_id=%s AND c.project_id=%s AND c.customer_id=%s AND c.deleted_at IS NULL
+		AND i.id=c.issue_id AND i.project_id=c.project_id AND i.customer_id=c.customer_id`,
+		sqlf.Join(fields, ","), id, issueId, projectId, customerId)
+
+	err := db.WithTransaction(ctx, cs.dbconn, func(tx db.Transaction) error {
+		var author string
 
-	qry := sqlf.Sprintf("UPDATE comments SET %s WHERE id=%s AND customer_id=%s", sqlf.Join(fields, ","), id, customerId)
+		err := tx.QueryRowContext(ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING c.author", qry.Args()...).Scan(&author)
+		if err != nil {
+			// deleted comments can't be edited
+			if err == sql.ErrNoRows {
+				return &CommentNotFoundError{fmt.Sprintf("id %s issueId: %s project_id %s", id, issueId, projectId)}
+			}
+			return err
+		}
+
+		if err := recordMentions(ctx, tx, updatedComment.Content, issueId, &id, customerId, author); err != nil {
+			return err
+		}
 
-	if _, err := cs.dbconn.ExecContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...); err != nil {
+		return recordCommentRevision(ctx, tx, id, customerId)
+	})
+	if err != nil {
+		if _, ok := err.(*CommentNotFoundError); ok {
+			return nil, err
+		}
 		return nil, errors.Wrapf(err, "failed to update issue by id: %s customerId: %s", id, customerId)
 	}
 
@@ -128,13 +235,88 @@ func (cs *CommentsPG) List(ctx context.Context, opt *CommentListOptions, issueId
 	conds = append(conds, sqlf.Sprintf("project_id = %s", projectId))
 	conds = append(conds, sqlf.Sprintf("customer_id = %s", customerId))
 
-	qry := sqlf.Sprintf("WHERE %s ORDER BY id ASC %s", sqlf.Join(conds, "AND"), opt.LimitOffset.SQL())
+	qry := sqlf.Sprintf(commentThreadSQL+"WHERE %s ORDER BY path ASC %s", issueId, projectId, customerId, sqlf.Join(conds, "AND"), opt.LimitOffset.SQL())
+
+	return cs.getBySQL(ctx, qry, customerId)
+}
+
+// Delete delete a comment, comments with replies are replaced by a placeholder. Placeholders left
+// without replies are removed.
+func (cs *CommentsPG) Delete(ctx context.Context, id, issueId, projectId, customerId string) error {
+	var attachmentKeys []string
+
+	err := db.WithTransaction(ctx, cs.dbconn, func(tx db.Transaction) error {
+		var found bool
+
+		err := tx.QueryRowContext(ctx, "SELECT true FROM comments WHERE id=$1 AND issue_id=$2 AND project_id=$3 AND customer_id=$4 AND deleted_at IS NULL FOR UPDATE",
+			id, issueId, projectId, customerId).Scan(&found)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &CommentNotFoundError{fmt.Sprintf("id %s issueId: %s project_id %s", id, issueId, projectId)}
+			}
+			return err
+		}
+
+		// the revisions, reactions, mentions and attachments are removed along with the content
+		if _, err := tx.ExecContext(ctx, "DELETE FROM comment_revisions WHERE comment_id=$1 AND customer_id=$2", id, customerId); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM comment_reactions WHERE comment_id=$1 AND customer_id=$2", id, customerId); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "DELETE FROM mentions WHERE source_id=$1 AND customer_id=$2", id, customerId); err != nil {
+			return err
+		}
+
+		attachmentKeys, err = deleteCommentAttachments(ctx, tx, id, customerId)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE comments SET content='', content_html='', content_html_version=$4, deleted_at=$1, updated_at=$1
+			WHERE id=$2 AND customer_id=$3 AND EXISTS (SELECT 1 FROM comments r WHERE r.parent_id=$2 AND r.customer_id=$3)`, time.Now(), id, customerId, markdown.Version)
+		if err != nil {
+			return err
+		}
+
+		// remove the comment if it has no replies, then walk up the thread removing placeholders left without replies
+		next := sql.NullString{String: id, Valid: true}
+		for next.Valid {
+			err := tx.QueryRowContext(ctx, `DELETE FROM comments c WHERE c.id=$1 AND c.customer_id=$2 AND (c.id=$3 OR c.deleted_at IS NOT NULL)
+				AND NOT EXISTS (SELECT 1 FROM comments r WHERE r.parent_id=c.id AND r.customer_id=c.customer_id) RETURNING c.parent_id`, next.String, customerId, id).Scan(&next)
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*CommentNotFoundError); ok {
+			return err
+		}
+		return errors.Wrapf(err, "failed to delete comment by id: %s issueId: %s customerId: %s", id, issueId, customerId)
+	}
+
+	if len(attachmentKeys) > 0 {
+		blobs, err := blob.New(cs.cfg)
+		if err != nil {
+			return err
+		}
+
+		deleteBlobs(ctx, blobs, attachmentKeys)
+	}
 
-	return cs.getBySQL(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	return nil
 }
 
-func (cs *CommentsPG) getBySQL(ctx context.Context, query string, args ...interface{}) ([]api.Comment, error) {
-	rows, err := cs.dbconn.QueryContext(ctx, "SELECT id, content, created_at, updated_at FROM comments "+query, args...)
+func (cs *CommentsPG) getBySQL(ctx context.Context, qry *sqlf.Query, customerId string) ([]api.Comment, error) {
+	rows, err := cs.dbconn.QueryContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
 	if err != nil {
 		return nil, err
 	}
@@ -143,16 +325,24 @@ func (cs *CommentsPG) getBySQL(ctx context.Context, query string, args ...interf
 	defer rows.Close()
 	for rows.Next() {
 		comment := api.Comment{}
-		err := rows.Scan(&comment.Id, &comment.Content, &comment.CreatedAt, &comment.UpdatedAt)
+		var cached contentHTML
+		err := rows.Scan(&comment.Id, &comment.ParentId, &comment.Depth, &comment.Deleted, &comment.Edited, &comment.Content, &cached.HTML, &cached.Version, &comment.CreatedAt, &comment.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
 
+		html := cached.render(&comment.Content)
+		comment.ContentHtml = &html
+
 		comments = append(comments, comment)
 	}
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
+	if err := loadReactions(ctx, cs.dbconn, comments, customerId); err != nil {
+		return nil, err
+	}
+
 	return comments, nil
 }
@@ -66,3 +66,125 @@ func TestComments_CreateGetUpdate(t *testing.T) {
 	assert.Len(listComment, 1)
 	assert.Equal(newComment, &listComment[0])
 }
+
+func TestComments_UpdateOtherIssue(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	newComment, err := stores.Comments.Create(ctx, &api.NewComment{Content: "test comment"}, testIssueId, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create a comment")
+	}
+
+	otherIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "other issue", Content: "other issue", Labels: []string{}}, testProjectId, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	// a comment can't be edited through the url of another issue
+	_, err = stores.Comments.Update(ctx, &api.UpdatedComment{NewComment: api.NewComment{Content: "moved"}}, newComment.Id, otherIssue.Id, testProjectId, testCustomerId)
+	assert.IsType(&store.CommentNotFoundError{}, err)
+
+	getComment, err := stores.Comments.GetByID(ctx, newComment.Id, testIssueId, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get comment by id")
+	}
+
+	assert.Equal("test comment", getComment.Content)
+}
+
+func TestComments_Threads(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	cstore := store.NewComments(db.Global, cfg)
+
+	first, err := cstore.Create(ctx, &api.NewComment{Content: "first"}, testIssueId, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create a comment")
+	}
+
+	second, err := cstore.Create(ctx, &api.NewComment{Content: "second"}, testIssueId, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create a comment")
+	}
+
+	reply, err := cstore.Create(ctx, &api.NewComment{Content: "reply", ParentId: &first.Id}, testIssueId, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create a reply")
+	}
+
+	assert.Equal(1, reply.Depth)
+
+	nested, err := cstore.Create(ctx, &api.NewComment{Content: "nested", ParentId: &reply.Id}, testIssueId, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create a reply")
+	}
+
+	assert.Equal(2, nested.Depth)
+
+	listComment, err := cstore.List(ctx, store.NewCommentListOptions("", 0, 100), testIssueId, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get comments")
+	}
+
+	assert.Len(listComment, 4)
+	assert.Equal([]string{first.Id, reply.Id, nested.Id, second.Id}, []string{listComment[0].Id, listComment[1].Id, listComment[2].Id, listComment[3].Id})
+
+	// the first comment has replies so it is kept as a placeholder
+	err = cstore.Delete(ctx, first.Id, testIssueId, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to delete comment")
+	}
+
+	getComment, err := cstore.GetByID(ctx, first.Id, testIssueId, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get comment by id")
+	}
+
+	assert.True(getComment.Deleted)
+	assert.Empty(getComment.Content)
+
+	_, err = cstore.Create(ctx, &api.NewComment{Content: "late reply", ParentId: &first.Id}, testIssueId, testProjectId, testCustomerId, testAuthor)
+	assert.IsType(&store.CommentValidationError{}, err)
+
+	_, err = cstore.Update(ctx, &api.UpdatedComment{NewComment: api.NewComment{Content: "edited"}}, first.Id, testIssueId, testProjectId, testCustomerId)
+	assert.IsType(&store.CommentNotFoundError{}, err)
+
+	// removing the last replies also removes the placeholder
+	for _, id := range []string{nested.Id, reply.Id} {
+		err = cstore.Delete(ctx, id, testIssueId, testProjectId, testCustomerId)
+		if err != nil {
+			t.Fatal("failed to delete comment")
+		}
+	}
+
+	listComment, err = cstore.List(ctx, store.NewCommentListOptions("", 0, 100), testIssueId, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get comments")
+	}
+
+	assert.Len(listComment, 1)
+	assert.Equal(second.Id, listComment[0].Id)
+}
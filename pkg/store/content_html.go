@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/markdown"
+)
+
+// contentHTMLTables the tables with content rendered to html.
+var contentHTMLTables = []string{"issues", "comments"}
+
+// contentHTML the html rendered from content as cached in the content_html columns, along with the
+// version of the renderer which produced it.
+type contentHTML struct {
+	HTML    sql.NullString
+	Version sql.NullInt64
+}
+
+// render returns the cached html when it is current, otherwise the content is rendered again. Reads
+// don't write the html back, the ContentHTML store refreshes the cache in the background.
+func (c *contentHTML) render(content *string) string {
+	if c.HTML.Valid && c.Version.Valid && c.Version.Int64 == markdown.Version {
+		return c.HTML.String
+	}
+
+	if content == nil {
+		return ""
+	}
+
+	return markdown.Render(*content)
+}
+
+// ContentHTML provides a store which refreshes the html cached for the content of issues and comments.
+type ContentHTML interface {
+	Refresh(ctx context.Context, limit int) (int, error)
+}
+
+// ContentHTMLPG provides a content html store for postgresql.
+type ContentHTMLPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewContentHTML new content html store.
+func NewContentHTML(dbconn *sql.DB, cfg *conf.Config) ContentHTML {
+	return &ContentHTMLPG{dbconn: dbconn, cfg: cfg}
+}
+
+// Refresh render the content of up to limit issues and comments whose html is missing or was
+// rendered by an older version of the renderer, returning the number of rows refreshed. Rows being
+// refreshed by another replica are skipped.
+func (ch *ContentHTMLPG) Refresh(ctx context.Context, limit int) (int, error) {
+	refreshed := 0
+
+	for _, table := range contentHTMLTables {
+		if refreshed >= limit {
+			break
+		}
+
+		n, err := ch.refreshTable(ctx, table, limit-refreshed)
+		if err != nil {
+			return refreshed, errors.Wrapf(err, "failed to refresh content html of %s", table)
+		}
+
+		refreshed += n
+	}
+
+	return refreshed, nil
+}
+
+func (ch *ContentHTMLPG) refreshTable(ctx context.Context, table string, limit int) (int, error) {
+	type staleRow struct {
+		id         string
+		customerId string
+		content    sql.NullString
+	}
+
+	refreshed := 0
+
+	err := db.WithTransaction(ctx, ch.dbconn, func(tx db.Transaction) error {
+		// the table is always one of contentHTMLTables, versions only increase so older html is below it
+		rows, err := tx.QueryContext(ctx, `SELECT id, customer_id, content FROM `+table+`
+			WHERE content_html_version IS NULL OR content_html_version < $1 LIMIT $2 FOR UPDATE SKIP LOCKED`, markdown.Version, limit)
+		if err != nil {
+			return err
+		}
+
+		stale := []staleRow{}
+		defer rows.Close()
+		for rows.Next() {
+			row := staleRow{}
+			if err := rows.Scan(&row.id, &row.customerId, &row.content); err != nil {
+				return err
+			}
+
+			stale = append(stale, row)
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		for _, row := range stale {
+			html := ""
+			if row.content.Valid {
+				html = markdown.Render(row.content.String)
+			}
+
+			_, err := tx.ExecContext(ctx, "UPDATE "+table+" SET content_html=$1, content_html_version=$2 WHERE id=$3 AND customer_id=$4",
+				html, markdown.Version, row.id, row.customerId)
+			if err != nil {
+				return err
+			}
+		}
+
+		refreshed = len(stale)
+
+		return nil
+	})
+
+	return refreshed, err
+}
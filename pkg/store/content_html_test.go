@@ -0,0 +1,125 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestContentHTML_Issues(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	istore := store.NewIssues(db.Global, cfg)
+
+	newIssue, err := istore.Create(ctx, &api.NewIssue{
+		Subject: "rendered issue",
+		Content: "**bold** <script>alert(1)</script>",
+		Labels:  []string{},
+	}, testProjectId, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	assert.Equal("<p><strong>bold</strong> </p>\n", *newIssue.ContentHtml)
+
+	// the cached html is replaced when the content is updated
+	updatedIssue, err := istore.Update(ctx, &api.UpdatedIssue{
+		NewIssue: api.NewIssue{
+			Subject: "rendered issue",
+			Content: "_updated_",
+			Labels:  []string{},
+		},
+	}, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update issue")
+	}
+
+	assert.Equal("<p><em>updated</em></p>\n", *updatedIssue.ContentHtml)
+
+	// html cached by an older renderer is rendered again when read without writing it back
+	_, err = db.Global.ExecContext(ctx, "UPDATE issues SET content_html='stale', content_html_version=0 WHERE id=$1", newIssue.Id)
+	assert.NoError(err)
+
+	getIssue, err := istore.GetByID(ctx, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue by id")
+	}
+
+	assert.Equal("<p><em>updated</em></p>\n", *getIssue.ContentHtml)
+
+	var cached string
+	err = db.Global.QueryRowContext(ctx, "SELECT content_html FROM issues WHERE id=$1", newIssue.Id).Scan(&cached)
+	assert.NoError(err)
+	assert.Equal("stale", cached)
+
+	// the refresh caches it again
+	refreshed, err := store.NewContentHTML(db.Global, cfg).Refresh(ctx, 1000)
+	if err != nil {
+		t.Fatal("failed to refresh content html")
+	}
+
+	assert.GreaterOrEqual(refreshed, 1)
+
+	err = db.Global.QueryRowContext(ctx, "SELECT content_html FROM issues WHERE id=$1", newIssue.Id).Scan(&cached)
+	assert.NoError(err)
+	assert.Equal("<p><em>updated</em></p>\n", cached)
+}
+
+func TestContentHTML_Comments(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	cstore := store.NewComments(db.Global, cfg)
+
+	newComment, err := cstore.Create(ctx, &api.NewComment{
+		Content: "- [x] done <img src=x onerror=alert(1)>",
+	}, testIssueId, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create a comment")
+	}
+
+	assert.Equal("<ul>\n<li class=\"task-list-item\"><input type=\"checkbox\" checked=\"\" disabled=\"\"> done <img src=\"x\"></li>\n</ul>\n", *newComment.ContentHtml)
+
+	updatedComment, err := cstore.Update(ctx, &api.UpdatedComment{
+		NewComment: api.NewComment{
+			Content: "see API-1",
+		},
+	}, newComment.Id, testIssueId, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update comment by id")
+	}
+
+	assert.Equal("<p>see <a class=\"issue-link\" href=\"/issues/API-1\" rel=\"nofollow noreferrer\">API-1</a></p>\n", *updatedComment.ContentHtml)
+
+	_, err = db.Global.ExecContext(ctx, "UPDATE comments SET content_html=NULL, content_html_version=NULL WHERE id=$1", newComment.Id)
+	assert.NoError(err)
+
+	listComments, err := cstore.List(ctx, nil, testIssueId, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list comments")
+	}
+
+	assert.Len(listComments, 1)
+	assert.Equal(updatedComment.ContentHtml, listComments[0].ContentHtml)
+}
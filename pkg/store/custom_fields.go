@@ -0,0 +1,307 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// ErrCustomFieldNameAlreadyExists custom field name is already taken.
+var ErrCustomFieldNameAlreadyExists = errors.New("custom field name is already taken")
+
+var customFieldNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// customFieldNumberPattern decimal numbers which are cast to numeric when filtering and sorting,
+// hex, underscores, infinity and NaN are accepted by strconv but not by postgres.
+var customFieldNumberPattern = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([eE][+-]?[0-9]+)?$`)
+
+// CustomFieldNotFoundError occurs when a custom field is not found.
+type CustomFieldNotFoundError struct {
+	Message string
+}
+
+func (e *CustomFieldNotFoundError) Error() string {
+	return fmt.Sprintf("custom field not found: %s", e.Message)
+}
+
+// CustomFieldValidationError occurs when a custom field definition is invalid.
+type CustomFieldValidationError struct {
+	Message string
+}
+
+func (e *CustomFieldValidationError) Error() string {
+	return fmt.Sprintf("invalid custom field: %s", e.Message)
+}
+
+// CustomFields provides a custom fields store.
+type CustomFields interface {
+	GetByID(ctx context.Context, id, projectId, customerId string) (*api.CustomField, error)
+	Create(ctx context.Context, newField *api.NewCustomField, projectId, customerId string) (*api.CustomField, error)
+	Update(ctx context.Context, updatedField *api.UpdatedCustomField, id, projectId, customerId string) (*api.CustomField, error)
+	List(ctx context.Context, projectId, customerId string) ([]api.CustomField, error)
+}
+
+// CustomFieldsPG provides a custom fields store for postgresql.
+type CustomFieldsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewCustomFields new custom fields store.
+func NewCustomFields(dbconn *sql.DB, cfg *conf.Config) CustomFields {
+	return &CustomFieldsPG{dbconn: dbconn, cfg: cfg}
+}
+
+// GetByID get custom field by id.
+func (cf *CustomFieldsPG) GetByID(ctx context.Context, id, projectId, customerId string) (*api.CustomField, error) {
+	fields, err := cf.getBySQL(ctx, "WHERE id=$1 AND project_id=$2 AND customer_id=$3 LIMIT 1", id, projectId, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get custom field by id: %s projectId: %s customerId: %s", id, projectId, customerId)
+	}
+
+	if len(fields) == 0 {
+		return nil, &CustomFieldNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
+	}
+
+	return &fields[0], nil
+}
+
+// Create create a custom field.
+func (cf *CustomFieldsPG) Create(ctx context.Context, newField *api.NewCustomField, projectId, customerId string) (*api.CustomField, error) {
+	if err := validateCustomField(newField); err != nil {
+		return nil, err
+	}
+
+	field := api.CustomField{}
+
+	qry := sqlf.Sprintf("INSERT INTO custom_fields(project_id, customer_id, name, description, type, required, default_value, options) VALUES(%s, %s, %s, %s, %s, %s, %s, %s)",
+		projectId, customerId, newField.Name, newField.Description, string(newField.Type), newField.Required, newField.Default, pq.Array(customFieldOptions(newField)))
+
+	err := db.WithTransaction(ctx, cf.dbconn, func(tx db.Transaction) error {
+		return tx.QueryRowContext(
+			ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING id, name, description, type, required, default_value, options, created_at, updated_at", qry.Args()...,
+		).Scan(&field.Id, &field.Name, &field.Description, &field.Type, &field.Required, &field.Default, pq.Array(&field.Options), &field.CreatedAt, &field.UpdatedAt)
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Constraint {
+			case "custom_fields_customer_id_project_id_name_key":
+				return nil, ErrCustomFieldNameAlreadyExists
+			}
+		}
+		return nil, errors.Wrapf(err, "failed to create custom field with name: %s projectId: %s customerId: %s", newField.Name, projectId, customerId)
+	}
+
+	return &field, nil
+}
+
+// Update update a custom field, renaming a field also renames the values stored on issues.
+func (cf *CustomFieldsPG) Update(ctx context.Context, updatedField *api.UpdatedCustomField, id, projectId, customerId string) (*api.CustomField, error) {
+	if err := validateCustomField(&updatedField.NewCustomField); err != nil {
+		return nil, err
+	}
+
+	field, err := cf.GetByID(ctx, id, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	// existing values are validated against the type so it can't be changed
+	if field.Type != string(updatedField.Type) {
+		return nil, &CustomFieldValidationError{fmt.Sprintf("type of %s can't be changed", field.Name)}
+	}
+
+	fields := []*sqlf.Query{sqlf.Sprintf("name=%s, description=%s, required=%s, default_value=%s, options=%s, updated_at=%s",
+		updatedField.Name, updatedField.Description, updatedField.Required, updatedField.Default, pq.Array(customFieldOptions(&updatedField.NewCustomField)), time.Now())}
+
+	qry := sqlf.Sprintf("UPDATE custom_fields SET %s WHERE id=%s AND project_id=%s AND customer_id=%s", sqlf.Join(fields, ","), id, projectId, customerId)
+
+	err = db.WithTransaction(ctx, cf.dbconn, func(tx db.Transaction) error {
+		if _, err := tx.ExecContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...); err != nil {
+			return err
+		}
+
+		if field.Name == updatedField.Name {
+			return nil
+		}
+
+		_, err := tx.ExecContext(ctx, `UPDATE issues SET custom_fields = delete(custom_fields, $1::text) || hstore($2::text, custom_fields -> $1::text)
+			WHERE project_id=$3 AND customer_id=$4 AND custom_fields ? $1::text`, field.Name, updatedField.Name, projectId, customerId)
+		return err
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Constraint {
+			case "custom_fields_customer_id_project_id_name_key":
+				return nil, ErrCustomFieldNameAlreadyExists
+			}
+		}
+		return nil, errors.Wrapf(err, "failed to update custom field by id: %s projectId: %s customerId: %s", id, projectId, customerId)
+	}
+
+	return cf.GetByID(ctx, id, projectId, customerId)
+}
+
+// List list all custom fields in a project.
+func (cf *CustomFieldsPG) List(ctx context.Context, projectId, customerId string) ([]api.CustomField, error) {
+	return cf.getBySQL(ctx, "WHERE project_id=$1 AND customer_id=$2 ORDER BY name ASC", projectId, customerId)
+}
+
+func (cf *CustomFieldsPG) getBySQL(ctx context.Context, query string, args ...interface{}) ([]api.CustomField, error) {
+	rows, err := cf.dbconn.QueryContext(ctx, "SELECT id, name, description, type, required, default_value, options, created_at, updated_at FROM custom_fields "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := []api.CustomField{}
+	defer rows.Close()
+	for rows.Next() {
+		field := api.CustomField{}
+		err := rows.Scan(&field.Id, &field.Name, &field.Description, &field.Type, &field.Required, &field.Default, pq.Array(&field.Options), &field.CreatedAt, &field.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, field)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func customFieldOptions(newField *api.NewCustomField) []string {
+	if newField.Options == nil {
+		return []string{}
+	}
+
+	return *newField.Options
+}
+
+func validateCustomField(newField *api.NewCustomField) error {
+	if !customFieldNamePattern.MatchString(newField.Name) {
+		return &CustomFieldValidationError{fmt.Sprintf("name %q may only contain letters, digits, - and _", newField.Name)}
+	}
+
+	field := api.CustomField{Name: newField.Name, Type: string(newField.Type), Options: customFieldOptions(newField)}
+
+	switch newField.Type {
+	case api.NewCustomFieldTypeEnum:
+		if len(field.Options) == 0 {
+			return &CustomFieldValidationError{fmt.Sprintf("enum %s requires options", newField.Name)}
+		}
+		if err := validateTaxonomyNames("option", field.Options); err != nil {
+			return &CustomFieldValidationError{err.(*TaxonomyValidationError).Message}
+		}
+	case api.NewCustomFieldTypeString, api.NewCustomFieldTypeNumber, api.NewCustomFieldTypeDate, api.NewCustomFieldTypeUser:
+	default:
+		return &CustomFieldValidationError{fmt.Sprintf("unknown type %s", newField.Type)}
+	}
+
+	if newField.Default != nil {
+		if err := validateCustomFieldValue(&field, *newField.Default); err != nil {
+			return &CustomFieldValidationError{fmt.Sprintf("default for %s is not a valid %s", newField.Name, newField.Type)}
+		}
+	}
+
+	return nil
+}
+
+// validateCustomFieldValue check a value is valid for the type of the field.
+func validateCustomFieldValue(field *api.CustomField, value string) error {
+	var err error
+
+	switch api.NewCustomFieldType(field.Type) {
+	case api.NewCustomFieldTypeNumber:
+		if !customFieldNumberPattern.MatchString(value) {
+			err = errors.Errorf("invalid number %s", value)
+			break
+		}
+		// numbers beyond the range of a float are rejected
+		_, err = strconv.ParseFloat(value, 64)
+	case api.NewCustomFieldTypeDate:
+		_, err = time.Parse("2006-01-02", value)
+	case api.NewCustomFieldTypeUser:
+		_, err = uuid.FromString(value)
+	case api.NewCustomFieldTypeEnum:
+		if !containsString(field.Options, value) {
+			err = errors.Errorf("unknown option %s", value)
+		}
+	}
+
+	if err != nil {
+		return &IssueValidationError{fmt.Sprintf("custom field %s is not a valid %s", field.Name, field.Type)}
+	}
+
+	return nil
+}
+
+// applyCustomFields validate custom field values against the fields defined in a project,
+// filling in defaults for missing values.
+func applyCustomFields(fields []api.CustomField, values *api.CustomFieldValues) (map[string]string, error) {
+	res := map[string]string{}
+
+	known := map[string]bool{}
+	for _, field := range fields {
+		known[field.Name] = true
+	}
+
+	if values != nil {
+		for name := range values.AdditionalProperties {
+			if !known[name] {
+				return nil, &IssueValidationError{fmt.Sprintf("unknown custom field %s", name)}
+			}
+		}
+	}
+
+	for i := range fields {
+		field := &fields[i]
+
+		var value string
+		if values != nil {
+			value = values.AdditionalProperties[field.Name]
+		}
+
+		if value == "" && field.Default != nil {
+			value = *field.Default
+		}
+
+		if value == "" {
+			if field.Required {
+				return nil, &IssueValidationError{fmt.Sprintf("custom field %s is required", field.Name)}
+			}
+			continue
+		}
+
+		if err := validateCustomFieldValue(field, value); err != nil {
+			return nil, err
+		}
+
+		res[field.Name] = value
+	}
+
+	return res, nil
+}
+
+// customFieldByName find a field by name.
+func customFieldByName(fields []api.CustomField, name string) (*api.CustomField, bool) {
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i], true
+		}
+	}
+
+	return nil, false
+}
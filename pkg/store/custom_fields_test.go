@@ -0,0 +1,140 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestCustomFields_CreateUpdateFilterSort(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	points, err := stores.CustomFields.Create(ctx, &api.NewCustomField{
+		Name:     "points",
+		Type:     api.NewCustomFieldTypeNumber,
+		Required: true,
+	}, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create custom field")
+	}
+
+	defaultImpact := "low"
+	_, err = stores.CustomFields.Create(ctx, &api.NewCustomField{
+		Name:     "impact",
+		Type:     api.NewCustomFieldTypeEnum,
+		Options:  &[]string{"low", "high"},
+		Default:  &defaultImpact,
+		Required: true,
+	}, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create custom field")
+	}
+
+	_, err = stores.CustomFields.Create(ctx, &api.NewCustomField{Name: "points", Type: api.NewCustomFieldTypeString}, testProjectId, testCustomerId)
+	assert.Equal(store.ErrCustomFieldNameAlreadyExists, err)
+
+	_, err = stores.CustomFields.Create(ctx, &api.NewCustomField{Name: "version", Type: api.NewCustomFieldTypeEnum}, testProjectId, testCustomerId)
+	assert.IsType(&store.CustomFieldValidationError{}, err)
+
+	_, err = stores.Issues.Create(ctx, &api.NewIssue{Subject: "test issue", Labels: []string{}}, testProjectId, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	// numbers are cast to numeric when filtering and sorting so only decimals are accepted
+	for _, p := range []string{"lots", "0x1p4", "Inf", "NaN", "1_000", "1e400", " 1"} {
+		_, err = stores.Issues.Create(ctx, &api.NewIssue{
+			Subject:      "test issue",
+			Labels:       []string{},
+			CustomFields: &api.CustomFieldValues{AdditionalProperties: map[string]string{"points": p}},
+		}, testProjectId, testCustomerId, testReporter)
+		assert.IsType(&store.IssueValidationError{}, err, p)
+	}
+
+	for _, p := range []string{"13", "3", "8"} {
+		newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{
+			Subject:      "test issue " + p,
+			Labels:       []string{},
+			CustomFields: &api.CustomFieldValues{AdditionalProperties: map[string]string{"points": p}},
+		}, testProjectId, testCustomerId, testReporter)
+		if err != nil {
+			t.Fatal("failed to create issue")
+		}
+
+		assert.Equal(map[string]string{"points": p, "impact": "low"}, newIssue.CustomFields.AdditionalProperties)
+	}
+
+	opt := store.NewIssueListOptions("", 0, 100)
+	opt.IssueSortOptions, err = store.NewIssueSortOptions("cf.points")
+	if err != nil {
+		t.Fatal("failed to parse sort")
+	}
+
+	listIssue, err := stores.Issues.List(ctx, opt, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list issues")
+	}
+
+	assert.Len(listIssue, 3)
+	assert.Equal("test issue 3", listIssue[0].Subject)
+	assert.Equal("test issue 8", listIssue[1].Subject)
+	assert.Equal("test issue 13", listIssue[2].Subject)
+
+	// renaming the field also renames the stored values
+	_, err = stores.CustomFields.Update(ctx, &api.UpdatedCustomField{
+		NewCustomField: api.NewCustomField{Name: "story_points", Type: api.NewCustomFieldTypeNumber, Required: true},
+	}, points.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update custom field")
+	}
+
+	opt = store.NewIssueListOptions("", 0, 100)
+	opt.IssueFilterOptions, err = store.NewIssueFilterOptions([]string{"cf.story_points:8", "cf.impact:low"})
+	if err != nil {
+		t.Fatal("failed to parse filter")
+	}
+
+	listIssue, err = stores.Issues.List(ctx, opt, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list issues")
+	}
+
+	assert.Len(listIssue, 1)
+	assert.Equal("test issue 8", listIssue[0].Subject)
+
+	opt.IssueFilterOptions, err = store.NewIssueFilterOptions([]string{"cf.points:8"})
+	if err != nil {
+		t.Fatal("failed to parse filter")
+	}
+
+	_, err = stores.Issues.List(ctx, opt, testProjectId, testCustomerId)
+	assert.IsType(&store.FilterError{}, err)
+}
+
+func TestCustomFields_NumberDefaults(t *testing.T) {
+	assert := require.New(t)
+
+	cfstore := store.NewCustomFields(nil, &conf.Config{})
+
+	for _, value := range []string{"", "lots", "0x1p4", "Inf", "-Infinity", "NaN", "1_000", "1e400", "1.2.3", "e5", "+"} {
+		_, err := cfstore.Create(context.Background(), &api.NewCustomField{Name: "points", Type: api.NewCustomFieldTypeNumber, Default: &value}, testProjectId, testCustomerId)
+		assert.IsType(&store.CustomFieldValidationError{}, err, value)
+	}
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// The kinds of reminder sent for the due date of an issue.
+const (
+	ReminderDueSoon = "due_soon"
+	ReminderOverdue = "overdue"
+)
+
+// reminderBatchSize the most reminders recorded in one transaction.
+const reminderBatchSize = 100
+
+// maxDueAt how far in the future an issue can be due, later dates are most likely typos.
+const maxDueAt = 10 * 365 * 24 * time.Hour
+
+// DueReminders provides a store for reminders about the due date of issues.
+type DueReminders interface {
+	Sweep(ctx context.Context, now time.Time, window time.Duration) (int, error)
+}
+
+// DueRemindersPG provides a due reminders store for postgresql.
+type DueRemindersPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewDueReminders new due reminders store.
+func NewDueReminders(dbconn *sql.DB, cfg *conf.Config) DueReminders {
+	return &DueRemindersPG{dbconn: dbconn, cfg: cfg}
+}
+
+// Sweep notify the assignee and watchers of open issues which are due within the window or
+// overdue, each kind of reminder is sent once for each due date. Reminders are claimed by
+// inserting them, so when sweeps run at the same time on several replicas the later inserts
+// wait for the first and then skip the reminders it recorded. This returns the number sent.
+func (dr *DueRemindersPG) Sweep(ctx context.Context, now time.Time, window time.Duration) (int, error) {
+	sent := 0
+
+	for {
+		n, err := dr.sweepBatch(ctx, now, window)
+		if err != nil {
+			return sent, errors.Wrap(err, "failed to sweep due reminders")
+		}
+
+		sent += n
+		if n < reminderBatchSize {
+			return sent, nil
+		}
+	}
+}
+
+func (dr *DueRemindersPG) sweepBatch(ctx context.Context, now time.Time, window time.Duration) (int, error) {
+	type reminder struct {
+		customerId, issueId, kind string
+		dueAt                     time.Time
+	}
+
+	reminders := []reminder{}
+
+	err := db.WithTransaction(ctx, dr.dbconn, func(tx db.Transaction) error {
+		rows, err := tx.QueryContext(ctx, `WITH due AS (
+				SELECT i.customer_id, i.id, i.due_at, CASE WHEN i.due_at <= $1 THEN $2 ELSE $3 END AS kind
+				FROM issues i
+				WHERE i.due_at IS NOT NULL AND i.due_at <= $4 AND i.state <> ALL($5)
+			)
+			INSERT INTO issue_due_reminders(customer_id, issue_id, kind, due_at)
+			SELECT d.customer_id, d.id, d.kind, d.due_at FROM due d
+			WHERE NOT EXISTS (
+				SELECT 1 FROM issue_due_reminders r
+				WHERE r.customer_id = d.customer_id AND r.issue_id = d.id AND r.kind = d.kind AND r.due_at = d.due_at
+			)
+			ORDER BY d.due_at
+			LIMIT $6
+			ON CONFLICT DO NOTHING
+			RETURNING customer_id, issue_id, kind, due_at`,
+			now, ReminderOverdue, ReminderDueSoon, now.Add(window), pq.Array(doneStates), reminderBatchSize)
+		if err != nil {
+			return err
+		}
+
+		defer rows.Close()
+		for rows.Next() {
+			var r reminder
+			if err := rows.Scan(&r.customerId, &r.issueId, &r.kind, &r.dueAt); err != nil {
+				return err
+			}
+			reminders = append(reminders, r)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		for _, r := range reminders {
+			if err := notifyDue(ctx, tx, r.issueId, r.customerId, r.kind, r.dueAt); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(reminders), nil
+}
+
+// notifyDue records a due notification for the assignee and watchers of the issue.
+func notifyDue(ctx context.Context, tx db.Transaction, issueId, customerId, kind string, dueAt time.Time) error {
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(notificationInsertSQL, `SELECT user_id FROM issue_watchers WHERE customer_id = $1 AND issue_id = $3
+			UNION SELECT assignee::text FROM issues WHERE customer_id = $1 AND id = $3 AND assignee IS NOT NULL`),
+		customerId, api.NotificationTypeDue, issueId, nil, "", toHstore(map[string]string{"reminder": kind, "due_at": dueAt.UTC().Format(time.RFC3339)}))
+	return err
+}
+
+// parseDueAt parse the due date of an issue.
+func parseDueAt(value string) (time.Time, error) {
+	dueAt, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, &IssueValidationError{fmt.Sprintf("due_at %q must be an RFC 3339 timestamp", value)}
+	}
+
+	return dueAt, nil
+}
+
+// validateDueAt check a new due date is in the future.
+func validateDueAt(dueAt, now time.Time) error {
+	if !dueAt.After(now) {
+		return &IssueValidationError{fmt.Sprintf("due_at %s must be in the future", dueAt.Format(time.RFC3339))}
+	}
+
+	if dueAt.After(now.Add(maxDueAt)) {
+		return &IssueValidationError{fmt.Sprintf("due_at %s is too far in the future", dueAt.Format(time.RFC3339))}
+	}
+
+	return nil
+}
+
+// checkDueAtChange validate the due date of an issue when it changes, the issue is locked so
+// the current due date can't change before the update.
+func checkDueAtChange(ctx context.Context, tx db.Transaction, dueAt time.Time, id, customerId string) error {
+	var current *time.Time
+
+	err := tx.QueryRowContext(ctx, "SELECT due_at FROM issues WHERE id=$1 AND customer_id=$2 FOR UPDATE", id, customerId).Scan(&current)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+
+	if current != nil && current.Equal(dueAt) {
+		return nil
+	}
+
+	return validateDueAt(dueAt, time.Now())
+}
@@ -0,0 +1,99 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestDueReminders_Sweep(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "due", Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	_, err = stores.Issues.Create(ctx, &api.NewIssue{Subject: "late", Labels: []string{}, DueAt: strPtr("2020-01-01T00:00:00Z")}, proj.Id, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	_, err = stores.Issues.Create(ctx, &api.NewIssue{Subject: "late", Labels: []string{}, DueAt: strPtr("tomorrow")}, proj.Id, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	dueAt := time.Now().Add(2 * time.Hour).UTC().Truncate(time.Second)
+
+	issue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "release", Labels: []string{}, DueAt: strPtr(dueAt.Format(time.RFC3339))}, proj.Id, testCustomerId, testReporter)
+	assert.NoError(err)
+	assert.True(dueAt.Equal(*issue.DueAt))
+
+	_, err = stores.Issues.Create(ctx, &api.NewIssue{Subject: "someday", Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+	assert.NoError(err)
+
+	watcher := "watcher-" + issue.Id
+	assert.NoError(stores.Watchers.Watch(ctx, issue.Id, proj.Id, testCustomerId, watcher))
+
+	before := dueAt.Add(time.Hour)
+	opt := store.NewIssueListOptions("", 0, 10)
+	opt.IssueFilterOptions = &store.IssueFilterOptions{DueBefore: &before}
+
+	issues, err := stores.Issues.List(ctx, opt, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Len(issues, 1)
+	assert.Equal(issue.Id, issues[0].Id)
+
+	opt.IssueFilterOptions = &store.IssueFilterOptions{Overdue: true}
+
+	issues, err = stores.Issues.List(ctx, opt, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Empty(issues)
+
+	// the due soon reminder is only sent once for the due date, even when sweeps overlap
+	sent, err := stores.DueReminders.Sweep(ctx, time.Now(), 24*time.Hour)
+	assert.NoError(err)
+	assert.GreaterOrEqual(sent, 1)
+
+	_, err = stores.DueReminders.Sweep(ctx, time.Now(), 24*time.Hour)
+	assert.NoError(err)
+
+	// once it is overdue the overdue reminder is sent
+	_, err = stores.DueReminders.Sweep(ctx, dueAt.Add(time.Minute), 24*time.Hour)
+	assert.NoError(err)
+
+	notifications, err := stores.Notifications.List(ctx, &store.NotificationListOptions{LimitOffset: &store.LimitOffset{Limit: 10}}, testCustomerId, watcher)
+	assert.NoError(err)
+	assert.Len(notifications, 2)
+	assert.Equal(api.NotificationTypeDue, notifications[0].Type)
+	assert.Equal(store.ReminderOverdue, notifications[0].Details.AdditionalProperties["reminder"])
+	assert.Equal(store.ReminderDueSoon, notifications[1].Details.AdditionalProperties["reminder"])
+
+	// the due date is left as is when it isn't provided, a new one must be in the future
+	issue, err = stores.Issues.Update(ctx, &api.UpdatedIssue{NewIssue: api.NewIssue{Subject: "release v2", Labels: []string{}}, Version: 1}, issue.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.True(dueAt.Equal(*issue.DueAt))
+
+	_, err = stores.Issues.Update(ctx, &api.UpdatedIssue{NewIssue: api.NewIssue{Subject: "release v2", Labels: []string{}, DueAt: strPtr("2020-01-01T00:00:00Z")}, Version: 1}, issue.Id, proj.Id, testCustomerId)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	issue, err = stores.Issues.Update(ctx, &api.UpdatedIssue{NewIssue: api.NewIssue{Subject: "release v2", Labels: []string{}, DueAt: strPtr("")}, Version: 1}, issue.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Nil(issue.DueAt)
+}
@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+)
+
+// outboxUndeliverable the error recorded for emails which can no longer be sent.
+const outboxUndeliverable = "the recipient, notification or issue no longer exists"
+
+// OutboxEmail a notification waiting to be emailed along with the recipient and issue.
+type OutboxEmail struct {
+	ID           string
+	CustomerID   string
+	UserID       string
+	Email        string
+	Name         string
+	Mode         api.NotificationPreferencesEmail
+	Attempts     int
+	IssueKey     *string
+	IssueSubject string
+	Notification api.Notification
+}
+
+// Outbox provides a store for the notifications waiting to be emailed.
+type Outbox interface {
+	Claim(ctx context.Context, limit int, lease time.Duration, digestCutoff time.Time) ([]OutboxEmail, error)
+	MarkSent(ctx context.Context, ids []string) error
+	MarkFailed(ctx context.Context, ids []string, reason string, retryAt time.Time, maxAttempts int) error
+}
+
+// OutboxPG provides an outbox store for postgresql.
+type OutboxPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewOutbox new outbox store.
+func NewOutbox(dbconn *sql.DB, cfg *conf.Config) Outbox {
+	return &OutboxPG{dbconn: dbconn, cfg: cfg}
+}
+
+// Claim lease pending emails which are due, immediate emails are due straight away and daily
+// emails once they were queued before the digest cutoff. Leased emails aren't claimed again
+// until the lease expires, so an email is retried if the sender stops before marking it. Emails
+// whose recipient, notification or issue has since been deleted can't be sent, so they are
+// marked failed when they are claimed rather than being returned.
+func (ob *OutboxPG) Claim(ctx context.Context, limit int, lease time.Duration, digestCutoff time.Time) ([]OutboxEmail, error) {
+	rows, err := ob.dbconn.QueryContext(ctx, `WITH c AS (
+			UPDATE email_outbox o SET next_attempt_at = now() + $2 * interval '1 second', attempts = o.attempts + 1,
+				status = CASE WHEN t.deliverable THEN o.status ELSE 'failed' END,
+				last_error = CASE WHEN t.deliverable THEN o.last_error ELSE $4 END
+			FROM (
+				SELECT e.id, (n.id IS NOT NULL AND i.id IS NOT NULL AND u.id IS NOT NULL) AS deliverable
+				FROM email_outbox e
+				LEFT JOIN notifications n ON n.id = e.notification_id AND n.customer_id = e.customer_id
+				LEFT JOIN issues i ON i.id = n.issue_id AND i.customer_id = n.customer_id
+				LEFT JOIN users u ON u.id::text = e.user_id AND u.customer_id = e.customer_id
+				WHERE e.status = 'pending' AND e.next_attempt_at <= now() AND (e.mode = 'immediate' OR e.created_at < $3)
+				ORDER BY e.created_at ASC LIMIT $1 FOR UPDATE OF e SKIP LOCKED
+			) t
+			WHERE o.id = t.id
+			RETURNING o.id, o.customer_id, o.notification_id, o.user_id, o.mode, o.attempts, t.deliverable
+		)
+		SELECT c.id, c.customer_id, c.user_id, u.email, u.name, c.mode, c.attempts, i.key, i.subject,
+			n.id, n.type, i.project_id, n.issue_id, n.comment_id, n.actor, n.details, n.created_at
+		FROM c
+		JOIN notifications n ON n.id = c.notification_id AND n.customer_id = c.customer_id
+		JOIN issues i ON i.id = n.issue_id AND i.customer_id = n.customer_id
+		JOIN users u ON u.id::text = c.user_id AND u.customer_id = c.customer_id
+		WHERE c.deliverable
+		ORDER BY c.customer_id, c.user_id, n.created_at ASC`, limit, lease.Seconds(), digestCutoff, outboxUndeliverable)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim emails from the outbox")
+	}
+
+	emails := []OutboxEmail{}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			email            OutboxEmail
+			commentId, actor sql.NullString
+			details          = hstore.Hstore{}
+		)
+
+		err := rows.Scan(&email.ID, &email.CustomerID, &email.UserID, &email.Email, &email.Name, &email.Mode, &email.Attempts, &email.IssueKey, &email.IssueSubject,
+			&email.Notification.Id, &email.Notification.Type, &email.Notification.ProjectId, &email.Notification.IssueId, &commentId, &actor, &details, &email.Notification.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if commentId.Valid {
+			email.Notification.CommentId = &commentId.String
+		}
+
+		if actor.Valid {
+			email.Notification.Actor = &actor.String
+		}
+
+		email.Notification.Details = api.NotificationDetails{}
+		for k, v := range details.Map {
+			email.Notification.Details.Set(k, v.String)
+		}
+
+		emails = append(emails, email)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return emails, nil
+}
+
+// MarkSent mark emails as sent.
+func (ob *OutboxPG) MarkSent(ctx context.Context, ids []string) error {
+	_, err := ob.dbconn.ExecContext(ctx, "UPDATE email_outbox SET status='sent', sent_at=now(), last_error=NULL WHERE id = ANY($1::uuid[])", pq.Array(ids))
+	if err != nil {
+		return errors.Wrap(err, "failed to mark emails sent")
+	}
+
+	return nil
+}
+
+// MarkFailed record a failed attempt to send emails, they are retried after retryAt until they
+// have been attempted maxAttempts times.
+func (ob *OutboxPG) MarkFailed(ctx context.Context, ids []string, reason string, retryAt time.Time, maxAttempts int) error {
+	_, err := ob.dbconn.ExecContext(ctx, `UPDATE email_outbox SET last_error=$2, next_attempt_at=$3,
+		status = CASE WHEN attempts >= $4 THEN 'failed' ELSE 'pending' END WHERE id = ANY($1::uuid[])`, pq.Array(ids), reason, retryAt, maxAttempts)
+	if err != nil {
+		return errors.Wrap(err, "failed to mark emails failed")
+	}
+
+	return nil
+}
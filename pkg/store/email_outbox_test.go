@@ -0,0 +1,54 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestOutbox_ClaimUndeliverable(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	// the notification and user of this email don't exist, as if they were deleted
+	var id string
+
+	err = db.Global.QueryRowContext(ctx, "INSERT INTO email_outbox(customer_id, notification_id, user_id, mode) VALUES($1, $2, $3, 'immediate') RETURNING id",
+		testCustomerId, uuid.NewV4().String(), uuid.NewV4().String()).Scan(&id)
+	if err != nil {
+		t.Fatal("failed to queue email")
+	}
+
+	emails, err := stores.Outbox.Claim(ctx, 1000, time.Minute, time.Now())
+	assert.NoError(err)
+
+	for _, email := range emails {
+		assert.NotEqual(id, email.ID)
+	}
+
+	var status string
+	var lastError *string
+
+	err = db.Global.QueryRowContext(ctx, "SELECT status, last_error FROM email_outbox WHERE id=$1", id).Scan(&status, &lastError)
+	assert.NoError(err)
+	assert.Equal("failed", status)
+	assert.NotNil(lastError)
+}
@@ -0,0 +1,367 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/blob"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// The kinds of record streamed by an export, in the order they are streamed.
+const (
+	ExportKindProject    = "project"
+	ExportKindLabel      = "label"
+	ExportKindIssue      = "issue"
+	ExportKindComment    = "comment"
+	ExportKindAttachment = "attachment"
+)
+
+// ExportKinds the kinds of record in the order they are streamed.
+var ExportKinds = []string{ExportKindProject, ExportKindLabel, ExportKindIssue, ExportKindComment, ExportKindAttachment}
+
+// ExportArchiveNotFoundError occurs when the archive of an export is not found.
+type ExportArchiveNotFoundError struct {
+	Message string
+}
+
+func (e *ExportArchiveNotFoundError) Error() string {
+	return fmt.Sprintf("export archive not found: %s", e.Message)
+}
+
+// ExportProject a project as it is exported.
+type ExportProject struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	Key         *string   `json:"key"`
+	Severities  []string  `json:"severities"`
+	Categories  []string  `json:"categories"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ExportLabel a label of the customer, or of a project when the project id is set.
+type ExportLabel struct {
+	ProjectID *string `json:"project_id"`
+	Name      string  `json:"name"`
+}
+
+// ExportIssue an issue as it is exported.
+type ExportIssue struct {
+	ID           string            `json:"id"`
+	ProjectID    string            `json:"project_id"`
+	Key          *string           `json:"key"`
+	ParentID     *string           `json:"parent_id"`
+	Reporter     string            `json:"reporter"`
+	Assignee     *string           `json:"assignee"`
+	Subject      string            `json:"subject"`
+	Content      *string           `json:"content"`
+	State        string            `json:"state"`
+	Severity     *string           `json:"severity"`
+	Category     *string           `json:"category"`
+	Labels       []string          `json:"labels"`
+	CustomFields map[string]string `json:"custom_fields"`
+	Votes        int               `json:"votes"`
+	CreatedAt    time.Time         `json:"created_at"`
+	UpdatedAt    time.Time         `json:"updated_at"`
+}
+
+// ExportComment a comment as it is exported, deleted comments are kept so replies keep their parent.
+type ExportComment struct {
+	ID        string     `json:"id"`
+	ProjectID string     `json:"project_id"`
+	IssueID   string     `json:"issue_id"`
+	ParentID  *string    `json:"parent_id"`
+	Author    string     `json:"author"`
+	Content   *string    `json:"content"`
+	DeletedAt *time.Time `json:"deleted_at"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+// ExportAttachment the metadata of an attachment, the content isn't exported.
+type ExportAttachment struct {
+	ID          string    `json:"id"`
+	ProjectID   string    `json:"project_id"`
+	IssueID     string    `json:"issue_id"`
+	CommentID   *string   `json:"comment_id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Sha256      string    `json:"sha256"`
+	UploadedBy  string    `json:"uploaded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ExportOptions specifies what is exported, all the projects of the customer are exported unless
+// a project id is given.
+type ExportOptions struct {
+	CustomerID string
+	ProjectID  string
+}
+
+// ExportFunc is called with each record streamed by an export, the record is one of the Export
+// types matching the kind.
+type ExportFunc func(kind string, record interface{}) error
+
+// Exports provides a store which streams the data of a customer for export.
+type Exports interface {
+	Stream(ctx context.Context, opt *ExportOptions, fn ExportFunc) error
+	SaveArchive(ctx context.Context, id, customerId string, r io.Reader, size int64) error
+	OpenArchive(ctx context.Context, id, customerId string) (io.ReadCloser, error)
+}
+
+// ExportsPG provides an exports store for postgresql.
+type ExportsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewExports new exports store.
+func NewExports(dbconn *sql.DB, cfg *conf.Config) Exports {
+	return &ExportsPG{dbconn: dbconn, cfg: cfg}
+}
+
+// Stream read the records of the export one at a time, each kind of record is read in creation
+// order. All the records are read in one read only transaction so they are consistent with each
+// other even while the data is being changed.
+func (es *ExportsPG) Stream(ctx context.Context, opt *ExportOptions, fn ExportFunc) error {
+	err := db.WithTransaction(ctx, es.dbconn, func(tx db.Transaction) error {
+		if _, err := tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY"); err != nil {
+			return err
+		}
+
+		if opt.ProjectID != "" {
+			var exists bool
+			err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM projects WHERE id=$1 AND customer_id=$2)", opt.ProjectID, opt.CustomerID).Scan(&exists)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return &ProjectNotFoundError{fmt.Sprintf("id %s", opt.ProjectID)}
+			}
+		}
+
+		for _, stream := range []func(context.Context, queryer, *ExportOptions, ExportFunc) error{
+			streamProjects, streamLabels, streamIssues, streamComments, streamAttachments,
+		} {
+			if err := stream(ctx, tx, opt, fn); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if _, ok := err.(*ProjectNotFoundError); ok {
+			return err
+		}
+		return errors.Wrapf(err, "failed to export customerId: %s projectId: %s", opt.CustomerID, opt.ProjectID)
+	}
+
+	return nil
+}
+
+// exportArchiveKey the blob key of the archive written by an export.
+func exportArchiveKey(id, customerId string) string {
+	return path.Join(customerId, "exports", id+".ndjson.gz")
+}
+
+// SaveArchive store the archive written by an export.
+func (es *ExportsPG) SaveArchive(ctx context.Context, id, customerId string, r io.Reader, size int64) error {
+	blobs, err := blob.New(es.cfg)
+	if err != nil {
+		return err
+	}
+
+	err = blobs.Put(ctx, exportArchiveKey(id, customerId), r, size, "application/gzip")
+	if err != nil {
+		return errors.Wrapf(err, "failed to store export archive id: %s customerId: %s", id, customerId)
+	}
+
+	return nil
+}
+
+// OpenArchive open the archive written by an export, the caller closes the reader.
+func (es *ExportsPG) OpenArchive(ctx context.Context, id, customerId string) (io.ReadCloser, error) {
+	blobs, err := blob.New(es.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	rc, err := blobs.Get(ctx, exportArchiveKey(id, customerId))
+	if err != nil {
+		if err == blob.ErrNotFound {
+			return nil, &ExportArchiveNotFoundError{fmt.Sprintf("id %s", id)}
+		}
+		return nil, errors.Wrapf(err, "failed to open export archive id: %s customerId: %s", id, customerId)
+	}
+
+	return rc, nil
+}
+
+// exportQuery runs the query for a kind of record limited to the project when one is given, the
+// project condition is appended so the query must end with a where clause.
+func exportQuery(ctx context.Context, q queryer, opt *ExportOptions, query, projectCond, order string) (*sql.Rows, error) {
+	args := []interface{}{opt.CustomerID}
+	if opt.ProjectID != "" {
+		query += " AND " + projectCond + "=$2"
+		args = append(args, opt.ProjectID)
+	}
+
+	return q.QueryContext(ctx, query+" ORDER BY "+order, args...)
+}
+
+func streamProjects(ctx context.Context, q queryer, opt *ExportOptions, fn ExportFunc) error {
+	rows, err := exportQuery(ctx, q, opt, `SELECT id, name, description, key, severities, categories, created_at, updated_at
+		FROM projects WHERE customer_id=$1`, "id", "created_at ASC, id ASC")
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		proj := &ExportProject{}
+		err := rows.Scan(&proj.ID, &proj.Name, &proj.Description, &proj.Key, pq.Array(&proj.Severities), pq.Array(&proj.Categories), &proj.CreatedAt, &proj.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(ExportKindProject, proj); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func streamLabels(ctx context.Context, q queryer, opt *ExportOptions, fn ExportFunc) error {
+	// the labels of the customer are only exported along with all of its projects
+	if opt.ProjectID == "" {
+		rows, err := q.QueryContext(ctx, "SELECT unnest(labels) FROM customers WHERE id=$1", opt.CustomerID)
+		if err != nil {
+			return err
+		}
+
+		defer rows.Close()
+		for rows.Next() {
+			label := &ExportLabel{}
+			if err := rows.Scan(&label.Name); err != nil {
+				return err
+			}
+
+			if err := fn(ExportKindLabel, label); err != nil {
+				return err
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+	}
+
+	rows, err := exportQuery(ctx, q, opt, "SELECT id, unnest(labels) FROM projects WHERE customer_id=$1", "id", "created_at ASC, id ASC")
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var projectId string
+		label := &ExportLabel{ProjectID: &projectId}
+		if err := rows.Scan(&projectId, &label.Name); err != nil {
+			return err
+		}
+
+		if err := fn(ExportKindLabel, label); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func streamIssues(ctx context.Context, q queryer, opt *ExportOptions, fn ExportFunc) error {
+	rows, err := exportQuery(ctx, q, opt, `SELECT id, project_id, key, parent_id, reporter, assignee, subject, content, state,
+		severity, category, labels, custom_fields, votes, created_at, updated_at FROM issues WHERE customer_id=$1`, "project_id", "created_at ASC, id ASC")
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		issue := &ExportIssue{}
+		customFields := hstore.Hstore{}
+		err := rows.Scan(&issue.ID, &issue.ProjectID, &issue.Key, &issue.ParentID, &issue.Reporter, &issue.Assignee, &issue.Subject, &issue.Content, &issue.State,
+			&issue.Severity, &issue.Category, pq.Array(&issue.Labels), &customFields, &issue.Votes, &issue.CreatedAt, &issue.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		issue.CustomFields = map[string]string{}
+		for k, v := range customFields.Map {
+			issue.CustomFields[k] = v.String
+		}
+
+		if err := fn(ExportKindIssue, issue); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func streamComments(ctx context.Context, q queryer, opt *ExportOptions, fn ExportFunc) error {
+	rows, err := exportQuery(ctx, q, opt, `SELECT id, project_id, issue_id, parent_id, author, content, deleted_at, created_at, updated_at
+		FROM comments WHERE customer_id=$1`, "project_id", "created_at ASC, id ASC")
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		comment := &ExportComment{}
+		err := rows.Scan(&comment.ID, &comment.ProjectID, &comment.IssueID, &comment.ParentID, &comment.Author, &comment.Content, &comment.DeletedAt, &comment.CreatedAt, &comment.UpdatedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(ExportKindComment, comment); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func streamAttachments(ctx context.Context, q queryer, opt *ExportOptions, fn ExportFunc) error {
+	rows, err := exportQuery(ctx, q, opt, `SELECT a.id, i.project_id, a.issue_id, a.comment_id, a.filename, a.content_type, a.size, a.sha256, a.uploaded_by, a.created_at
+		FROM attachments a JOIN issues i ON i.id = a.issue_id AND i.customer_id = a.customer_id WHERE a.customer_id=$1`, "i.project_id", "a.created_at ASC, a.id ASC")
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		attachment := &ExportAttachment{}
+		err := rows.Scan(&attachment.ID, &attachment.ProjectID, &attachment.IssueID, &attachment.CommentID, &attachment.Filename, &attachment.ContentType,
+			&attachment.Size, &attachment.Sha256, &attachment.UploadedBy, &attachment.CreatedAt)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(ExportKindAttachment, attachment); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,92 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestExports_Stream(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "exports", Labels: []string{"bug", "feature"}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	issue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "exported", Labels: []string{"bug"}}, proj.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	comment, err := stores.Comments.Create(ctx, &api.NewComment{Content: "first"}, issue.Id, proj.Id, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	_, err = stores.Comments.Create(ctx, &api.NewComment{Content: "reply", ParentId: &comment.Id}, issue.Id, proj.Id, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	err = stores.Comments.Delete(ctx, comment.Id, issue.Id, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to delete comment")
+	}
+
+	kinds := []string{}
+	labels := []string{}
+	comments := []*store.ExportComment{}
+
+	err = stores.Exports.Stream(ctx, &store.ExportOptions{CustomerID: testCustomerId, ProjectID: proj.Id}, func(kind string, record interface{}) error {
+		kinds = append(kinds, kind)
+
+		switch rec := record.(type) {
+		case *store.ExportProject:
+			assert.Equal(proj.Id, rec.ID)
+		case *store.ExportLabel:
+			assert.Equal(proj.Id, *rec.ProjectID)
+			labels = append(labels, rec.Name)
+		case *store.ExportIssue:
+			assert.Equal(issue.Id, rec.ID)
+			assert.Equal(testReporter, rec.Reporter)
+			assert.Equal([]string{"bug"}, rec.Labels)
+		case *store.ExportComment:
+			comments = append(comments, rec)
+		}
+
+		return nil
+	})
+	assert.NoError(err)
+
+	assert.Equal([]string{"project", "label", "label", "issue", "comment", "comment"}, kinds)
+	assert.Equal([]string{"bug", "feature"}, labels)
+
+	// the deleted comment is kept so the reply keeps its parent
+	assert.Equal(comment.Id, comments[0].ID)
+	assert.NotNil(comments[0].DeletedAt)
+	assert.Equal(comment.Id, *comments[1].ParentID)
+
+	err = stores.Exports.Stream(ctx, &store.ExportOptions{CustomerID: testCustomerId, ProjectID: "a1d6c2a0-5f6b-4bb3-a8c1-3f8f5d6a0c11"}, func(kind string, record interface{}) error {
+		return nil
+	})
+	assert.IsType(&store.ProjectNotFoundError{}, err)
+}
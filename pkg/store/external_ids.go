@@ -0,0 +1,163 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// ErrExternalIDAlreadyImported the record was already created by this or another import.
+var ErrExternalIDAlreadyImported = errors.New("external id has already been imported")
+
+// The kinds of record which are tracked by external id.
+const (
+	ExternalKindIssue   = "issue"
+	ExternalKindComment = "comment"
+)
+
+// ExternalID identifies a record in the source it was imported from.
+type ExternalID struct {
+	ProjectID  string
+	Source     string
+	Kind       string
+	ExternalID string
+}
+
+// ExternalIDs provides a store which creates the records of imports along with their id in the
+// source they were imported from.
+type ExternalIDs interface {
+	Lookup(ctx context.Context, projectId, source, kind string, externalIds []string, customerId string) (map[string]string, error)
+	CreateIssue(ctx context.Context, ext *ExternalID, newIssue *api.NewIssue, customerId, reporter string) (*api.Issue, error)
+	CreateComment(ctx context.Context, ext *ExternalID, newComment *api.NewComment, issueId, customerId, author string) (string, error)
+}
+
+// ExternalIDsPG provides an external ids store for postgresql.
+type ExternalIDsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+	issues *IssuesPG
+}
+
+// NewExternalIDs new external ids store.
+func NewExternalIDs(dbconn *sql.DB, cfg *conf.Config) ExternalIDs {
+	return &ExternalIDsPG{dbconn: dbconn, cfg: cfg, issues: &IssuesPG{dbconn: dbconn, cfg: cfg, fields: &CustomFieldsPG{dbconn: dbconn, cfg: cfg}}}
+}
+
+// Lookup the ids of the records already imported for the external ids.
+func (es *ExternalIDsPG) Lookup(ctx context.Context, projectId, source, kind string, externalIds []string, customerId string) (map[string]string, error) {
+	rows, err := es.dbconn.QueryContext(ctx, `SELECT external_id, entity_id FROM import_external_ids
+		WHERE customer_id=$1 AND project_id=$2 AND source=$3 AND kind=$4 AND external_id = ANY($5)`, customerId, projectId, source, kind, pq.Array(externalIds))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to lookup external ids for projectId: %s customerId: %s", projectId, customerId)
+	}
+
+	ids := map[string]string{}
+	defer rows.Close()
+	for rows.Next() {
+		var externalId, entityId string
+		if err := rows.Scan(&externalId, &entityId); err != nil {
+			return nil, err
+		}
+
+		ids[externalId] = entityId
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// CreateIssue create an issue and record its external id in the same transaction, this returns
+// ErrExternalIDAlreadyImported if the external id was already imported.
+func (es *ExternalIDsPG) CreateIssue(ctx context.Context, ext *ExternalID, newIssue *api.NewIssue, customerId, reporter string) (*api.Issue, error) {
+	ins, err := es.issues.prepareInsert(ctx, newIssue, ext.ProjectID, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := api.Issue{}
+
+	err = db.WithTransaction(ctx, es.dbconn, func(tx db.Transaction) error {
+		if err := claimExternalID(ctx, tx, ext, customerId); err != nil {
+			return err
+		}
+
+		if err := ins.insert(ctx, tx, reporter, &issue); err != nil {
+			return err
+		}
+
+		return completeExternalID(ctx, tx, ext, issue.Id, customerId)
+	})
+	if err != nil {
+		if err == ErrExternalIDAlreadyImported {
+			return nil, err
+		}
+		if _, ok := err.(*IssueValidationError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to import issue with external id: %s customerId: %s", ext.ExternalID, customerId)
+	}
+
+	return &issue, nil
+}
+
+// CreateComment create a comment and record its external id in the same transaction, this returns
+// the id of the comment or ErrExternalIDAlreadyImported if the external id was already imported.
+func (es *ExternalIDsPG) CreateComment(ctx context.Context, ext *ExternalID, newComment *api.NewComment, issueId, customerId, author string) (string, error) {
+	var id string
+
+	err := db.WithTransaction(ctx, es.dbconn, func(tx db.Transaction) error {
+		if err := claimExternalID(ctx, tx, ext, customerId); err != nil {
+			return err
+		}
+
+		var err error
+		id, err = insertComment(ctx, tx, newComment, issueId, ext.ProjectID, customerId, author)
+		if err != nil {
+			return err
+		}
+
+		return completeExternalID(ctx, tx, ext, id, customerId)
+	})
+	if err != nil {
+		if err == ErrExternalIDAlreadyImported {
+			return "", err
+		}
+		if _, ok := err.(*CommentValidationError); ok {
+			return "", err
+		}
+		return "", errors.Wrapf(err, "failed to import comment with external id: %s customerId: %s", ext.ExternalID, customerId)
+	}
+
+	return id, nil
+}
+
+// claimExternalID claim the external id before the record is created, a concurrent import of the
+// same external id waits for this transaction and then finds it was imported.
+func claimExternalID(ctx context.Context, tx db.Transaction, ext *ExternalID, customerId string) error {
+	res, err := tx.ExecContext(ctx, `INSERT INTO import_external_ids(customer_id, project_id, source, kind, external_id)
+		VALUES($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`, customerId, ext.ProjectID, ext.Source, ext.Kind, ext.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return ErrExternalIDAlreadyImported
+	}
+
+	return nil
+}
+
+// completeExternalID record the id of the record created for a claimed external id.
+func completeExternalID(ctx context.Context, tx db.Transaction, ext *ExternalID, entityId, customerId string) error {
+	_, err := tx.ExecContext(ctx, `UPDATE import_external_ids SET entity_id=$1
+		WHERE customer_id=$2 AND project_id=$3 AND source=$4 AND kind=$5 AND external_id=$6`, entityId, customerId, ext.ProjectID, ext.Source, ext.Kind, ext.ExternalID)
+
+	return err
+}
@@ -0,0 +1,72 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestExternalIDs_CreateOnce(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	ext := &store.ExternalID{ProjectID: testProjectId, Source: "csv", Kind: store.ExternalKindIssue, ExternalID: "OPS-1"}
+
+	issue, err := stores.ExternalIDs.CreateIssue(ctx, ext, &api.NewIssue{Subject: "imported", Labels: []string{}}, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to import issue")
+	}
+
+	_, err = stores.ExternalIDs.CreateIssue(ctx, ext, &api.NewIssue{Subject: "imported again", Labels: []string{}}, testCustomerId, testReporter)
+	assert.Equal(store.ErrExternalIDAlreadyImported, err)
+
+	// the claim is rolled back with an issue which fails inside the transaction, so the external id
+	// is imported again by the next run
+	invalid := &store.ExternalID{ProjectID: testProjectId, Source: "csv", Kind: store.ExternalKindIssue, ExternalID: "OPS-2"}
+	missing := "5f4b7f0e-2d4c-4a8e-9d63-1b0a9f8c2e71"
+
+	_, err = stores.ExternalIDs.CreateIssue(ctx, invalid, &api.NewIssue{Subject: "invalid", MilestoneId: &missing, Labels: []string{}}, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	ids, err := stores.ExternalIDs.Lookup(ctx, testProjectId, "csv", store.ExternalKindIssue, []string{"OPS-1", "OPS-2"}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to lookup external ids")
+	}
+
+	assert.Equal(map[string]string{"OPS-1": issue.Id}, ids)
+
+	comment := &store.ExternalID{ProjectID: testProjectId, Source: "csv", Kind: store.ExternalKindComment, ExternalID: "OPS-1#1"}
+
+	commentId, err := stores.ExternalIDs.CreateComment(ctx, comment, &api.NewComment{Content: "imported"}, issue.Id, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to import comment")
+	}
+
+	_, err = stores.ExternalIDs.CreateComment(ctx, comment, &api.NewComment{Content: "imported again"}, issue.Id, testCustomerId, testAuthor)
+	assert.Equal(store.ErrExternalIDAlreadyImported, err)
+
+	comments, err := stores.Comments.List(ctx, nil, issue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list comments")
+	}
+
+	assert.Len(comments, 1)
+	assert.Equal(commentId, comments[0].Id)
+}
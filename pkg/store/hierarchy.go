@@ -0,0 +1,135 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// maxIssueDepth the maximum number of levels in an issue hierarchy, including the root issue.
+const maxIssueDepth = 5
+
+// checkIssueParent validates that an issue can be made a child of the parent, the parent must be
+// in the same project and the move must not create a cycle or exceed the maximum depth. The id is
+// empty for issues which are yet to be created. Concurrent checks for a customer are serialised
+// using an advisory lock held until the transaction completes.
+func checkIssueParent(ctx context.Context, tx db.Transaction, parentId, id, projectId, customerId string) error {
+	if parentId == id {
+		return &IssueValidationError{"issue can't be its own parent"}
+	}
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext('issue_hierarchy:' || $1::text))", customerId); err != nil {
+		return err
+	}
+
+	if err := issueExists(ctx, tx, parentId, projectId, customerId); err != nil {
+		return &IssueValidationError{fmt.Sprintf("parent issue %s not found", parentId)}
+	}
+
+	var (
+		childId interface{}
+		depth   int
+		cycle   bool
+	)
+
+	if id != "" {
+		childId = id
+	}
+
+	// walk up from the parent, the issue being one of the ancestors means it would become its own descendant
+	err := tx.QueryRowContext(ctx, `WITH RECURSIVE ancestors(id, parent_id) AS (
+			SELECT id, parent_id FROM issues WHERE id=$1 AND customer_id=$2
+			UNION ALL
+			SELECT i.id, i.parent_id FROM issues i JOIN ancestors a ON i.id = a.parent_id WHERE i.customer_id=$2
+		)
+		SELECT count(*), COALESCE(bool_or(id = $3::uuid), false) FROM ancestors`, parentId, customerId, childId).Scan(&depth, &cycle)
+	if err != nil {
+		return err
+	}
+
+	if cycle {
+		return &IssueValidationError{fmt.Sprintf("parent issue %s is a descendant of the issue", parentId)}
+	}
+
+	height := 1
+	if id != "" {
+		err := tx.QueryRowContext(ctx, `WITH RECURSIVE descendants(id, depth) AS (
+				SELECT id, 1 FROM issues WHERE id=$1 AND customer_id=$2
+				UNION ALL
+				SELECT i.id, d.depth + 1 FROM issues i JOIN descendants d ON i.parent_id = d.id WHERE i.customer_id=$2
+			)
+			SELECT COALESCE(max(depth), 1) FROM descendants`, id, customerId).Scan(&height)
+		if err != nil {
+			return err
+		}
+	}
+
+	if depth+height > maxIssueDepth {
+		return &IssueValidationError{fmt.Sprintf("issue hierarchy can't be more than %d levels deep", maxIssueDepth)}
+	}
+
+	return nil
+}
+
+// loadChildCounts rolls up the counts of all descendants by state for a page of issues using a
+// single recursive query.
+func loadChildCounts(ctx context.Context, q queryer, issues []api.Issue, customerId string) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(issues))
+	idx := map[string]*api.IssueChildCounts{}
+	for i := range issues {
+		ids[i] = issues[i].Id
+		idx[issues[i].Id] = &issues[i].Children
+	}
+
+	rows, err := q.QueryContext(ctx, `WITH RECURSIVE descendants(root_id, id, state) AS (
+			SELECT parent_id, id, state FROM issues WHERE customer_id=$1 AND parent_id = ANY($2::uuid[])
+			UNION ALL
+			SELECT d.root_id, i.id, i.state FROM issues i JOIN descendants d ON i.parent_id = d.id WHERE i.customer_id=$1
+		)
+		SELECT root_id, state, count(*) FROM descendants GROUP BY root_id, state`, customerId, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			rootId string
+			state  string
+			count  int
+		)
+
+		if err := rows.Scan(&rootId, &state, &count); err != nil {
+			return err
+		}
+
+		counts, ok := idx[rootId]
+		if !ok {
+			continue
+		}
+
+		counts.Total += count
+
+		switch state {
+		case StateCreated:
+			counts.Created += count
+		case StateOpen:
+			counts.Open += count
+		case StateInProgress:
+			counts.InProgress += count
+		case StateResolved:
+			counts.Resolved += count
+		case StateClosed:
+			counts.Closed += count
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,85 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestIssues_HierarchyRollup(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	istore := store.NewIssues(db.Global, cfg)
+
+	// build a chain epic -> story -> task -> subtask -> step which is the maximum depth
+	chain := []*api.Issue{}
+	var parentId *string
+	for _, subject := range []string{"epic", "story", "task", "subtask", "step"} {
+		newIssue, err := istore.Create(ctx, &api.NewIssue{Subject: subject, Labels: []string{}, ParentId: parentId}, testProjectId, testCustomerId, testReporter)
+		if err != nil {
+			t.Fatal("failed to create issue")
+		}
+		chain = append(chain, newIssue)
+		parentId = &newIssue.Id
+	}
+
+	_, err = istore.Create(ctx, &api.NewIssue{Subject: "too deep", Labels: []string{}, ParentId: parentId}, testProjectId, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	_, err = istore.Transition(ctx, &api.IssueTransition{State: api.IssueTransitionStateClosed}, chain[4].Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to transition issue")
+	}
+
+	epic, err := istore.GetByID(ctx, chain[0].Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue by id")
+	}
+
+	assert.Equal(api.IssueChildCounts{Total: 4, Created: 3, Closed: 1}, epic.Children)
+
+	children, err := istore.ListChildren(ctx, chain[0].Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list children")
+	}
+
+	assert.Len(children, 1)
+	assert.Equal(chain[1].Id, children[0].Id)
+	assert.Equal(3, children[0].Children.Total)
+
+	// making the epic a child of one of its descendants would create a cycle
+	_, err = istore.Update(ctx, &api.UpdatedIssue{
+		NewIssue: api.NewIssue{Subject: "epic", Labels: []string{}, ParentId: &chain[2].Id},
+	}, chain[0].Id, testProjectId, testCustomerId)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	noParent := ""
+	story, err := istore.Update(ctx, &api.UpdatedIssue{
+		NewIssue: api.NewIssue{Subject: "story", Labels: []string{}, ParentId: &noParent},
+	}, chain[1].Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update issue by id")
+	}
+
+	assert.Nil(story.ParentId)
+
+	epic, err = istore.GetByID(ctx, chain[0].Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue by id")
+	}
+
+	assert.Equal(0, epic.Children.Total)
+}
@@ -0,0 +1,239 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// InboundEmailRouteError occurs when no project matches the recipients of an inbound email.
+type InboundEmailRouteError struct {
+	Message string
+}
+
+func (e *InboundEmailRouteError) Error() string {
+	return fmt.Sprintf("inbound email not routed: %s", e.Message)
+}
+
+// InboundEmailValidationError occurs when an inbound email can't become an issue or comment.
+type InboundEmailValidationError struct {
+	Message string
+}
+
+func (e *InboundEmailValidationError) Error() string {
+	return fmt.Sprintf("invalid inbound email: %s", e.Message)
+}
+
+// InboundEmail an email received from the mail relay with quoted text and signatures removed.
+type InboundEmail struct {
+	// MessageID the Message-ID header without angle brackets.
+	MessageID string
+	// References the message ids this email replies to, from In-Reply-To then References with the
+	// most recent first.
+	References []string
+	From       string
+	// Recipients the addresses the email was sent to, used to route it to a project.
+	Recipients []string
+	Subject    string
+	Body       string
+}
+
+// InboundEmails provides a store for the emails received from the mail relay.
+type InboundEmails interface {
+	Receive(ctx context.Context, email *InboundEmail, customerId, defaultAuthor string) (*api.InboundEmail, bool, error)
+}
+
+// InboundEmailsPG provides an inbound emails store for postgresql.
+type InboundEmailsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+	issues *IssuesPG
+}
+
+// NewInboundEmails new inbound emails store.
+func NewInboundEmails(dbconn *sql.DB, cfg *conf.Config) InboundEmails {
+	return &InboundEmailsPG{dbconn: dbconn, cfg: cfg, issues: &IssuesPG{dbconn: dbconn, cfg: cfg, fields: &CustomFieldsPG{dbconn: dbconn, cfg: cfg}}}
+}
+
+// Receive create an issue from an email, or a comment when it replies to an email which was
+// already received. The sender is the author when they match a user by email address, otherwise
+// the default author is used. This returns true when the email was created, an email which was
+// already received returns the original result so redelivery by the relay is safe.
+func (ies *InboundEmailsPG) Receive(ctx context.Context, email *InboundEmail, customerId, defaultAuthor string) (*api.InboundEmail, bool, error) {
+	if strings.TrimSpace(email.Body) == "" {
+		return nil, false, &InboundEmailValidationError{fmt.Sprintf("message %s has no content", email.MessageID)}
+	}
+
+	res, err := ies.get(ctx, email.MessageID, customerId)
+	if err != nil || res != nil {
+		return res, false, err
+	}
+
+	author, err := ies.author(ctx, email.From, customerId, defaultAuthor)
+	if err != nil {
+		return nil, false, err
+	}
+
+	res = &api.InboundEmail{MessageId: email.MessageID, From: email.From}
+
+	err = ies.thread(ctx, email.References, customerId, res)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if res.IssueId != "" {
+		err = db.WithTransaction(ctx, ies.dbconn, func(tx db.Transaction) error {
+			commentId, err := insertComment(ctx, tx, &api.NewComment{Content: email.Body}, res.IssueId, res.ProjectId, customerId, author)
+			if err != nil {
+				return err
+			}
+
+			res.CommentId = &commentId
+
+			return insertInboundEmail(ctx, tx, customerId, res)
+		})
+	} else {
+		err = ies.createIssue(ctx, email, customerId, author, res)
+	}
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Constraint == "inbound_emails_customer_id_message_id_key" {
+			// the same email was delivered concurrently
+			res, err := ies.get(ctx, email.MessageID, customerId)
+			return res, false, err
+		}
+
+		switch err.(type) {
+		case *IssueValidationError, *CommentValidationError, *InboundEmailRouteError:
+			return nil, false, err
+		}
+		return nil, false, errors.Wrapf(err, "failed to receive email by messageId: %s customerId: %s", email.MessageID, customerId)
+	}
+
+	return res, true, nil
+}
+
+func (ies *InboundEmailsPG) createIssue(ctx context.Context, email *InboundEmail, customerId, author string, res *api.InboundEmail) error {
+	projectId, err := ies.route(ctx, email.Recipients, customerId)
+	if err != nil {
+		return err
+	}
+
+	subject := email.Subject
+	if subject == "" {
+		subject = "(no subject)"
+	}
+
+	ins, err := ies.issues.prepareInsert(ctx, &api.NewIssue{Subject: subject, Content: email.Body, Labels: []string{}}, projectId, customerId)
+	if err != nil {
+		return err
+	}
+
+	return db.WithTransaction(ctx, ies.dbconn, func(tx db.Transaction) error {
+		issue := api.Issue{}
+		if err := ins.insert(ctx, tx, author, &issue); err != nil {
+			return err
+		}
+
+		res.ProjectId, res.IssueId = projectId, issue.Id
+
+		return insertInboundEmail(ctx, tx, customerId, res)
+	})
+}
+
+// route finds the project for the recipients of an email, the local part of an address or the
+// part after a + is matched against the key or identifier of the projects of the customer.
+func (ies *InboundEmailsPG) route(ctx context.Context, recipients []string, customerId string) (string, error) {
+	names := []string{}
+	for _, recipient := range recipients {
+		local := strings.ToLower(recipient)
+		if i := strings.LastIndex(local, "@"); i >= 0 {
+			local = local[:i]
+		}
+
+		if i := strings.Index(local, "+"); i >= 0 {
+			names = append(names, local[i+1:])
+			local = local[:i]
+		}
+
+		names = append(names, local)
+	}
+
+	// the order of the recipients decides between projects when more than one matches
+	var projectId string
+
+	err := ies.dbconn.QueryRowContext(ctx, `SELECT p.id FROM projects p JOIN unnest($2::text[]) WITH ORDINALITY AS r(name, n)
+		ON lower(p.key) = r.name OR p.id::text = r.name WHERE p.customer_id=$1 ORDER BY r.n ASC LIMIT 1`, customerId, pq.Array(names)).Scan(&projectId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", &InboundEmailRouteError{fmt.Sprintf("no project for recipients %s", strings.Join(recipients, ", "))}
+		}
+		return "", errors.Wrapf(err, "failed to route email by customerId: %s", customerId)
+	}
+
+	return projectId, nil
+}
+
+// thread finds the issue an email replies to from the emails which were received before.
+func (ies *InboundEmailsPG) thread(ctx context.Context, references []string, customerId string, res *api.InboundEmail) error {
+	if len(references) == 0 {
+		return nil
+	}
+
+	err := ies.dbconn.QueryRowContext(ctx, `SELECT i.project_id, i.id FROM inbound_emails e
+		JOIN unnest($2::text[]) WITH ORDINALITY AS r(message_id, n) ON r.message_id = e.message_id
+		JOIN issues i ON i.id = e.issue_id AND i.customer_id = e.customer_id
+		WHERE e.customer_id=$1 ORDER BY r.n ASC LIMIT 1`, customerId, pq.Array(references)).Scan(&res.ProjectId, &res.IssueId)
+	if err != nil && err != sql.ErrNoRows {
+		return errors.Wrapf(err, "failed to find thread of email by customerId: %s", customerId)
+	}
+
+	return nil
+}
+
+// author the user with the address an email was sent from, or the default author.
+func (ies *InboundEmailsPG) author(ctx context.Context, from, customerId, defaultAuthor string) (string, error) {
+	var userId string
+
+	err := ies.dbconn.QueryRowContext(ctx, "SELECT id FROM users WHERE customer_id=$1 AND lower(email)=lower($2) ORDER BY created_at ASC LIMIT 1", customerId, from).Scan(&userId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return defaultAuthor, nil
+		}
+		return "", errors.Wrapf(err, "failed to find author of email by customerId: %s", customerId)
+	}
+
+	return userId, nil
+}
+
+func (ies *InboundEmailsPG) get(ctx context.Context, messageId, customerId string) (*api.InboundEmail, error) {
+	res := &api.InboundEmail{}
+
+	var commentId sql.NullString
+
+	err := ies.dbconn.QueryRowContext(ctx, "SELECT message_id, project_id, issue_id, comment_id, from_address, created_at FROM inbound_emails WHERE customer_id=$1 AND message_id=$2",
+		customerId, messageId).Scan(&res.MessageId, &res.ProjectId, &res.IssueId, &commentId, &res.From, &res.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get email by messageId: %s customerId: %s", messageId, customerId)
+	}
+
+	if commentId.Valid {
+		res.CommentId = &commentId.String
+	}
+
+	return res, nil
+}
+
+func insertInboundEmail(ctx context.Context, tx db.Transaction, customerId string, res *api.InboundEmail) error {
+	return tx.QueryRowContext(ctx, "INSERT INTO inbound_emails(customer_id, message_id, project_id, issue_id, comment_id, from_address) VALUES($1, $2, $3, $4, $5, $6) RETURNING created_at",
+		customerId, res.MessageId, res.ProjectId, res.IssueId, res.CommentId, res.From).Scan(&res.CreatedAt)
+}
@@ -0,0 +1,107 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestInboundEmails_Receive(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "support desk", Key: strPtr("DESK"), Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	carol, err := stores.Users.Create(ctx, &api.NewUser{Login: "carol", Name: "Carol", Email: "Carol@example.com"}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create user")
+	}
+
+	_, _, err = stores.InboundEmails.Receive(ctx, &store.InboundEmail{
+		MessageID: "unrouted@example.com", From: "customer@example.com", Recipients: []string{"nobody@support.example.com"}, Subject: "help", Body: "help",
+	}, testCustomerId, testReporter)
+	assert.IsType(&store.InboundEmailRouteError{}, err)
+
+	email := &store.InboundEmail{
+		MessageID:  "first@example.com",
+		From:       "customer@example.com",
+		Recipients: []string{"other@example.com", "support+desk@support.example.com"},
+		Subject:    "printer is on fire",
+		Body:       "it is very hot",
+	}
+
+	res, created, err := stores.InboundEmails.Receive(ctx, email, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to receive email")
+	}
+
+	assert.True(created)
+	assert.Equal(proj.Id, res.ProjectId)
+	assert.Nil(res.CommentId)
+
+	issue, err := stores.Issues.GetByID(ctx, res.IssueId, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue")
+	}
+
+	assert.Equal("printer is on fire", issue.Subject)
+	assert.Equal("it is very hot", issue.Content)
+
+	// redelivery returns the original result
+	again, created, err := stores.InboundEmails.Receive(ctx, email, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to receive email")
+	}
+
+	assert.False(created)
+	assert.Equal(res.IssueId, again.IssueId)
+
+	reply, created, err := stores.InboundEmails.Receive(ctx, &store.InboundEmail{
+		MessageID:  "reply@example.com",
+		References: []string{"unknown@example.com", "first@example.com"},
+		From:       "carol@example.com",
+		Recipients: []string{"desk@support.example.com"},
+		Subject:    "printer is on fire",
+		Body:       "turned it off",
+	}, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to receive reply")
+	}
+
+	assert.True(created)
+	assert.Equal(res.IssueId, reply.IssueId)
+	assert.NotNil(reply.CommentId)
+
+	comment, err := stores.Comments.GetByID(ctx, *reply.CommentId, res.IssueId, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get comment")
+	}
+
+	assert.Equal("turned it off", comment.Content)
+	assert.Equal(carol.Id, comment.Author.Id)
+
+	_, _, err = stores.InboundEmails.Receive(ctx, &store.InboundEmail{
+		MessageID: "empty@example.com", From: "customer@example.com", Recipients: []string{"desk@support.example.com"}, Body: " ",
+	}, testCustomerId, testReporter)
+	assert.IsType(&store.InboundEmailValidationError{}, err)
+}
@@ -0,0 +1,425 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// BulkMaxIssues the most issues which can be changed by a bulk request.
+const BulkMaxIssues = 500
+
+// IssueVersionConflictError occurs when an issue has been updated since the version a change was based on.
+type IssueVersionConflictError struct {
+	Message string
+}
+
+func (e *IssueVersionConflictError) Error() string {
+	return fmt.Sprintf("issue has changed: %s", e.Message)
+}
+
+// errBulkRolledBack rolls back the transaction of an atomic bulk change which had failures.
+var errBulkRolledBack = errors.New("bulk change rolled back")
+
+// bulkChanges the validated changes of a bulk request.
+type bulkChanges struct {
+	state        *string
+	severity     *string
+	assigneeId   *string
+	addLabels    []string
+	removeLabels []string
+}
+
+// BulkUpdate apply the same changes to a list of issues, or the issues matching a filter. Each issue
+// is changed in a savepoint so failures are reported in the results without affecting the other
+// issues, unless the request is atomic in which case any failure rolls back every change.
+func (is *IssuesPG) BulkUpdate(ctx context.Context, bulk *api.IssueBulkUpdate, projectId, customerId, actor string) (*api.IssueBulkResults, error) {
+	changes, err := is.prepareBulkChanges(ctx, &bulk.Changes, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	hasIssues, hasFilter := bulk.Issues != nil && len(*bulk.Issues) > 0, bulk.Filter != nil && len(*bulk.Filter) > 0
+	if hasIssues == hasFilter {
+		return nil, &IssueValidationError{"either issues or filter must be provided"}
+	}
+
+	var (
+		targets []api.IssueBulkTarget
+		conds   []*sqlf.Query
+	)
+
+	if hasIssues {
+		targets = *bulk.Issues
+		if err := validateBulkTargets(targets); err != nil {
+			return nil, err
+		}
+	} else {
+		filters, err := NewIssueFilterOptions(*bulk.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		fields, err := is.fields.List(ctx, projectId, customerId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list custom fields for projectId: %s customerId: %s", projectId, customerId)
+		}
+
+		conds, err = filters.SQL(fields)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	atomic := bulk.Atomic != nil && *bulk.Atomic
+
+	res := &api.IssueBulkResults{}
+
+	err = db.WithTransaction(ctx, is.dbconn, func(tx db.Transaction) error {
+		res.Results = []api.IssueBulkResult{}
+
+		// the issues matching a filter are resolved in the transaction which changes them
+		if hasFilter {
+			var err error
+			targets, err = matchBulkTargets(ctx, tx, conds, projectId, customerId)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, target := range targets {
+			result, err := changes.apply(ctx, tx, target, projectId, customerId, actor)
+			if err != nil {
+				return err
+			}
+
+			if result.Status == api.IssueBulkResultStatusFailed {
+				res.Failed++
+			}
+
+			res.Results = append(res.Results, result)
+		}
+
+		if atomic && res.Failed > 0 {
+			return errBulkRolledBack
+		}
+
+		return nil
+	})
+	if err != nil && err != errBulkRolledBack {
+		switch err.(type) {
+		case *IssueValidationError:
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to bulk update issues for projectId: %s customerId: %s", projectId, customerId)
+	}
+
+	res.Committed = err == nil
+
+	for i := range res.Results {
+		result := &res.Results[i]
+
+		switch {
+		case !res.Committed && result.Status != api.IssueBulkResultStatusFailed:
+			result.Status = api.IssueBulkResultStatusRolledBack
+			result.UpdatedAt = nil
+		case result.Status == api.IssueBulkResultStatusUpdated:
+			res.Updated++
+		}
+	}
+
+	return res, nil
+}
+
+// prepareBulkChanges validates the changes against the workflow and taxonomy of the project.
+func (is *IssuesPG) prepareBulkChanges(ctx context.Context, changes *api.IssueBulkChanges, projectId, customerId string) (*bulkChanges, error) {
+	c := &bulkChanges{assigneeId: changes.AssigneeId, severity: changes.Severity}
+
+	if changes.State != nil {
+		state := string(*changes.State)
+		if _, ok := issueTransitions[state]; !ok {
+			return nil, &IssueValidationError{fmt.Sprintf("unknown state %s", state)}
+		}
+		c.state = &state
+	}
+
+	if changes.Severity != nil {
+		tax, err := loadTaxonomy(ctx, is.dbconn, projectId, customerId)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tax.validateSeverity(*changes.Severity); err != nil {
+			return nil, err
+		}
+	}
+
+	if changes.AssigneeId != nil && *changes.AssigneeId != "" {
+		if err := validateAssignee(*changes.AssigneeId); err != nil {
+			return nil, err
+		}
+	}
+
+	if changes.AddLabels != nil {
+		c.addLabels = *changes.AddLabels
+	}
+
+	if changes.RemoveLabels != nil {
+		c.removeLabels = *changes.RemoveLabels
+	}
+
+	for _, label := range c.addLabels {
+		if label == "" {
+			return nil, &IssueValidationError{"labels can't be empty"}
+		}
+		if containsString(c.removeLabels, label) {
+			return nil, &IssueValidationError{fmt.Sprintf("label %s can't be both added and removed", label)}
+		}
+	}
+
+	if c.state == nil && c.severity == nil && c.assigneeId == nil && len(c.addLabels) == 0 && len(c.removeLabels) == 0 {
+		return nil, &IssueValidationError{"no changes provided"}
+	}
+
+	return c, nil
+}
+
+// validateBulkTargets check the number of issues and that each is only listed once.
+func validateBulkTargets(targets []api.IssueBulkTarget) error {
+	if len(targets) > BulkMaxIssues {
+		return &IssueValidationError{fmt.Sprintf("at most %d issues can be changed at once", BulkMaxIssues)}
+	}
+
+	seen := map[string]bool{}
+	for _, target := range targets {
+		if target.Id == "" {
+			return &IssueValidationError{"issue id can't be empty"}
+		}
+		if seen[target.Id] {
+			return &IssueValidationError{fmt.Sprintf("issue %s is listed more than once", target.Id)}
+		}
+		seen[target.Id] = true
+	}
+
+	return nil
+}
+
+// matchBulkTargets the issues in the project matching the filter conditions.
+func matchBulkTargets(ctx context.Context, tx db.Transaction, conds []*sqlf.Query, projectId, customerId string) ([]api.IssueBulkTarget, error) {
+	conds = append(conds, sqlf.Sprintf("project_id = %s", projectId), sqlf.Sprintf("customer_id = %s", customerId))
+
+	qry := sqlf.Sprintf("SELECT id FROM issues WHERE %s ORDER BY id ASC LIMIT %s", sqlf.Join(conds, "AND"), BulkMaxIssues+1)
+
+	rows, err := tx.QueryContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []api.IssueBulkTarget{}
+	defer rows.Close()
+	for rows.Next() {
+		target := api.IssueBulkTarget{}
+		if err := rows.Scan(&target.Id); err != nil {
+			return nil, err
+		}
+
+		targets = append(targets, target)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(targets) > BulkMaxIssues {
+		return nil, &IssueValidationError{fmt.Sprintf("filter matches more than %d issues", BulkMaxIssues)}
+	}
+
+	return targets, nil
+}
+
+// apply changes an issue in a savepoint, failures caused by the issue are rolled back to the
+// savepoint and reported in the result, any other error aborts the transaction.
+func (c *bulkChanges) apply(ctx context.Context, tx db.Transaction, target api.IssueBulkTarget, projectId, customerId, actor string) (api.IssueBulkResult, error) {
+	result := api.IssueBulkResult{Id: target.Id}
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT bulk_issue"); err != nil {
+		return result, err
+	}
+
+	changed, err := c.applyIssue(ctx, tx, target, &result, projectId, customerId, actor)
+	if err != nil {
+		code, ok := bulkErrorCode(err)
+		if !ok {
+			return result, err
+		}
+
+		if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT bulk_issue"); err != nil {
+			return result, err
+		}
+
+		msg := err.Error()
+		result.Status, result.ErrorCode, result.Error = api.IssueBulkResultStatusFailed, &code, &msg
+
+		return result, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, "RELEASE SAVEPOINT bulk_issue"); err != nil {
+		return result, err
+	}
+
+	result.Status = api.IssueBulkResultStatusUnchanged
+	if changed {
+		result.Status = api.IssueBulkResultStatusUpdated
+	}
+
+	return result, nil
+}
+
+func (c *bulkChanges) applyIssue(ctx context.Context, tx db.Transaction, target api.IssueBulkTarget, result *api.IssueBulkResult, projectId, customerId, actor string) (bool, error) {
+	var (
+		id, state, severity string
+		key, assignee       sql.NullString
+		labels              []string
+		updatedAt           time.Time
+	)
+
+	err := tx.QueryRowContext(ctx, "SELECT id, key, state, severity, assignee::text, labels, updated_at FROM issues WHERE "+issueIDColumn(target.Id)+"=$1 AND project_id=$2 AND customer_id=$3 FOR UPDATE",
+		target.Id, projectId, customerId).Scan(&id, &key, &state, &severity, &assignee, pq.Array(&labels), &updatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", target.Id, projectId)}
+		}
+		return false, err
+	}
+
+	if key.Valid {
+		result.Key = &key.String
+	}
+
+	if target.UpdatedAt != nil && !target.UpdatedAt.Equal(updatedAt) {
+		return false, &IssueVersionConflictError{fmt.Sprintf("%s was updated at %s", target.Id, updatedAt.Format(time.RFC3339Nano))}
+	}
+
+	fields := []*sqlf.Query{}
+	details := map[string]string{}
+
+	if c.severity != nil && *c.severity != severity {
+		fields = append(fields, sqlf.Sprintf("severity=%s", *c.severity))
+		details["from_severity"], details["to_severity"] = severity, *c.severity
+	}
+
+	if c.assigneeId != nil && *c.assigneeId != assignee.String {
+		if *c.assigneeId == "" {
+			fields = append(fields, sqlf.Sprintf("assignee=NULL"))
+		} else {
+			fields = append(fields, sqlf.Sprintf("assignee=%s", *c.assigneeId))
+		}
+		details["from_assignee_id"], details["to_assignee_id"] = assignee.String, *c.assigneeId
+	}
+
+	if added, removed := diffLabels(labels, c.addLabels, c.removeLabels); len(added) > 0 || len(removed) > 0 {
+		// the issue is locked so the labels can be changed from those read
+		updated := []string{}
+		for _, label := range labels {
+			if !containsString(removed, label) {
+				updated = append(updated, label)
+			}
+		}
+		updated = append(updated, added...)
+
+		fields = append(fields, sqlf.Sprintf("labels=%s", pq.Array(updated)))
+		if len(added) > 0 {
+			details["added_labels"] = strings.Join(added, ",")
+		}
+		if len(removed) > 0 {
+			details["removed_labels"] = strings.Join(removed, ",")
+		}
+	}
+
+	if len(fields) > 0 {
+		fields = append(fields, sqlf.Sprintf("updated_at=%s", time.Now()))
+
+		qry := sqlf.Sprintf("UPDATE issues SET %s WHERE id=%s AND customer_id=%s", sqlf.Join(fields, ","), id, customerId)
+		if _, err := tx.ExecContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...); err != nil {
+			return false, err
+		}
+	}
+
+	if c.assigneeId != nil && *c.assigneeId != "" && *c.assigneeId != assignee.String {
+		if err := addWatcher(ctx, tx, id, customerId, *c.assigneeId, api.WatcherReasonAssignee); err != nil {
+			return false, err
+		}
+
+		if err := notifyUsers(ctx, tx, api.NotificationTypeAssigned, id, nil, customerId, actor, nil, []string{*c.assigneeId}); err != nil {
+			return false, err
+		}
+	}
+
+	if c.state != nil && *c.state != state {
+		if err := transitionIssue(ctx, tx, *c.state, id, customerId); err != nil {
+			return false, err
+		}
+		details["from_state"], details["to_state"] = state, *c.state
+	}
+
+	// transitions sync the sla so only a change of severity on its own needs it
+	if _, ok := details["to_severity"]; ok && details["to_state"] == "" {
+		if _, err := syncIssueSLA(ctx, tx, id, customerId, time.Now()); err != nil {
+			return false, err
+		}
+	}
+
+	if len(details) > 0 {
+		if err := recordActivity(ctx, tx, ActionBulkUpdated, id, customerId, actor, details); err != nil {
+			return false, err
+		}
+	}
+
+	if err := tx.QueryRowContext(ctx, "SELECT updated_at FROM issues WHERE id=$1 AND customer_id=$2", id, customerId).Scan(&updatedAt); err != nil {
+		return false, err
+	}
+	result.UpdatedAt = &updatedAt
+
+	return len(details) > 0, nil
+}
+
+// diffLabels the labels which are added to and removed from an issue with the labels.
+func diffLabels(labels, add, remove []string) (added, removed []string) {
+	for _, label := range add {
+		if !containsString(labels, label) && !containsString(added, label) {
+			added = append(added, label)
+		}
+	}
+
+	for _, label := range remove {
+		if containsString(labels, label) && !containsString(removed, label) {
+			removed = append(removed, label)
+		}
+	}
+
+	return added, removed
+}
+
+// bulkErrorCode the error code reported for a failure to change an issue, false if the error
+// isn't caused by the issue or the change.
+func bulkErrorCode(err error) (api.IssueBulkResultErrorCode, bool) {
+	switch err.(type) {
+	case *IssueNotFoundError:
+		return api.IssueBulkResultErrorCodeNotFound, true
+	case *IssueValidationError:
+		return api.IssueBulkResultErrorCodeInvalid, true
+	case *InvalidTransitionError:
+		return api.IssueBulkResultErrorCodeInvalidTransition, true
+	case *IssueVersionConflictError:
+		return api.IssueBulkResultErrorCodeVersionConflict, true
+	}
+
+	return "", false
+}
@@ -0,0 +1,146 @@
+package store_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestIssues_BulkUpdate(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "triage", Key: strPtr("TRI"), Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	issues := []*api.Issue{}
+	for _, subject := range []string{"first", "second", "third"} {
+		issue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: subject, Severity: "low", Labels: []string{"triage"}}, proj.Id, testCustomerId, testReporter)
+		if err != nil {
+			t.Fatal("failed to create issue")
+		}
+		issues = append(issues, issue)
+	}
+
+	_, err = stores.Issues.Transition(ctx, &api.IssueTransition{State: api.IssueTransitionStateClosed}, issues[2].Id, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to transition issue")
+	}
+
+	state := api.IssueBulkChangesStateInProgress
+	changes := api.IssueBulkChanges{
+		State:        &state,
+		Severity:     strPtr("high"),
+		AssigneeId:   strPtr(testAuthor),
+		AddLabels:    &[]string{"accepted"},
+		RemoveLabels: &[]string{"triage"},
+	}
+
+	// the closed issue can't move to in progress and the stale version is rejected
+	stale := issues[1].UpdatedAt.Add(-time.Second)
+	res, err := stores.Issues.BulkUpdate(ctx, &api.IssueBulkUpdate{
+		Issues: &[]api.IssueBulkTarget{
+			{Id: "TRI-1", UpdatedAt: &issues[0].UpdatedAt},
+			{Id: issues[1].Id, UpdatedAt: &stale},
+			{Id: issues[2].Id},
+			{Id: "TRI-99"},
+		},
+		Changes: changes,
+	}, proj.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to bulk update issues")
+	}
+
+	assert.True(res.Committed)
+	assert.Equal(1, res.Updated)
+	assert.Equal(3, res.Failed)
+	assert.Equal(api.IssueBulkResultStatusUpdated, res.Results[0].Status)
+	assert.Equal("TRI-1", *res.Results[0].Key)
+	assert.Equal(api.IssueBulkResultErrorCodeVersionConflict, *res.Results[1].ErrorCode)
+	assert.Equal(api.IssueBulkResultErrorCodeInvalidTransition, *res.Results[2].ErrorCode)
+	assert.Equal(api.IssueBulkResultErrorCodeNotFound, *res.Results[3].ErrorCode)
+
+	updated, err := stores.Issues.GetByID(ctx, issues[0].Id, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue by id")
+	}
+
+	assert.Equal("in_progress", updated.State)
+	assert.Equal("high", updated.Severity)
+	assert.Equal(testAuthor, *updated.AssigneeId)
+	assert.Equal([]string{"accepted"}, updated.Labels)
+	assert.True(updated.UpdatedAt.Equal(*res.Results[0].UpdatedAt))
+
+	// the severity change failed with the rest of the changes to the closed issue
+	closed, err := stores.Issues.GetByID(ctx, issues[2].Id, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue by id")
+	}
+
+	assert.Equal("low", closed.Severity)
+
+	// applying the same changes again leaves the issue unchanged
+	res, err = stores.Issues.BulkUpdate(ctx, &api.IssueBulkUpdate{
+		Issues:  &[]api.IssueBulkTarget{{Id: issues[0].Id}},
+		Changes: changes,
+	}, proj.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to bulk update issues")
+	}
+
+	assert.Equal(api.IssueBulkResultStatusUnchanged, res.Results[0].Status)
+
+	// a failure in an atomic request rolls back every change
+	atomic := true
+	res, err = stores.Issues.BulkUpdate(ctx, &api.IssueBulkUpdate{
+		Filter:  &[]string{"label:triage"},
+		Changes: api.IssueBulkChanges{State: &state},
+		Atomic:  &atomic,
+	}, proj.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to bulk update issues")
+	}
+
+	assert.False(res.Committed)
+	assert.Len(res.Results, 2)
+	assert.Equal(0, res.Updated)
+	assert.Equal(1, res.Failed)
+
+	second, err := stores.Issues.GetByID(ctx, issues[1].Id, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue by id")
+	}
+
+	assert.Equal("created", second.State)
+
+	_, err = stores.Issues.BulkUpdate(ctx, &api.IssueBulkUpdate{
+		Issues:  &[]api.IssueBulkTarget{{Id: issues[0].Id}},
+		Changes: api.IssueBulkChanges{AddLabels: &[]string{"a"}, RemoveLabels: &[]string{"a"}},
+	}, proj.Id, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	_, err = stores.Issues.BulkUpdate(ctx, &api.IssueBulkUpdate{
+		Changes: changes,
+	}, proj.Id, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+}
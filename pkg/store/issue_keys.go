@@ -0,0 +1,43 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// issueIDColumn returns the column used to look up an issue, identifiers which aren't a UUID
+// are treated as keys such as API-123.
+func issueIDColumn(id string) string {
+	if _, err := uuid.FromString(id); err != nil {
+		return "key"
+	}
+
+	return "id"
+}
+
+// allocateIssueKey allocates the next key in a project, the project row is locked by the update
+// so concurrent creates in the same project are given consecutive numbers. Issues in projects
+// without a key, or which don't exist, are not given a key.
+func allocateIssueKey(ctx context.Context, tx db.Transaction, projectId, customerId string) (*string, error) {
+	var (
+		key string
+		seq int64
+	)
+
+	err := tx.QueryRowContext(ctx, "UPDATE projects SET issue_seq = issue_seq + 1 WHERE id=$1 AND customer_id=$2 AND key IS NOT NULL RETURNING key, issue_seq",
+		projectId, customerId).Scan(&key, &seq)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	key = fmt.Sprintf("%s-%d", key, seq)
+
+	return &key, nil
+}
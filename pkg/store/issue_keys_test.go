@@ -0,0 +1,109 @@
+package store_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestIssueKeys_AllocateAndResolve(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "test project", Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	// issues created before the project has a key are numbered when the key is set
+	first, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "first", Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	assert.Nil(first.Key)
+
+	_, err = stores.Projects.Create(ctx, &api.NewProject{Name: "invalid key", Key: strPtr("api"), Labels: []string{}}, testCustomerId)
+	assert.IsType(&store.ProjectValidationError{}, err)
+
+	_, err = stores.Projects.Update(ctx, &api.UpdatedProject{NewProject: api.NewProject{Name: "test project", Key: strPtr("API"), Labels: []string{}}}, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update project")
+	}
+
+	_, err = stores.Projects.Update(ctx, &api.UpdatedProject{NewProject: api.NewProject{Name: "test project", Key: strPtr("WEB"), Labels: []string{}}}, proj.Id, testCustomerId)
+	assert.IsType(&store.ProjectValidationError{}, err)
+
+	_, err = stores.Projects.Create(ctx, &api.NewProject{Name: "other project", Key: strPtr("API"), Labels: []string{}}, testCustomerId)
+	assert.Equal(store.ErrProjectKeyAlreadyExists, err)
+
+	getIssue, err := stores.Issues.GetByID(ctx, "API-1", proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue by key")
+	}
+
+	assert.Equal(first.Id, getIssue.Id)
+
+	var wg sync.WaitGroup
+
+	keys := make(chan string, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: fmt.Sprintf("issue %d", i), Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+			if err != nil {
+				t.Error("failed to create issue")
+				return
+			}
+
+			keys <- *newIssue.Key
+		}(i)
+	}
+
+	wg.Wait()
+	close(keys)
+
+	allocated := map[string]bool{}
+	for key := range keys {
+		allocated[key] = true
+	}
+
+	for i := 2; i <= 11; i++ {
+		assert.True(allocated[fmt.Sprintf("API-%d", i)])
+	}
+
+	updated, err := stores.Issues.Update(ctx, &api.UpdatedIssue{NewIssue: api.NewIssue{Subject: "updated", Labels: []string{}}}, "API-1", proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update issue by key")
+	}
+
+	assert.Equal("updated", updated.Subject)
+
+	_, err = stores.Issues.GetByID(ctx, "API-99", proj.Id, testCustomerId)
+	assert.IsType(&store.IssueNotFoundError{}, err)
+}
+
+func strPtr(s string) *string {
+	return &s
+}
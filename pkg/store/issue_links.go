@@ -0,0 +1,261 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+var (
+	// ErrIssueLinkAlreadyExists issue link already exists.
+	ErrIssueLinkAlreadyExists = errors.New("issue link already exists")
+
+	// ErrIssueLinkCycle blocking link would create a cycle.
+	ErrIssueLinkCycle = errors.New("issue link would create a blocking cycle")
+)
+
+// issueLinkInverses the type of the inverse link stored on the linked issue.
+var issueLinkInverses = map[api.NewIssueLinkType]api.NewIssueLinkType{
+	api.NewIssueLinkTypeBlocks:       api.NewIssueLinkTypeBlockedBy,
+	api.NewIssueLinkTypeBlockedBy:    api.NewIssueLinkTypeBlocks,
+	api.NewIssueLinkTypeDuplicates:   api.NewIssueLinkTypeDuplicatedBy,
+	api.NewIssueLinkTypeDuplicatedBy: api.NewIssueLinkTypeDuplicates,
+	api.NewIssueLinkTypeRelatesTo:    api.NewIssueLinkTypeRelatesTo,
+}
+
+// IssueLinkNotFoundError occurs when an issue link is not found.
+type IssueLinkNotFoundError struct {
+	Message string
+}
+
+func (e *IssueLinkNotFoundError) Error() string {
+	return fmt.Sprintf("issue link not found: %s", e.Message)
+}
+
+// IssueLinks provides a store for links between issues.
+type IssueLinks interface {
+	Create(ctx context.Context, newLink *api.NewIssueLink, issueId, projectId, customerId string) (*api.IssueLink, error)
+	Delete(ctx context.Context, id, issueId, projectId, customerId string) error
+	List(ctx context.Context, issueId, projectId, customerId string) ([]api.IssueLink, error)
+}
+
+// IssueLinksPG provides a issue links store for postgresql.
+type IssueLinksPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewIssueLinks new issue links store.
+func NewIssueLinks(dbconn *sql.DB, cfg *conf.Config) IssueLinks {
+	return &IssueLinksPG{dbconn: dbconn, cfg: cfg}
+}
+
+// Create link an issue to another issue of the same customer along with the inverse link.
+func (ls *IssueLinksPG) Create(ctx context.Context, newLink *api.NewIssueLink, issueId, projectId, customerId string) (*api.IssueLink, error) {
+	inverse, ok := issueLinkInverses[newLink.Type]
+	if !ok {
+		return nil, &IssueValidationError{fmt.Sprintf("unknown link type %s", newLink.Type)}
+	}
+
+	if _, err := uuid.FromString(issueId); err != nil {
+		return nil, &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", issueId, projectId)}
+	}
+
+	var linkId string
+
+	err := db.WithTransaction(ctx, ls.dbconn, func(tx db.Transaction) error {
+		if err := issueExists(ctx, tx, issueId, projectId, customerId); err != nil {
+			return err
+		}
+
+		// the linked issue may be given by id or key, the id is used from here on
+		var targetId, targetProjectId string
+		err := tx.QueryRowContext(ctx, "SELECT id, project_id FROM issues WHERE "+issueIDColumn(newLink.IssueId)+"=$1 AND customer_id=$2",
+			newLink.IssueId, customerId).Scan(&targetId, &targetProjectId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &IssueNotFoundError{fmt.Sprintf("id %s", newLink.IssueId)}
+			}
+			return err
+		}
+
+		if targetId == issueId {
+			return &IssueValidationError{"issue can't be linked to itself"}
+		}
+
+		switch newLink.Type {
+		case api.NewIssueLinkTypeBlocks:
+			err = checkBlockingCycle(ctx, tx, issueId, targetId, customerId)
+		case api.NewIssueLinkTypeBlockedBy:
+			err = checkBlockingCycle(ctx, tx, targetId, issueId, customerId)
+		}
+		if err != nil {
+			return err
+		}
+
+		err = tx.QueryRowContext(ctx, "INSERT INTO issue_links(customer_id, source_issue_id, target_issue_id, type) VALUES($1, $2, $3, $4) RETURNING id",
+			customerId, issueId, targetId, string(newLink.Type)).Scan(&linkId)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "INSERT INTO issue_links(customer_id, source_issue_id, target_issue_id, type) VALUES($1, $2, $3, $4) ON CONFLICT DO NOTHING",
+			customerId, targetId, issueId, string(inverse))
+		if err != nil {
+			return err
+		}
+
+		if newLink.Type == api.NewIssueLinkTypeDuplicates && newLink.Close != nil && *newLink.Close {
+			return transitionIssue(ctx, tx, StateClosed, issueId, customerId)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Constraint {
+			case "issue_links_customer_id_source_issue_id_target_issue_id_type_key":
+				return nil, ErrIssueLinkAlreadyExists
+			}
+		}
+		switch err.(type) {
+		case *IssueNotFoundError, *IssueValidationError, *InvalidTransitionError:
+			return nil, err
+		}
+		if err == ErrIssueLinkCycle {
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to create issue link from issueId: %s to issueId: %s customerId: %s", issueId, newLink.IssueId, customerId)
+	}
+
+	links, err := ls.getBySQL(ctx, "WHERE l.id=$1 AND l.customer_id=$2", linkId, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get issue link by id: %s customerId: %s", linkId, customerId)
+	}
+
+	if len(links) == 0 {
+		return nil, &IssueLinkNotFoundError{fmt.Sprintf("id %s", linkId)}
+	}
+
+	return &links[0], nil
+}
+
+// Delete remove a link and its inverse.
+func (ls *IssueLinksPG) Delete(ctx context.Context, id, issueId, projectId, customerId string) error {
+	err := db.WithTransaction(ctx, ls.dbconn, func(tx db.Transaction) error {
+		if err := issueExists(ctx, tx, issueId, projectId, customerId); err != nil {
+			return err
+		}
+
+		var (
+			targetIssueId string
+			linkType      string
+		)
+
+		err := tx.QueryRowContext(ctx, "DELETE FROM issue_links WHERE id=$1 AND source_issue_id=$2 AND customer_id=$3 RETURNING target_issue_id, type",
+			id, issueId, customerId).Scan(&targetIssueId, &linkType)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &IssueLinkNotFoundError{fmt.Sprintf("id %s issue_id %s", id, issueId)}
+			}
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "DELETE FROM issue_links WHERE source_issue_id=$1 AND target_issue_id=$2 AND type=$3 AND customer_id=$4",
+			targetIssueId, issueId, string(issueLinkInverses[api.NewIssueLinkType(linkType)]), customerId)
+		return err
+	})
+	if err != nil {
+		switch err.(type) {
+		case *IssueNotFoundError, *IssueLinkNotFoundError:
+			return err
+		}
+		return errors.Wrapf(err, "failed to delete issue link by id: %s issueId: %s customerId: %s", id, issueId, customerId)
+	}
+
+	return nil
+}
+
+// List list the links from an issue.
+func (ls *IssueLinksPG) List(ctx context.Context, issueId, projectId, customerId string) ([]api.IssueLink, error) {
+	if err := issueExists(ctx, ls.dbconn, issueId, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	return ls.getBySQL(ctx, "WHERE l.source_issue_id=$1 AND l.customer_id=$2 ORDER BY l.created_at ASC, l.id ASC", issueId, customerId)
+}
+
+func (ls *IssueLinksPG) getBySQL(ctx context.Context, query string, args ...interface{}) ([]api.IssueLink, error) {
+	rows, err := ls.dbconn.QueryContext(ctx, `SELECT l.id, l.type, i.id, i.project_id, i.subject, i.state, l.created_at
+		FROM issue_links l JOIN issues i ON i.id = l.target_issue_id AND i.customer_id = l.customer_id `+query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	links := []api.IssueLink{}
+	defer rows.Close()
+	for rows.Next() {
+		link := api.IssueLink{}
+		err := rows.Scan(&link.Id, &link.Type, &link.IssueId, &link.ProjectId, &link.Subject, &link.State, &link.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		links = append(links, link)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return links, nil
+}
+
+// checkBlockingCycle returns ErrIssueLinkCycle if the blocked issue already blocks the blocking
+// issue, either directly or through other issues. Concurrent checks for a customer are serialised
+// using an advisory lock held until the transaction completes.
+func checkBlockingCycle(ctx context.Context, tx db.Transaction, blockingId, blockedId, customerId string) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext('issue_links:' || $1::text))", customerId); err != nil {
+		return err
+	}
+
+	var cycle bool
+
+	err := tx.QueryRowContext(ctx, `WITH RECURSIVE blocked(issue_id) AS (
+			SELECT target_issue_id FROM issue_links WHERE customer_id=$1 AND source_issue_id=$2 AND type='blocks'
+			UNION
+			SELECT l.target_issue_id FROM issue_links l JOIN blocked b ON l.source_issue_id = b.issue_id WHERE l.customer_id=$1 AND l.type='blocks'
+		)
+		SELECT EXISTS (SELECT 1 FROM blocked WHERE issue_id=$3)`, customerId, blockedId, blockingId).Scan(&cycle)
+	if err != nil {
+		return err
+	}
+
+	if cycle {
+		return ErrIssueLinkCycle
+	}
+
+	return nil
+}
+
+// issueExists returns IssueNotFoundError if the issue doesn't exist in the project.
+func issueExists(ctx context.Context, q queryRower, id, projectId, customerId string) error {
+	var exists bool
+
+	err := q.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM issues WHERE id=$1 AND project_id=$2 AND customer_id=$3)", id, projectId, customerId).Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestIssueLinks_CreateListDelete(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	issues := []*api.Issue{}
+	for _, subject := range []string{"first", "second", "third"} {
+		newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: subject, Labels: []string{}}, testProjectId, testCustomerId, testReporter)
+		if err != nil {
+			t.Fatal("failed to create issue")
+		}
+		issues = append(issues, newIssue)
+	}
+
+	first, second, third := issues[0], issues[1], issues[2]
+
+	link, err := stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeBlocks, IssueId: second.Id}, first.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create issue link")
+	}
+
+	assert.Equal("blocks", link.Type)
+	assert.Equal(second.Id, link.IssueId)
+
+	// the inverse is listed on the linked issue
+	listLinks, err := stores.IssueLinks.List(ctx, second.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list issue links")
+	}
+
+	assert.Len(listLinks, 1)
+	assert.Equal("blocked_by", listLinks[0].Type)
+	assert.Equal(first.Id, listLinks[0].IssueId)
+
+	_, err = stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeBlocks, IssueId: second.Id}, first.Id, testProjectId, testCustomerId)
+	assert.Equal(store.ErrIssueLinkAlreadyExists, err)
+
+	_, err = stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeBlocks, IssueId: third.Id}, second.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create issue link")
+	}
+
+	_, err = stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeBlockedBy, IssueId: third.Id}, first.Id, testProjectId, testCustomerId)
+	assert.Equal(store.ErrIssueLinkCycle, err)
+
+	_, err = stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeRelatesTo, IssueId: first.Id}, first.Id, testProjectId, testCustomerId)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	// the linked issue may be given by key, ids and keys which don't exist aren't found
+	keyed, err := stores.Projects.Create(ctx, &api.NewProject{Name: "links", Key: strPtr("LNK"), Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	keyedIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "keyed", Labels: []string{}}, keyed.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	keyedLink, err := stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeRelatesTo, IssueId: *keyedIssue.Key}, first.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create issue link by key")
+	}
+
+	assert.Equal(keyedIssue.Id, keyedLink.IssueId)
+
+	for _, id := range []string{"LNK-999", "not-an-issue", "5f4b7f0e-2d4c-4a8e-9d63-1b0a9f8c2e71"} {
+		_, err = stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeRelatesTo, IssueId: id}, first.Id, testProjectId, testCustomerId)
+		assert.IsType(&store.IssueNotFoundError{}, err, id)
+	}
+
+	_, err = stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeRelatesTo, IssueId: second.Id}, "not-an-issue", testProjectId, testCustomerId)
+	assert.IsType(&store.IssueNotFoundError{}, err)
+
+	closeDuplicate := true
+	_, err = stores.IssueLinks.Create(ctx, &api.NewIssueLink{Type: api.NewIssueLinkTypeDuplicates, IssueId: first.Id, Close: &closeDuplicate}, third.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create issue link")
+	}
+
+	getIssue, err := stores.Issues.GetByID(ctx, third.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get issue")
+	}
+
+	assert.Equal(store.StateClosed, getIssue.State)
+
+	_, err = stores.Issues.Transition(ctx, &api.IssueTransition{State: api.IssueTransitionStateInProgress}, third.Id, testProjectId, testCustomerId)
+	assert.IsType(&store.InvalidTransitionError{}, err)
+
+	err = stores.IssueLinks.Delete(ctx, link.Id, first.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to delete issue link")
+	}
+
+	listLinks, err = stores.IssueLinks.List(ctx, second.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list issue links")
+	}
+
+	assert.Len(listLinks, 1)
+	assert.Equal("blocks", listLinks[0].Type)
+
+	err = stores.IssueLinks.Delete(ctx, link.Id, first.Id, testProjectId, testCustomerId)
+	assert.IsType(&store.IssueLinkNotFoundError{}, err)
+}
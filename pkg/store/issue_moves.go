@@ -0,0 +1,161 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// Move move an issue and its comments to another project of the same customer. The issue is
+// validated against the taxonomy, custom fields and workflow of the target project, custom field
+// values are only kept for fields with the same name in the target project. As hierarchies are
+// limited to a project the issue is detached from its parent and children, and it is removed
+// from its milestone.
+func (is *IssuesPG) Move(ctx context.Context, move *api.IssueMove, id, projectId, customerId, actor string) (*api.Issue, error) {
+	issue, err := is.GetByID(ctx, id, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if move.ProjectId == projectId {
+		return issue, nil
+	}
+
+	var exists bool
+
+	err = is.dbconn.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM projects WHERE id=$1 AND customer_id=$2)", move.ProjectId, customerId).Scan(&exists)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project by id: %s customerId: %s", move.ProjectId, customerId)
+	}
+
+	if !exists {
+		return nil, &ProjectNotFoundError{fmt.Sprintf("id %s", move.ProjectId)}
+	}
+
+	tax, err := loadTaxonomy(ctx, is.dbconn, move.ProjectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := is.fields.List(ctx, move.ProjectId, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list custom fields for projectId: %s customerId: %s", move.ProjectId, customerId)
+	}
+
+	err = db.WithTransaction(ctx, is.dbconn, func(tx db.Transaction) error {
+		// the issue is locked and validated as it is now so a concurrent edit can't be moved
+		locked := &api.Issue{}
+
+		err := scanIssue(tx.QueryRowContext(ctx, "SELECT "+issueColumns+" FROM issues WHERE id=$1 AND project_id=$2 AND customer_id=$3 FOR UPDATE",
+			issue.Id, projectId, customerId), locked)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", issue.Id, projectId)}
+			}
+			return err
+		}
+
+		customFields, err := validateMove(locked, tax, fields)
+		if err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(ctx, "UPDATE issues SET project_id=$1, parent_id=NULL, milestone_id=NULL, custom_fields=$2, updated_at=$3 WHERE id=$4 AND project_id=$5 AND customer_id=$6",
+			move.ProjectId, toHstore(customFields), time.Now(), issue.Id, projectId, customerId)
+		if err != nil {
+			return err
+		}
+
+		// the issue may have been moved by a concurrent request
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", issue.Id, projectId)}
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE issues SET parent_id=NULL WHERE parent_id=$1 AND customer_id=$2", issue.Id, customerId); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE comments SET project_id=$1 WHERE issue_id=$2 AND project_id=$3 AND customer_id=$4", move.ProjectId, issue.Id, projectId, customerId); err != nil {
+			return err
+		}
+
+		// a redirect is no longer needed when the issue returns to a project and earlier redirects follow it
+		if _, err := tx.ExecContext(ctx, "DELETE FROM issue_redirects WHERE project_id=$1 AND issue_id=$2 AND customer_id=$3", move.ProjectId, issue.Id, customerId); err != nil {
+			return err
+		}
+
+		if _, err := tx.ExecContext(ctx, "UPDATE issue_redirects SET target_project_id=$1 WHERE issue_id=$2 AND customer_id=$3", move.ProjectId, issue.Id, customerId); err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO issue_redirects(customer_id, project_id, issue_id, target_project_id) VALUES($1, $2, $3, $4)
+			ON CONFLICT (customer_id, project_id, issue_id) DO UPDATE SET target_project_id = EXCLUDED.target_project_id`, customerId, projectId, issue.Id, move.ProjectId)
+		if err != nil {
+			return err
+		}
+
+		// the target project may have its own sla policy
+		if _, err := syncIssueSLA(ctx, tx, issue.Id, customerId, time.Now()); err != nil {
+			return err
+		}
+
+		return recordActivity(ctx, tx, ActionMoved, issue.Id, customerId, actor, map[string]string{
+			"from_project_id": projectId,
+			"to_project_id":   move.ProjectId,
+		})
+	})
+	if err != nil {
+		switch err.(type) {
+		case *IssueNotFoundError, *IssueValidationError:
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to move issue by id: %s from projectId: %s to projectId: %s customerId: %s", issue.Id, projectId, move.ProjectId, customerId)
+	}
+
+	return is.GetByID(ctx, issue.Id, move.ProjectId, customerId)
+}
+
+// validateMove validate an issue against the taxonomy and custom fields of the target project,
+// returning the custom field values it keeps.
+func validateMove(issue *api.Issue, tax *taxonomy, fields []api.CustomField) (map[string]string, error) {
+	if err := tax.validate(issue.Severity, issue.Category); err != nil {
+		return nil, err
+	}
+
+	// the workflow is shared by all projects, labels are free form so they move unchanged
+	if _, ok := issueTransitions[issue.State]; !ok {
+		return nil, &IssueValidationError{fmt.Sprintf("state %s is not in the workflow", issue.State)}
+	}
+
+	values := &api.CustomFieldValues{}
+	for name, value := range issue.CustomFields.AdditionalProperties {
+		if _, ok := customFieldByName(fields, name); ok {
+			values.Set(name, value)
+		}
+	}
+
+	return applyCustomFields(fields, values)
+}
+
+// GetRedirect get the project an issue has moved to from the project, the issue may be
+// identified by id or key.
+func (is *IssuesPG) GetRedirect(ctx context.Context, id, projectId, customerId string) (string, error) {
+	var targetProjectId string
+
+	err := is.dbconn.QueryRowContext(ctx, `SELECT r.target_project_id FROM issue_redirects r
+		JOIN issues i ON i.id = r.issue_id AND i.customer_id = r.customer_id
+		WHERE i.`+issueIDColumn(id)+`=$1 AND r.project_id=$2 AND r.customer_id=$3`, id, projectId, customerId).Scan(&targetProjectId)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
+		}
+		return "", errors.Wrapf(err, "failed to get issue redirect by id: %s projectId: %s customerId: %s", id, projectId, customerId)
+	}
+
+	return targetProjectId, nil
+}
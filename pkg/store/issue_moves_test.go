@@ -0,0 +1,117 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestIssues_Move(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	src, err := stores.Projects.Create(ctx, &api.NewProject{Name: "source", Key: strPtr("SRC"), Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	dst, err := stores.Projects.Create(ctx, &api.NewProject{Name: "destination", Key: strPtr("DST"), Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	_, err = stores.Taxonomies.UpdateByProjectID(ctx, &api.UpdatedTaxonomy{Categories: &[]string{"support"}}, dst.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update project taxonomy")
+	}
+
+	bug, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "bug", Category: "bug", Labels: []string{}}, src.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	_, err = stores.Issues.Move(ctx, &api.IssueMove{ProjectId: dst.Id}, bug.Id, src.Id, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "misfiled", Category: "support", Labels: []string{"test"}}, src.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	_, err = stores.Comments.Create(ctx, &api.NewComment{Content: "test comment"}, newIssue.Id, src.Id, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	moved, err := stores.Issues.Move(ctx, &api.IssueMove{ProjectId: dst.Id}, "SRC-2", src.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to move issue")
+	}
+
+	assert.Equal(newIssue.Id, moved.Id)
+	assert.Equal("SRC-2", *moved.Key)
+
+	listComments, err := stores.Comments.List(ctx, store.NewCommentListOptions("", 0, 100), newIssue.Id, dst.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list comments")
+	}
+
+	assert.Len(listComments, 1)
+
+	_, err = stores.Issues.GetByID(ctx, newIssue.Id, src.Id, testCustomerId)
+	assert.IsType(&store.IssueNotFoundError{}, err)
+
+	targetProjectId, err := stores.Issues.GetRedirect(ctx, "SRC-2", src.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get redirect")
+	}
+
+	assert.Equal(dst.Id, targetProjectId)
+
+	activity, err := stores.Activity.List(ctx, newIssue.Id, dst.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list activity")
+	}
+
+	assert.Len(activity, 1)
+	assert.Equal(store.ActionMoved, activity[0].Action)
+	assert.Equal(map[string]string{"from_project_id": src.Id, "to_project_id": dst.Id}, activity[0].Details.AdditionalProperties)
+
+	// labels are free form so they move unchanged to a project with other labels
+	labelled, err := stores.Projects.Create(ctx, &api.NewProject{Name: "labelled", Key: strPtr("LBL"), Labels: []string{"frontend"}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	tagged, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "tagged", Labels: []string{"frontend", "backend"}}, src.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	tagged, err = stores.Issues.Move(ctx, &api.IssueMove{ProjectId: labelled.Id}, tagged.Id, src.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to move issue")
+	}
+
+	assert.Equal([]string{"frontend", "backend"}, tagged.Labels)
+
+	_, err = stores.Issues.Move(ctx, &api.IssueMove{ProjectId: testProjectId}, newIssue.Id, dst.Id, testCustomerId, testReporter)
+	assert.IsType(&store.ProjectNotFoundError{}, err)
+}
@@ -0,0 +1,307 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/calendar"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// issueSLAColumns the columns of the clocks of an issue, the columns of each clock are prefixed with its name.
+const issueSLAColumns = `policy_id,
+	first_response_target_minutes, first_response_status, first_response_due_at, first_response_at_risk_at, first_response_completed_at, first_response_breached_at,
+	resolution_target_minutes, resolution_status, resolution_due_at, resolution_at_risk_at, resolution_completed_at, resolution_breached_at`
+
+// slaSegment a period the clock was running, end is zero while the clock is still running.
+type slaSegment struct {
+	start time.Time
+	end   time.Time
+}
+
+// slaClock the progress of an issue against a target.
+type slaClock struct {
+	targetMinutes int
+	status        string
+	dueAt         *time.Time
+	atRiskAt      *time.Time
+	completedAt   *time.Time
+	breachedAt    *time.Time
+}
+
+// trackSLA measures the segments against the target in business time. Clocks which are still
+// running are due when the remaining target elapses, stopped clocks are met or were breached
+// part way through a segment.
+func trackSLA(cal *calendar.Calendar, targetMinutes, atRiskPercent int, segments []slaSegment, now time.Time) *slaClock {
+	clock := &slaClock{targetMinutes: targetMinutes, status: string(api.SlaClockStatusOk)}
+
+	target := time.Duration(targetMinutes) * time.Minute
+	atRisk := target * time.Duration(atRiskPercent) / 100
+
+	var elapsed time.Duration
+
+	for _, seg := range segments {
+		if seg.end.IsZero() {
+			// only the last segment can be running
+			if clock.breachedAt == nil {
+				clock.dueAt = timePtr(cal.Add(seg.start, target-elapsed))
+				clock.atRiskAt = timePtr(cal.Add(seg.start, atRisk-elapsed))
+			}
+			break
+		}
+
+		segElapsed := cal.Elapsed(seg.start, seg.end)
+		if clock.breachedAt == nil && elapsed+segElapsed > target {
+			clock.breachedAt = timePtr(cal.Add(seg.start, target-elapsed))
+		}
+		elapsed += segElapsed
+	}
+
+	running := len(segments) > 0 && segments[len(segments)-1].end.IsZero()
+	if !running && len(segments) > 0 {
+		clock.completedAt = timePtr(segments[len(segments)-1].end)
+	}
+
+	switch {
+	case clock.breachedAt != nil:
+		clock.status = string(api.SlaClockStatusBreached)
+	case !running:
+		clock.status = string(api.SlaClockStatusMet)
+	case !clock.dueAt.After(now):
+		clock.status = string(api.SlaClockStatusBreached)
+		clock.breachedAt = clock.dueAt
+	case !clock.atRiskAt.After(now):
+		clock.status = string(api.SlaClockStatusAtRisk)
+	}
+
+	return clock
+}
+
+// syncIssueSLA recomputes the SLA clocks of an issue from its history, this is called in the
+// transaction of every change which can start, stop or retarget a clock. Issues without a
+// policy or a target for their severity aren't tracked.
+func syncIssueSLA(ctx context.Context, tx db.Transaction, id, customerId string, now time.Time) (*api.IssueSla, error) {
+	var (
+		projectId, reporter string
+		severity            sql.NullString
+		createdAt           time.Time
+	)
+
+	err := tx.QueryRowContext(ctx, "SELECT project_id, reporter, severity, created_at FROM issues WHERE id=$1 AND customer_id=$2",
+		id, customerId).Scan(&projectId, &reporter, &severity, &createdAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &IssueNotFoundError{fmt.Sprintf("id %s", id)}
+		}
+		return nil, err
+	}
+
+	policy, err := loadSLAPolicy(ctx, tx, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	var target *api.SlaTarget
+	if policy != nil {
+		target = policy.target(severity.String)
+	}
+
+	if target == nil {
+		_, err := tx.ExecContext(ctx, "DELETE FROM issue_sla WHERE issue_id=$1 AND customer_id=$2", id, customerId)
+		return nil, err
+	}
+
+	// the resolution clock is stopped while the issue is resolved or closed
+	resolution := []slaSegment{{start: createdAt}}
+	var resolvedAt time.Time
+
+	rows, err := tx.QueryContext(ctx, "SELECT from_state, to_state, created_at FROM issue_state_changes WHERE issue_id=$1 AND customer_id=$2 ORDER BY created_at",
+		id, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			from, to  string
+			changedAt time.Time
+		)
+
+		if err := rows.Scan(&from, &to, &changedAt); err != nil {
+			return nil, err
+		}
+
+		last := &resolution[len(resolution)-1]
+		switch {
+		case containsString(doneStates, to) && !containsString(doneStates, from) && last.end.IsZero():
+			last.end = changedAt
+			if resolvedAt.IsZero() {
+				resolvedAt = changedAt
+			}
+		case containsString(doneStates, from) && !containsString(doneStates, to) && !last.end.IsZero():
+			resolution = append(resolution, slaSegment{start: changedAt})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	cal, err := policy.calendar()
+	if err != nil {
+		return nil, err
+	}
+
+	var firstResponse, resolve *slaClock
+
+	if target.FirstResponseMinutes != nil {
+		// comments are soft deleted so a deleted response still counts
+		var respondedAt sql.NullTime
+
+		err := tx.QueryRowContext(ctx, "SELECT min(created_at) FROM comments WHERE issue_id=$1 AND customer_id=$2 AND author <> $3",
+			id, customerId, reporter).Scan(&respondedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		// resolving an issue without a response also stops the clock
+		seg := slaSegment{start: createdAt}
+		switch {
+		case respondedAt.Valid && (resolvedAt.IsZero() || respondedAt.Time.Before(resolvedAt)):
+			seg.end = respondedAt.Time
+		case !resolvedAt.IsZero():
+			seg.end = resolvedAt
+		}
+
+		firstResponse = trackSLA(cal, *target.FirstResponseMinutes, policy.AtRiskPercent, []slaSegment{seg}, now)
+	}
+
+	if target.ResolutionMinutes != nil {
+		resolve = trackSLA(cal, *target.ResolutionMinutes, policy.AtRiskPercent, resolution, now)
+	}
+
+	args := []interface{}{customerId, id, policy.Id}
+	for _, clock := range []*slaClock{firstResponse, resolve} {
+		if clock == nil {
+			args = append(args, nil, nil, nil, nil, nil, nil)
+			continue
+		}
+		args = append(args, clock.targetMinutes, clock.status, clock.dueAt, clock.atRiskAt, clock.completedAt, clock.breachedAt)
+	}
+	args = append(args, now)
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO issue_sla(customer_id, issue_id, `+issueSLAColumns+`, updated_at)
+		VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		ON CONFLICT (customer_id, issue_id) DO UPDATE SET
+			policy_id = EXCLUDED.policy_id,
+			first_response_target_minutes = EXCLUDED.first_response_target_minutes,
+			first_response_status = EXCLUDED.first_response_status,
+			first_response_due_at = EXCLUDED.first_response_due_at,
+			first_response_at_risk_at = EXCLUDED.first_response_at_risk_at,
+			first_response_completed_at = EXCLUDED.first_response_completed_at,
+			first_response_breached_at = EXCLUDED.first_response_breached_at,
+			resolution_target_minutes = EXCLUDED.resolution_target_minutes,
+			resolution_status = EXCLUDED.resolution_status,
+			resolution_due_at = EXCLUDED.resolution_due_at,
+			resolution_at_risk_at = EXCLUDED.resolution_at_risk_at,
+			resolution_completed_at = EXCLUDED.resolution_completed_at,
+			resolution_breached_at = EXCLUDED.resolution_breached_at,
+			updated_at = EXCLUDED.updated_at`, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.IssueSla{PolicyId: policy.Id, FirstResponse: firstResponse.toAPI(), Resolution: resolve.toAPI()}, nil
+}
+
+func (c *slaClock) toAPI() *api.SlaClock {
+	if c == nil {
+		return nil
+	}
+
+	return &api.SlaClock{
+		TargetMinutes: c.targetMinutes,
+		Status:        api.SlaClockStatus(c.status),
+		DueAt:         c.dueAt,
+		CompletedAt:   c.completedAt,
+		BreachedAt:    c.breachedAt,
+	}
+}
+
+// scanIssueSLA scans the columns of issueSLAColumns after any leading columns of the row.
+func scanIssueSLA(row rowScanner, dest ...interface{}) (*api.IssueSla, error) {
+	sla := &api.IssueSla{}
+	clocks := [2]api.SlaClock{}
+	targets := [2]sql.NullInt64{}
+	statuses := [2]sql.NullString{}
+
+	var atRiskAt *time.Time
+
+	dest = append(dest, &sla.PolicyId)
+	for i := range clocks {
+		dest = append(dest, &targets[i], &statuses[i], &clocks[i].DueAt, &atRiskAt, &clocks[i].CompletedAt, &clocks[i].BreachedAt)
+	}
+
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+
+	for i := range clocks {
+		clocks[i].TargetMinutes = int(targets[i].Int64)
+		clocks[i].Status = api.SlaClockStatus(statuses[i].String)
+	}
+
+	if targets[0].Valid {
+		sla.FirstResponse = &clocks[0]
+	}
+
+	if targets[1].Valid {
+		sla.Resolution = &clocks[1]
+	}
+
+	return sla, nil
+}
+
+// loadSLAs loads the SLA clocks of a page of issues.
+func loadSLAs(ctx context.Context, q queryer, issues []api.Issue, customerId string) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(issues))
+	idx := map[string]*api.Issue{}
+	for i := range issues {
+		ids[i] = issues[i].Id
+		idx[issues[i].Id] = &issues[i]
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT issue_id, "+issueSLAColumns+" FROM issue_sla WHERE customer_id=$1 AND issue_id = ANY($2::uuid[])",
+		customerId, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var issueId string
+
+		sla, err := scanIssueSLA(rows, &issueId)
+		if err != nil {
+			return err
+		}
+
+		if issue, ok := idx[issueId]; ok {
+			issue.Sla = sla
+		}
+	}
+
+	return rows.Err()
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/keegancsmith/sqlf"
 	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
 	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
 	"github.com/wolfeidau/exitus/pkg/api"
 	"github.com/wolfeidau/exitus/pkg/conf"
 	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/markdown"
 )
 
 // IssueNotFoundError occurs when an issue is not found.
@@ -23,17 +27,56 @@ func (e *IssueNotFoundError) Error() string {
 	return fmt.Sprintf("issue not found: %s", e.Message)
 }
 
+// IssueValidationError occurs when an issue has values which aren't allowed in the project.
+type IssueValidationError struct {
+	Message string
+}
+
+func (e *IssueValidationError) Error() string {
+	return fmt.Sprintf("invalid issue: %s", e.Message)
+}
+
+// SortFieldError occurs when a list is sorted using an unknown field.
+type SortFieldError struct {
+	Field string
+}
+
+func (e *SortFieldError) Error() string {
+	return fmt.Sprintf("unknown sort field: %s", e.Field)
+}
+
+// FilterError occurs when a list is filtered using an invalid filter.
+type FilterError struct {
+	Message string
+}
+
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("invalid filter: %s", e.Message)
+}
+
 // Issues provides a issues store.
 type Issues interface {
 	GetByID(ctx context.Context, id, projectId, customerId string) (*api.Issue, error)
 	Create(ctx context.Context, newProj *api.NewIssue, projectId, customerId, reporter string) (*api.Issue, error)
 	Update(ctx context.Context, updatedIssue *api.UpdatedIssue, id, projectId, customerId string) (*api.Issue, error)
 	List(ctx context.Context, opt *IssueListOptions, projectId, customerId string) ([]api.Issue, error)
+	Stream(ctx context.Context, opt *IssueListOptions, projectId, customerId string, fn func(issue *api.Issue) error) error
+	Transition(ctx context.Context, transition *api.IssueTransition, id, projectId, customerId string) (*api.Issue, error)
+	ListChildren(ctx context.Context, id, projectId, customerId string) ([]api.Issue, error)
+	Move(ctx context.Context, move *api.IssueMove, id, projectId, customerId, actor string) (*api.Issue, error)
+	GetRedirect(ctx context.Context, id, projectId, customerId string) (string, error)
+	ListRevisions(ctx context.Context, id, projectId, customerId string) ([]api.IssueRevision, error)
+	Vote(ctx context.Context, id, projectId, customerId, userId string) (*api.Issue, error)
+	Unvote(ctx context.Context, id, projectId, customerId, userId string) (*api.Issue, error)
+	ListWatched(ctx context.Context, opt *LimitOffset, customerId, userId string) ([]api.Issue, error)
+	BulkUpdate(ctx context.Context, bulk *api.IssueBulkUpdate, projectId, customerId, actor string) (*api.IssueBulkResults, error)
 }
 
 // IssueListOptions specifies the options for listing issues.
 type IssueListOptions struct {
 	*SubjectLikeOptions
+	*IssueFilterOptions
+	*IssueSortOptions
 	*LimitOffset
 }
 
@@ -61,20 +104,188 @@ func ListSubjectLikeSQL(opt *SubjectLikeOptions) (conds []*sqlf.Query) {
 	return conds
 }
 
+// customFieldPrefix the prefix used to sort or filter issues by a custom field.
+const customFieldPrefix = "cf."
+
+// severitySortSQL resolves the weight of an issues severity using the project taxonomy, falling
+// back to the customer taxonomy. The weight is the inverse of the rank so the most severe issues
+// are first when sorting descending, this is NULL when the severity isn't ranked.
+const severitySortSQL = `(SELECT array_length(s.severities, 1) + 1 - array_position(s.severities, issues.severity) FROM (SELECT COALESCE(
+	(SELECT p.severities FROM projects p WHERE p.id = issues.project_id AND p.customer_id = issues.customer_id),
+	(SELECT c.severities FROM customers c WHERE c.id = issues.customer_id)) AS severities) s)`
+
+var issueSortColumns = map[string]string{
+	"created_at": "created_at",
+	"updated_at": "updated_at",
+	"due_at":     "due_at",
+	"subject":    "subject",
+	"severity":   severitySortSQL,
+	"votes":      "votes",
+}
+
+// IssueSortOptions used to order issues by a field.
+type IssueSortOptions struct {
+	// Field the name of the field to sort by.
+	Field string
+	// Desc sort in descending order.
+	Desc bool
+}
+
+// NewIssueSortOptions parse a sort such as "-severity" into sort options, an empty sort
+// returns nil which orders by id.
+func NewIssueSortOptions(sort string) (*IssueSortOptions, error) {
+	if sort == "" {
+		return nil, nil
+	}
+
+	opt := &IssueSortOptions{Field: strings.TrimPrefix(sort, "-"), Desc: strings.HasPrefix(sort, "-")}
+	if _, ok := issueSortColumns[opt.Field]; !ok && !strings.HasPrefix(opt.Field, customFieldPrefix) {
+		return nil, &SortFieldError{opt.Field}
+	}
+
+	return opt, nil
+}
+
+// SQL returns the SQL query fragment ("ORDER BY ...") for use in SQL queries, custom fields
+// are sorted using the type of the field defined in the project.
+func (o *IssueSortOptions) SQL(fields []api.CustomField) (*sqlf.Query, error) {
+	if o == nil {
+		return sqlf.Sprintf("ORDER BY id ASC"), nil
+	}
+
+	dir := "ASC"
+	if o.Desc {
+		dir = "DESC"
+	}
+
+	if !strings.HasPrefix(o.Field, customFieldPrefix) {
+		return sqlf.Sprintf("ORDER BY " + issueSortColumns[o.Field] + " " + dir + " NULLS LAST, id ASC"), nil
+	}
+
+	field, ok := customFieldByName(fields, strings.TrimPrefix(o.Field, customFieldPrefix))
+	if !ok {
+		return nil, &SortFieldError{o.Field}
+	}
+
+	return sqlf.Sprintf("ORDER BY "+customFieldSQL(field)+" "+dir+" NULLS LAST, id ASC", field.Name), nil
+}
+
+// customFieldSQL returns an expression which selects the value of a custom field as its type,
+// the field name is bound as a parameter.
+func customFieldSQL(field *api.CustomField) string {
+	switch api.NewCustomFieldType(field.Type) {
+	case api.NewCustomFieldTypeNumber:
+		return "(custom_fields -> %s::text)::numeric"
+	case api.NewCustomFieldTypeDate:
+		return "(custom_fields -> %s::text)::date"
+	}
+
+	return "(custom_fields -> %s::text)"
+}
+
+// IssueFilter filters issues where a field matches a value.
+type IssueFilter struct {
+	Field string
+	Value string
+}
+
+// IssueFilterOptions used to filter issues by field values, all filters must match.
+type IssueFilterOptions struct {
+	Filters []IssueFilter
+	// DueBefore only include issues due before this time.
+	DueBefore *time.Time
+	// Overdue only include open issues which are past their due date.
+	Overdue bool
+}
+
+// NewIssueFilterOptions parse filters in the form field:value into filter options.
+func NewIssueFilterOptions(filters []string) (*IssueFilterOptions, error) {
+	opt := &IssueFilterOptions{}
+
+	for _, filter := range filters {
+		idx := strings.IndexByte(filter, ':')
+		if idx < 1 {
+			return nil, &FilterError{fmt.Sprintf("%q must be in the form field:value", filter)}
+		}
+
+		opt.Filters = append(opt.Filters, IssueFilter{Field: filter[:idx], Value: filter[idx+1:]})
+	}
+
+	return opt, nil
+}
+
+// SQL returns the SQL conditions for the filters, custom fields are matched using the type of
+// the field defined in the project.
+func (o *IssueFilterOptions) SQL(fields []api.CustomField) ([]*sqlf.Query, error) {
+	conds := []*sqlf.Query{}
+	if o == nil {
+		return conds, nil
+	}
+
+	if o.DueBefore != nil {
+		conds = append(conds, sqlf.Sprintf("due_at < %s", *o.DueBefore))
+	}
+
+	if o.Overdue {
+		conds = append(conds, sqlf.Sprintf("due_at < now() AND state <> ALL(%s)", pq.Array(doneStates)))
+	}
+
+	for _, filter := range o.Filters {
+		switch filter.Field {
+		case "state", "severity", "category":
+			conds = append(conds, sqlf.Sprintf(filter.Field+" = %s", filter.Value))
+			continue
+		case "label":
+			conds = append(conds, sqlf.Sprintf("%s = ANY(labels)", filter.Value))
+			continue
+		case "milestone_id":
+			if _, err := uuid.FromString(filter.Value); err != nil {
+				return nil, &FilterError{fmt.Sprintf("%s is not a valid milestone identifier", filter.Value)}
+			}
+			conds = append(conds, sqlf.Sprintf("milestone_id = %s", filter.Value))
+			continue
+		}
+
+		if !strings.HasPrefix(filter.Field, customFieldPrefix) {
+			return nil, &FilterError{fmt.Sprintf("unknown field %s", filter.Field)}
+		}
+
+		field, ok := customFieldByName(fields, strings.TrimPrefix(filter.Field, customFieldPrefix))
+		if !ok {
+			return nil, &FilterError{fmt.Sprintf("unknown field %s", filter.Field)}
+		}
+
+		if err := validateCustomFieldValue(field, filter.Value); err != nil {
+			return nil, &FilterError{fmt.Sprintf("%s is not a valid %s", filter.Value, field.Type)}
+		}
+
+		switch api.NewCustomFieldType(field.Type) {
+		case api.NewCustomFieldTypeNumber, api.NewCustomFieldTypeDate:
+			conds = append(conds, sqlf.Sprintf(customFieldSQL(field)+" = %s", field.Name, filter.Value))
+		default:
+			// containment is used so the GIN index on custom_fields can be used
+			conds = append(conds, sqlf.Sprintf("custom_fields @> hstore(%s::text, %s::text)", field.Name, filter.Value))
+		}
+	}
+
+	return conds, nil
+}
+
 // IssuesPG provides a issues store for postgresql.
 type IssuesPG struct {
 	dbconn *sql.DB
 	cfg    *conf.Config
+	fields *CustomFieldsPG
 }
 
 // NewIssues new issues store.
 func NewIssues(dbconn *sql.DB, cfg *conf.Config) Issues {
-	return &IssuesPG{dbconn: dbconn, cfg: cfg}
+	return &IssuesPG{dbconn: dbconn, cfg: cfg, fields: &CustomFieldsPG{dbconn: dbconn, cfg: cfg}}
 }
 
-// GetByID get issue by id.
+// GetByID get issue by id or key.
 func (is *IssuesPG) GetByID(ctx context.Context, id, projectId, customerId string) (*api.Issue, error) {
-	issues, err := is.getBySQL(ctx, "WHERE id=$1 AND project_id=$2 AND customer_id=$3 LIMIT 1", id, projectId, customerId)
+	issues, err := is.getBySQL(ctx, "WHERE "+issueIDColumn(id)+"=$1 AND project_id=$2 AND customer_id=$3 LIMIT 1", id, projectId, customerId)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get issue by id: %s projectId: %s customerId: %s", id, projectId, customerId)
 	}
@@ -83,57 +294,495 @@ func (is *IssuesPG) GetByID(ctx context.Context, id, projectId, customerId strin
 		return nil, &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
 	}
 
+	if err := loadChildCounts(ctx, is.dbconn, issues, customerId); err != nil {
+		return nil, errors.Wrapf(err, "failed to count children of issue by id: %s customerId: %s", id, customerId)
+	}
+
+	if err := loadSLAs(ctx, is.dbconn, issues, customerId); err != nil {
+		return nil, errors.Wrapf(err, "failed to load sla of issue by id: %s customerId: %s", id, customerId)
+	}
+
 	return &issues[0], nil
 }
 
 // Create create new issue.
 func (is *IssuesPG) Create(ctx context.Context, newIssue *api.NewIssue, projectId, customerId, reporter string) (*api.Issue, error) {
-	issue := api.Issue{}
+	ins, err := is.prepareInsert(ctx, newIssue, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
 
-	qry := sqlf.Sprintf("INSERT INTO issues(project_id, customer_id, reporter, subject, state, severity, category, labels, content) VALUES(%s, %s, %s, %s, %s, %s, %s, %s, %s)",
-		projectId, customerId, reporter, newIssue.Subject, "created", newIssue.Severity, newIssue.Category, pq.Array(newIssue.Labels), newIssue.Content)
+	issue := api.Issue{}
 
-	err := db.WithTransaction(ctx, is.dbconn, func(tx db.Transaction) error {
-		return tx.QueryRowContext(
-			ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING id, subject, state, severity, category, labels, content, created_at, updated_at", qry.Args()...,
-		).Scan(&issue.Id, &issue.Subject, &issue.State, &issue.Severity, &issue.Category, pq.Array(&issue.Labels), &issue.Content, &issue.CreatedAt, &issue.UpdatedAt)
+	err = db.WithTransaction(ctx, is.dbconn, func(tx db.Transaction) error {
+		return ins.insert(ctx, tx, reporter, &issue)
 	})
 	if err != nil {
+		if _, ok := err.(*IssueValidationError); ok {
+			return nil, err
+		}
 		return nil, errors.Wrapf(err, "failed to create issue with subject: %s, customer_id: %s", newIssue.Subject, customerId)
 	}
 
 	return &issue, nil
 }
 
+// issueInsert a new issue which has been validated against the project.
+type issueInsert struct {
+	newIssue     *api.NewIssue
+	projectId    string
+	customerId   string
+	parentId     *string
+	assigneeId   *string
+	dueAt        *time.Time
+	milestoneId  *string
+	customFields map[string]string
+}
+
+// prepareInsert validates a new issue against the taxonomy and custom fields of the project,
+// this is done before the transaction which inserts it.
+func (is *IssuesPG) prepareInsert(ctx context.Context, newIssue *api.NewIssue, projectId, customerId string) (*issueInsert, error) {
+	tax, err := loadTaxonomy(ctx, is.dbconn, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tax.validate(newIssue.Severity, newIssue.Category); err != nil {
+		return nil, err
+	}
+
+	fields, err := is.fields.List(ctx, projectId, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list custom fields for projectId: %s customerId: %s", projectId, customerId)
+	}
+
+	customFields, err := applyCustomFields(fields, newIssue.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+
+	ins := &issueInsert{newIssue: newIssue, projectId: projectId, customerId: customerId, customFields: customFields}
+
+	if newIssue.ParentId != nil && *newIssue.ParentId != "" {
+		ins.parentId = newIssue.ParentId
+	}
+
+	if newIssue.AssigneeId != nil && *newIssue.AssigneeId != "" {
+		if err := validateAssignee(*newIssue.AssigneeId); err != nil {
+			return nil, err
+		}
+		ins.assigneeId = newIssue.AssigneeId
+	}
+
+	if newIssue.DueAt != nil && *newIssue.DueAt != "" {
+		dueAt, err := parseDueAt(*newIssue.DueAt)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateDueAt(dueAt, time.Now()); err != nil {
+			return nil, err
+		}
+		ins.dueAt = &dueAt
+	}
+
+	if newIssue.MilestoneId != nil && *newIssue.MilestoneId != "" {
+		ins.milestoneId = newIssue.MilestoneId
+	}
+
+	return ins, nil
+}
+
+// insert inserts the issue in the transaction along with the watchers, notifications, mentions
+// and first revision which go with it.
+func (ins *issueInsert) insert(ctx context.Context, tx db.Transaction, reporter string, issue *api.Issue) error {
+	projectId, customerId, newIssue := ins.projectId, ins.customerId, ins.newIssue
+
+	if ins.parentId != nil {
+		if err := checkIssueParent(ctx, tx, *ins.parentId, "", projectId, customerId); err != nil {
+			return err
+		}
+	}
+
+	if ins.milestoneId != nil {
+		if err := checkIssueMilestone(ctx, tx, *ins.milestoneId, projectId, customerId); err != nil {
+			return err
+		}
+	}
+
+	key, err := allocateIssueKey(ctx, tx, projectId, customerId)
+	if err != nil {
+		return err
+	}
+
+	qry := sqlf.Sprintf("INSERT INTO issues(project_id, customer_id, reporter, assignee, parent_id, due_at, milestone_id, key, subject, state, severity, category, labels, custom_fields, content, content_html, content_html_version) VALUES(%s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s, %s)",
+		projectId, customerId, reporter, ins.assigneeId, ins.parentId, ins.dueAt, ins.milestoneId, key, newIssue.Subject, StateCreated, newIssue.Severity, newIssue.Category, pq.Array(newIssue.Labels), toHstore(ins.customFields), newIssue.Content, markdown.Render(newIssue.Content), markdown.Version)
+
+	err = scanIssue(tx.QueryRowContext(
+		ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING "+issueColumns, qry.Args()...,
+	), issue)
+	if err != nil {
+		return err
+	}
+
+	if err := addWatcher(ctx, tx, issue.Id, customerId, reporter, api.WatcherReasonReporter); err != nil {
+		return err
+	}
+
+	if ins.assigneeId != nil {
+		if err := addWatcher(ctx, tx, issue.Id, customerId, *ins.assigneeId, api.WatcherReasonAssignee); err != nil {
+			return err
+		}
+
+		if err := notifyUsers(ctx, tx, api.NotificationTypeAssigned, issue.Id, nil, customerId, reporter, nil, []string{*ins.assigneeId}); err != nil {
+			return err
+		}
+	}
+
+	if err := recordMentions(ctx, tx, newIssue.Content, issue.Id, nil, customerId, reporter); err != nil {
+		return err
+	}
+
+	if issue.Sla, err = syncIssueSLA(ctx, tx, issue.Id, customerId, time.Now()); err != nil {
+		return err
+	}
+
+	return recordIssueRevision(ctx, tx, issue.Id, customerId)
+}
+
+// Update update an issue by id or key.
 func (is *IssuesPG) Update(ctx context.Context, updatedIssue *api.UpdatedIssue, id, projectId, customerId string) (*api.Issue, error) {
+	// keys are resolved up front as the remaining queries use the id
+	if issueIDColumn(id) == "key" {
+		issue, err := is.GetByID(ctx, id, projectId, customerId)
+		if err != nil {
+			return nil, err
+		}
+		id = issue.Id
+	}
+
+	tax, err := loadTaxonomy(ctx, is.dbconn, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tax.validate(updatedIssue.Severity, updatedIssue.Category); err != nil {
+		return nil, err
+	}
+
 	fields := []*sqlf.Query{sqlf.Sprintf("subject=%s, content=%s, severity=%s, category=%s, labels=%s, updated_at=%s", updatedIssue.Subject, updatedIssue.Content, updatedIssue.Severity, updatedIssue.Category, pq.Array(updatedIssue.Labels), time.Now())}
 
-	qry := sqlf.Sprintf("UPDATE issues SET %s WHERE id=%s AND customer_id=%s", sqlf.Join(fields, ","), id, customerId)
+	// the rendered content is replaced along with the content
+	fields = append(fields, sqlf.Sprintf("content_html=%s, content_html_version=%s", markdown.Render(updatedIssue.Content), markdown.Version))
+
+	// custom fields are left as is when they aren't provided
+	if updatedIssue.CustomFields != nil {
+		defs, err := is.fields.List(ctx, projectId, customerId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list custom fields for projectId: %s customerId: %s", projectId, customerId)
+		}
+
+		customFields, err := applyCustomFields(defs, updatedIssue.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = append(fields, sqlf.Sprintf("custom_fields=%s", toHstore(customFields)))
+	}
+
+	// the parent is left as is when it isn't provided and removed when it is empty
+	var parentId string
+	if updatedIssue.ParentId != nil {
+		parentId = *updatedIssue.ParentId
+		if parentId == "" {
+			fields = append(fields, sqlf.Sprintf("parent_id=NULL"))
+		} else {
+			fields = append(fields, sqlf.Sprintf("parent_id=%s", parentId))
+		}
+	}
+
+	// the assignee is left as is when it isn't provided and removed when it is empty
+	var assigneeId string
+	if updatedIssue.AssigneeId != nil {
+		assigneeId = *updatedIssue.AssigneeId
+		if assigneeId == "" {
+			fields = append(fields, sqlf.Sprintf("assignee=NULL"))
+		} else {
+			if err := validateAssignee(assigneeId); err != nil {
+				return nil, err
+			}
+			fields = append(fields, sqlf.Sprintf("assignee=%s", assigneeId))
+		}
+	}
+
+	// the due date is left as is when it isn't provided and removed when it is empty
+	var dueAt *time.Time
+	if updatedIssue.DueAt != nil {
+		if *updatedIssue.DueAt == "" {
+			fields = append(fields, sqlf.Sprintf("due_at=NULL"))
+		} else {
+			parsed, err := parseDueAt(*updatedIssue.DueAt)
+			if err != nil {
+				return nil, err
+			}
+			dueAt = &parsed
+			fields = append(fields, sqlf.Sprintf("due_at=%s", parsed))
+		}
+	}
+
+	// the milestone is left as is when it isn't provided and removed when it is empty
+	var milestoneId string
+	if updatedIssue.MilestoneId != nil {
+		milestoneId = *updatedIssue.MilestoneId
+		if milestoneId == "" {
+			fields = append(fields, sqlf.Sprintf("milestone_id=NULL"))
+		} else {
+			fields = append(fields, sqlf.Sprintf("milestone_id=%s", milestoneId))
+		}
+	}
+
+	qry := sqlf.Sprintf("UPDATE issues SET %s WHERE id=%s AND project_id=%s AND customer_id=%s", sqlf.Join(fields, ","), id, projectId, customerId)
+
+	err = db.WithTransaction(ctx, is.dbconn, func(tx db.Transaction) error {
+		// an unchanged due date may have passed, only a new one must be in the future
+		if dueAt != nil {
+			if err := checkDueAtChange(ctx, tx, *dueAt, id, customerId); err != nil {
+				return err
+			}
+		}
 
-	if _, err := is.dbconn.ExecContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...); err != nil {
+		if parentId != "" {
+			if err := checkIssueParent(ctx, tx, parentId, id, projectId, customerId); err != nil {
+				return err
+			}
+		}
+
+		if milestoneId != "" {
+			if err := checkIssueMilestone(ctx, tx, milestoneId, projectId, customerId); err != nil {
+				return err
+			}
+		}
+
+		// the previous assignee is locked so only a change of assignee is notified
+		var previousAssignee sql.NullString
+		if assigneeId != "" {
+			err := tx.QueryRowContext(ctx, "SELECT assignee::text FROM issues WHERE id=$1 AND customer_id=$2 FOR UPDATE", id, customerId).Scan(&previousAssignee)
+			if err != nil && err != sql.ErrNoRows {
+				return err
+			}
+		}
+
+		// mentions in the content of an issue are attributed to the reporter
+		var reporter string
+		if err := tx.QueryRowContext(ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING reporter", qry.Args()...).Scan(&reporter); err != nil {
+			if err == sql.ErrNoRows {
+				return &IssueNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
+			}
+			return err
+		}
+
+		if assigneeId != "" && assigneeId != previousAssignee.String {
+			if err := addWatcher(ctx, tx, id, customerId, assigneeId, api.WatcherReasonAssignee); err != nil {
+				return err
+			}
+
+			if err := notifyUsers(ctx, tx, api.NotificationTypeAssigned, id, nil, customerId, "", nil, []string{assigneeId}); err != nil {
+				return err
+			}
+		}
+
+		if err := recordMentions(ctx, tx, updatedIssue.Content, id, nil, customerId, reporter); err != nil {
+			return err
+		}
+
+		// the severity picks the sla target
+		if _, err := syncIssueSLA(ctx, tx, id, customerId, time.Now()); err != nil {
+			return err
+		}
+
+		return recordIssueRevision(ctx, tx, id, customerId)
+	})
+	if err != nil {
+		switch err.(type) {
+		case *IssueNotFoundError, *IssueValidationError:
+			return nil, err
+		}
 		return nil, errors.Wrapf(err, "failed to update issue by id: %s customerId: %s", id, customerId)
 	}
 
 	return is.GetByID(ctx, id, projectId, customerId)
 }
 
+// Transition move an issue to a new state, only the transitions in the workflow are allowed.
+func (is *IssuesPG) Transition(ctx context.Context, transition *api.IssueTransition, id, projectId, customerId string) (*api.Issue, error) {
+	err := db.WithTransaction(ctx, is.dbconn, func(tx db.Transaction) error {
+		if err := issueExists(ctx, tx, id, projectId, customerId); err != nil {
+			return err
+		}
+
+		return transitionIssue(ctx, tx, string(transition.State), id, customerId)
+	})
+	if err != nil {
+		switch err.(type) {
+		case *IssueNotFoundError, *IssueValidationError, *InvalidTransitionError:
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to transition issue by id: %s to state: %s customerId: %s", id, transition.State, customerId)
+	}
+
+	return is.GetByID(ctx, id, projectId, customerId)
+}
+
 // List list issues.
 func (is *IssuesPG) List(ctx context.Context, opt *IssueListOptions, projectId, customerId string) ([]api.Issue, error) {
+	qry, err := is.listSQL(ctx, opt, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := is.getBySQL(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := loadChildCounts(ctx, is.dbconn, issues, customerId); err != nil {
+		return nil, errors.Wrapf(err, "failed to count children of issues for projectId: %s customerId: %s", projectId, customerId)
+	}
+
+	if err := loadSLAs(ctx, is.dbconn, issues, customerId); err != nil {
+		return nil, errors.Wrapf(err, "failed to load sla of issues for projectId: %s customerId: %s", projectId, customerId)
+	}
+
+	return issues, nil
+}
+
+// Stream call fn with each issue matching the options as it is read from the database, unlike
+// List the issues aren't held in memory so all the issues in a project can be written out. The
+// child counts aren't loaded.
+func (is *IssuesPG) Stream(ctx context.Context, opt *IssueListOptions, projectId, customerId string, fn func(issue *api.Issue) error) error {
+	qry, err := is.listSQL(ctx, opt, projectId, customerId)
+	if err != nil {
+		return err
+	}
+
+	rows, err := is.dbconn.QueryContext(ctx, "SELECT "+issueColumns+" FROM issues "+qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to stream issues for projectId: %s customerId: %s", projectId, customerId)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		issue := api.Issue{}
+		if err := scanIssue(rows, &issue); err != nil {
+			return err
+		}
+
+		if err := fn(&issue); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// listSQL the where, order by and limit clauses of a list of issues.
+func (is *IssuesPG) listSQL(ctx context.Context, opt *IssueListOptions, projectId, customerId string) (*sqlf.Query, error) {
 	if opt == nil {
 		opt = &IssueListOptions{}
 	}
 
+	fields, err := is.fields.List(ctx, projectId, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list custom fields for projectId: %s customerId: %s", projectId, customerId)
+	}
+
 	conds := ListSubjectLikeSQL(opt.SubjectLikeOptions)
 	conds = append(conds, sqlf.Sprintf("project_id = %s", projectId))
 	conds = append(conds, sqlf.Sprintf("customer_id = %s", customerId))
 
-	qry := sqlf.Sprintf("WHERE %s ORDER BY id ASC %s", sqlf.Join(conds, "AND"), opt.LimitOffset.SQL())
+	filterConds, err := opt.IssueFilterOptions.SQL(fields)
+	if err != nil {
+		return nil, err
+	}
+	conds = append(conds, filterConds...)
 
-	return is.getBySQL(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	orderBy, err := opt.IssueSortOptions.SQL(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	return sqlf.Sprintf("WHERE %s %s %s", sqlf.Join(conds, "AND"), orderBy, opt.LimitOffset.SQL()), nil
+}
+
+// ListChildren list the direct children of an issue.
+func (is *IssuesPG) ListChildren(ctx context.Context, id, projectId, customerId string) ([]api.Issue, error) {
+	if err := issueExists(ctx, is.dbconn, id, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	issues, err := is.getBySQL(ctx, "WHERE parent_id=$1 AND project_id=$2 AND customer_id=$3 ORDER BY id ASC", id, projectId, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list children of issue by id: %s projectId: %s customerId: %s", id, projectId, customerId)
+	}
+
+	if err := loadChildCounts(ctx, is.dbconn, issues, customerId); err != nil {
+		return nil, errors.Wrapf(err, "failed to count children of issue by id: %s customerId: %s", id, customerId)
+	}
+
+	if err := loadSLAs(ctx, is.dbconn, issues, customerId); err != nil {
+		return nil, errors.Wrapf(err, "failed to load sla of children of issue by id: %s customerId: %s", id, customerId)
+	}
+
+	return issues, nil
+}
+
+// issueColumns the columns read by scanIssue.
+const issueColumns = "id, project_id, key, parent_id, assignee, due_at, milestone_id, subject, state, severity, category, labels, custom_fields, content, content_html, content_html_version, votes, created_at, updated_at"
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanIssue scans an issue, the content is rendered when the cached html is missing or out of date.
+func scanIssue(row rowScanner, issue *api.Issue) error {
+	var parentId, assigneeId sql.NullString
+	var cached contentHTML
+	customFields := hstore.Hstore{}
+
+	err := row.Scan(&issue.Id, &issue.ProjectId, &issue.Key, &parentId, &assigneeId, &issue.DueAt, &issue.MilestoneId, &issue.Subject, &issue.State, &issue.Severity, &issue.Category, pq.Array(&issue.Labels), &customFields, &issue.Content, &cached.HTML, &cached.Version, &issue.Votes, &issue.CreatedAt, &issue.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	html := cached.render(issue.Content)
+	issue.ContentHtml = &html
+
+	if parentId.Valid {
+		issue.ParentId = &parentId.String
+	}
+
+	if assigneeId.Valid {
+		issue.AssigneeId = &assigneeId.String
+	}
+
+	issue.CustomFields = api.CustomFieldValues{}
+	for k, v := range customFields.Map {
+		issue.CustomFields.Set(k, v.String)
+	}
+
+	return nil
+}
+
+func toHstore(values map[string]string) hstore.Hstore {
+	h := hstore.Hstore{Map: map[string]sql.NullString{}}
+	for k, v := range values {
+		h.Map[k] = sql.NullString{String: v, Valid: true}
+	}
+
+	return h
 }
 
 func (is *IssuesPG) getBySQL(ctx context.Context, query string, args ...interface{}) ([]api.Issue, error) {
-	rows, err := is.dbconn.QueryContext(ctx, "SELECT id, subject, state, severity, category, labels, content, created_at, updated_at FROM issues "+query, args...)
+	rows, err := is.dbconn.QueryContext(ctx, "SELECT "+issueColumns+" FROM issues "+query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -142,8 +791,7 @@ func (is *IssuesPG) getBySQL(ctx context.Context, query string, args ...interfac
 	defer rows.Close()
 	for rows.Next() {
 		issue := api.Issue{}
-		err := rows.Scan(&issue.Id, &issue.Subject, &issue.State, &issue.Severity, &issue.Category, pq.Array(&issue.Labels), &issue.Content, &issue.CreatedAt, &issue.UpdatedAt)
-		if err != nil {
+		if err := scanIssue(rows, &issue); err != nil {
 			return nil, err
 		}
 
@@ -68,3 +68,76 @@ func TestIssues_CreateGetUpdate(t *testing.T) {
 	assert.Len(listIssue, 1)
 	assert.Equal(newIssue, &listIssue[0])
 }
+
+func TestIssues_UpdateNotFound(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	istore := store.NewIssues(db.Global, cfg)
+
+	updatedIssue := &api.UpdatedIssue{NewIssue: api.NewIssue{Subject: "missing", Labels: []string{}}}
+
+	_, err = istore.Update(ctx, updatedIssue, "5f0c2a1e-8b7d-4c3a-9e6f-1d2b3c4a5e6f", testProjectId, testCustomerId)
+	assert.IsType(&store.IssueNotFoundError{}, err)
+
+	// an issue can't be updated through another project
+	newIssue, err := istore.Create(ctx, &api.NewIssue{Subject: "elsewhere", Labels: []string{}}, testProjectId, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	_, err = istore.Update(ctx, updatedIssue, newIssue.Id, "7a3e9c1b-2d4f-4b6a-8c0e-5f1d3b7a9c2e", testCustomerId)
+	assert.IsType(&store.IssueNotFoundError{}, err)
+}
+
+func TestIssues_Stream(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	istore := store.NewIssues(db.Global, cfg)
+
+	for _, subject := range []string{"first", "second", "third"} {
+		_, err := istore.Create(ctx, &api.NewIssue{Subject: subject, Labels: []string{}}, testProjectId, testCustomerId, testReporter)
+		if err != nil {
+			t.Fatal("failed to create issue")
+		}
+	}
+
+	opt := store.NewIssueListOptions("", 0, 0)
+	opt.LimitOffset = nil
+	opt.IssueSortOptions, err = store.NewIssueSortOptions("-subject")
+	assert.NoError(err)
+
+	subjects := []string{}
+	err = istore.Stream(ctx, opt, testProjectId, testCustomerId, func(issue *api.Issue) error {
+		subjects = append(subjects, issue.Subject)
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal([]string{"third", "second", "first"}, subjects)
+
+	opt.IssueFilterOptions, err = store.NewIssueFilterOptions([]string{"cf.missing:1"})
+	assert.NoError(err)
+
+	err = istore.Stream(ctx, opt, testProjectId, testCustomerId, func(issue *api.Issue) error {
+		t.Fatal("no issues should be streamed")
+		return nil
+	})
+	assert.IsType(&store.FilterError{}, err)
+}
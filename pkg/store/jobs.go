@@ -0,0 +1,277 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+)
+
+// ErrJobLeaseLost occurs when a worker updates a job it no longer holds, the lease expired and
+// the job was claimed again or it has already finished.
+var ErrJobLeaseLost = errors.New("job lease lost")
+
+// JobNotFoundError occurs when a job is not found.
+type JobNotFoundError struct {
+	Message string
+}
+
+func (e *JobNotFoundError) Error() string {
+	return fmt.Sprintf("job not found: %s", e.Message)
+}
+
+// NewJob a job to enqueue, the payload is encoded as JSON.
+type NewJob struct {
+	Type        string
+	Payload     interface{}
+	MaxAttempts int
+	RunAt       *time.Time
+	CreatedBy   *string
+}
+
+// QueuedJob a job claimed by a worker. The attempt identifies the claim, a job which is claimed
+// again after its lease expires can only be updated by the latest claim.
+type QueuedJob struct {
+	ID          string
+	CustomerID  string
+	Type        string
+	Payload     json.RawMessage
+	Attempts    int
+	MaxAttempts int
+}
+
+// Jobs provides a store for background jobs.
+type Jobs interface {
+	Enqueue(ctx context.Context, newJob *NewJob, customerId string) (*api.Job, error)
+	GetByID(ctx context.Context, id, customerId string) (*api.Job, error)
+	List(ctx context.Context, opt *JobListOptions, customerId string) ([]api.Job, error)
+	Claim(ctx context.Context, limit int, lease time.Duration, types []string) ([]QueuedJob, error)
+	Heartbeat(ctx context.Context, job *QueuedJob, lease time.Duration) error
+	Complete(ctx context.Context, job *QueuedJob, result json.RawMessage) error
+	Retry(ctx context.Context, job *QueuedJob, reason string, runAt time.Time) error
+	Fail(ctx context.Context, job *QueuedJob, reason string) error
+}
+
+// JobListOptions specifies the options for listing jobs.
+type JobListOptions struct {
+	Type   string
+	Status string
+	*LimitOffset
+}
+
+// JobsPG provides a jobs store for postgresql.
+type JobsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewJobs new jobs store.
+func NewJobs(dbconn *sql.DB, cfg *conf.Config) Jobs {
+	return &JobsPG{dbconn: dbconn, cfg: cfg}
+}
+
+// jobColumns the columns read by scanJob.
+const jobColumns = "id, type, status, attempts, max_attempts, run_at, last_error, result, created_by, created_at, updated_at, finished_at"
+
+// Enqueue add a job to the queue, it is run once it is due.
+func (js *JobsPG) Enqueue(ctx context.Context, newJob *NewJob, customerId string) (*api.Job, error) {
+	job, err := enqueueJob(ctx, js.dbconn, newJob, customerId, js.cfg.JobMaxAttempts)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to enqueue job with type: %s customerId: %s", newJob.Type, customerId)
+	}
+
+	return job, nil
+}
+
+// enqueueJob adds a job to the queue, passing a transaction enqueues the job along with the change
+// which caused it.
+func enqueueJob(ctx context.Context, q queryRower, newJob *NewJob, customerId string, defaultMaxAttempts int) (*api.Job, error) {
+	payload, err := json.Marshal(newJob.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	maxAttempts := newJob.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = defaultMaxAttempts
+	}
+
+	runAt := time.Now()
+	if newJob.RunAt != nil {
+		runAt = *newJob.RunAt
+	}
+
+	job := &api.Job{}
+
+	err = scanJob(q.QueryRowContext(ctx, `INSERT INTO jobs(customer_id, type, payload, max_attempts, run_at, created_by)
+		VALUES($1, $2, $3, $4, $5, $6) RETURNING `+jobColumns, customerId, newJob.Type, payload, maxAttempts, runAt, newJob.CreatedBy), job)
+	if err != nil {
+		return nil, err
+	}
+
+	return job, nil
+}
+
+// GetByID get job by id.
+func (js *JobsPG) GetByID(ctx context.Context, id, customerId string) (*api.Job, error) {
+	job := &api.Job{}
+
+	err := scanJob(js.dbconn.QueryRowContext(ctx, "SELECT "+jobColumns+" FROM jobs WHERE id=$1 AND customer_id=$2", id, customerId), job)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &JobNotFoundError{fmt.Sprintf("id %s", id)}
+		}
+		return nil, errors.Wrapf(err, "failed to get job by id: %s customerId: %s", id, customerId)
+	}
+
+	return job, nil
+}
+
+// List list the jobs of a customer, newest first.
+func (js *JobsPG) List(ctx context.Context, opt *JobListOptions, customerId string) ([]api.Job, error) {
+	if opt == nil {
+		opt = &JobListOptions{}
+	}
+
+	conds := []*sqlf.Query{sqlf.Sprintf("customer_id = %s", customerId)}
+	if opt.Type != "" {
+		conds = append(conds, sqlf.Sprintf("type = %s", opt.Type))
+	}
+	if opt.Status != "" {
+		conds = append(conds, sqlf.Sprintf("status = %s", opt.Status))
+	}
+
+	qry := sqlf.Sprintf("SELECT "+jobColumns+" FROM jobs WHERE %s ORDER BY created_at DESC, id ASC %s", sqlf.Join(conds, "AND"), opt.LimitOffset.SQL())
+
+	rows, err := js.dbconn.QueryContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list jobs for customerId: %s", customerId)
+	}
+
+	jobs := []api.Job{}
+	defer rows.Close()
+	for rows.Next() {
+		job := api.Job{}
+		if err := scanJob(rows, &job); err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, job)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// Claim lease due jobs of the given types, jobs which are running are claimed again once their
+// lease has expired. Each claim counts as an attempt, the caller fails jobs which have been
+// attempted too many times.
+func (js *JobsPG) Claim(ctx context.Context, limit int, lease time.Duration, types []string) ([]QueuedJob, error) {
+	rows, err := js.dbconn.QueryContext(ctx, `UPDATE jobs j SET status='running', attempts=j.attempts + 1, run_at=now(),
+			locked_until=now() + $2 * interval '1 second', updated_at=now()
+		WHERE j.id IN (
+			SELECT id FROM jobs WHERE type = ANY($3) AND
+				((status = 'queued' AND run_at <= now()) OR (status = 'running' AND locked_until < now()))
+			ORDER BY run_at ASC LIMIT $1 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING j.id, j.customer_id, j.type, j.payload, j.attempts, j.max_attempts`, limit, lease.Seconds(), pq.Array(types))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to claim jobs")
+	}
+
+	jobs := []QueuedJob{}
+	defer rows.Close()
+	for rows.Next() {
+		job := QueuedJob{}
+		if err := rows.Scan(&job.ID, &job.CustomerID, &job.Type, &job.Payload, &job.Attempts, &job.MaxAttempts); err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, job)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+// Heartbeat extend the lease of a running job.
+func (js *JobsPG) Heartbeat(ctx context.Context, job *QueuedJob, lease time.Duration) error {
+	return js.updateClaimed(ctx, job, "locked_until=now() + $3 * interval '1 second'", lease.Seconds())
+}
+
+// Complete mark a job as succeeded with its result.
+func (js *JobsPG) Complete(ctx context.Context, job *QueuedJob, result json.RawMessage) error {
+	return js.updateClaimed(ctx, job, "status='succeeded', result=$3, last_error=NULL, locked_until=NULL, finished_at=now()", []byte(result))
+}
+
+// Retry record a failed attempt, the job is queued to run again at runAt.
+func (js *JobsPG) Retry(ctx context.Context, job *QueuedJob, reason string, runAt time.Time) error {
+	return js.updateClaimed(ctx, job, "status='queued', last_error=$3, run_at=$4, locked_until=NULL", reason, runAt)
+}
+
+// Fail mark a job as failed, it isn't run again.
+func (js *JobsPG) Fail(ctx context.Context, job *QueuedJob, reason string) error {
+	return js.updateClaimed(ctx, job, "status='failed', last_error=$3, locked_until=NULL, finished_at=now()", reason)
+}
+
+// updateClaimed update a job which is still held by the claim, the set clause is given the
+// arguments from $3.
+func (js *JobsPG) updateClaimed(ctx context.Context, job *QueuedJob, set string, args ...interface{}) error {
+	res, err := js.dbconn.ExecContext(ctx, "UPDATE jobs SET "+set+", updated_at=now() WHERE id=$1 AND customer_id=$2 AND status='running' AND attempts="+fmt.Sprint(job.Attempts),
+		append([]interface{}{job.ID, job.CustomerID}, args...)...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update job by id: %s", job.ID)
+	}
+
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return ErrJobLeaseLost
+	}
+
+	return nil
+}
+
+func scanJob(row rowScanner, job *api.Job) error {
+	var (
+		lastError, createdBy sql.NullString
+		result               []byte
+		finishedAt           sql.NullTime
+	)
+
+	err := row.Scan(&job.Id, &job.Type, &job.Status, &job.Attempts, &job.MaxAttempts, &job.RunAt, &lastError, &result, &createdBy, &job.CreatedAt, &job.UpdatedAt, &finishedAt)
+	if err != nil {
+		return err
+	}
+
+	if lastError.Valid {
+		job.LastError = &lastError.String
+	}
+
+	if createdBy.Valid {
+		job.CreatedBy = &createdBy.String
+	}
+
+	if finishedAt.Valid {
+		job.FinishedAt = &finishedAt.Time
+	}
+
+	// results which aren't an object, such as null, are left out
+	if len(result) > 0 {
+		values := map[string]interface{}{}
+		if err := json.Unmarshal(result, &values); err == nil {
+			job.Result = &values
+		}
+	}
+
+	return nil
+}
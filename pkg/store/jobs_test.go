@@ -0,0 +1,155 @@
+package store_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestJobs_ClaimAndComplete(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	// a unique type keeps jobs queued by other tests out of the claims
+	jobType := "test-" + uuid.NewV4().String()
+
+	later := time.Now().Add(time.Hour)
+
+	due, err := stores.Jobs.Enqueue(ctx, &store.NewJob{Type: jobType, Payload: map[string]string{"name": "due"}, CreatedBy: strPtr(testReporter)}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to enqueue job")
+	}
+
+	assert.Equal(api.JobStatusQueued, due.Status)
+	assert.Equal(cfg.JobMaxAttempts, due.MaxAttempts)
+
+	_, err = stores.Jobs.Enqueue(ctx, &store.NewJob{Type: jobType, Payload: map[string]string{"name": "later"}, RunAt: &later}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to enqueue job")
+	}
+
+	claimed, err := stores.Jobs.Claim(ctx, 10, time.Minute, []string{jobType})
+	if err != nil {
+		t.Fatal("failed to claim jobs")
+	}
+
+	// only the due job is claimed
+	assert.Len(claimed, 1)
+	assert.Equal(due.Id, claimed[0].ID)
+	assert.Equal(1, claimed[0].Attempts)
+	assert.JSONEq(`{"name":"due"}`, string(claimed[0].Payload))
+
+	// a leased job isn't claimed again
+	again, err := stores.Jobs.Claim(ctx, 10, time.Minute, []string{jobType})
+	if err != nil {
+		t.Fatal("failed to claim jobs")
+	}
+
+	assert.Len(again, 0)
+
+	assert.NoError(stores.Jobs.Heartbeat(ctx, &claimed[0], time.Minute))
+	assert.NoError(stores.Jobs.Complete(ctx, &claimed[0], json.RawMessage(`{"count":3}`)))
+
+	// once complete the claim can no longer update the job
+	assert.Equal(store.ErrJobLeaseLost, stores.Jobs.Fail(ctx, &claimed[0], "too late"))
+
+	job, err := stores.Jobs.GetByID(ctx, due.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get job")
+	}
+
+	assert.Equal(api.JobStatusSucceeded, job.Status)
+	assert.Equal(map[string]interface{}{"count": float64(3)}, *job.Result)
+	assert.NotNil(job.FinishedAt)
+
+	jobs, err := stores.Jobs.List(ctx, &store.JobListOptions{Type: jobType, Status: "queued"}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list jobs")
+	}
+
+	assert.Len(jobs, 1)
+
+	_, err = stores.Jobs.GetByID(ctx, due.Id, "another-customer")
+	assert.IsType(&store.JobNotFoundError{}, err)
+}
+
+func TestJobs_RetryAndExpiredLease(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	jobType := "test-" + uuid.NewV4().String()
+
+	_, err = stores.Jobs.Enqueue(ctx, &store.NewJob{Type: jobType, MaxAttempts: 2}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to enqueue job")
+	}
+
+	claimed, err := stores.Jobs.Claim(ctx, 10, time.Minute, []string{jobType})
+	if err != nil {
+		t.Fatal("failed to claim jobs")
+	}
+
+	assert.Len(claimed, 1)
+	assert.NoError(stores.Jobs.Retry(ctx, &claimed[0], "unavailable", time.Now().Add(-time.Second)))
+
+	// an expired lease is claimed again and the previous claim is fenced off
+	first, err := stores.Jobs.Claim(ctx, 10, -time.Second, []string{jobType})
+	if err != nil {
+		t.Fatal("failed to claim jobs")
+	}
+
+	assert.Len(first, 1)
+	assert.Equal(2, first[0].Attempts)
+
+	second, err := stores.Jobs.Claim(ctx, 10, time.Minute, []string{jobType})
+	if err != nil {
+		t.Fatal("failed to claim jobs")
+	}
+
+	assert.Len(second, 1)
+	assert.Equal(3, second[0].Attempts)
+
+	assert.Equal(store.ErrJobLeaseLost, stores.Jobs.Heartbeat(ctx, &first[0], time.Minute))
+	assert.NoError(stores.Jobs.Fail(ctx, &second[0], "exceeded max attempts"))
+
+	job, err := stores.Jobs.GetByID(ctx, second[0].ID, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get job")
+	}
+
+	assert.Equal(api.JobStatusFailed, job.Status)
+	assert.Equal("exceeded max attempts", *job.LastError)
+}
@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+var (
+	// mentionPattern matches @login where the @ isn't part of a word, such as an email address.
+	mentionPattern = regexp.MustCompile(`(?:^|[^A-Za-z0-9_@.])@([A-Za-z0-9][A-Za-z0-9_-]{0,38})`)
+
+	// codePattern matches fenced code blocks and code spans, mentions in code are ignored.
+	codePattern = regexp.MustCompile("(?s)```.*?```|`[^`\n]*`")
+)
+
+// Mentions provides a store for the users mentioned in issues and comments.
+type Mentions interface {
+	List(ctx context.Context, opt *LimitOffset, customerId, userId string) ([]api.Mention, error)
+}
+
+// MentionsPG provides a mentions store for postgresql.
+type MentionsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewMentions new mentions store.
+func NewMentions(dbconn *sql.DB, cfg *conf.Config) Mentions {
+	return &MentionsPG{dbconn: dbconn, cfg: cfg}
+}
+
+// List list the mentions of a user across all projects, newest first.
+func (ms *MentionsPG) List(ctx context.Context, opt *LimitOffset, customerId, userId string) ([]api.Mention, error) {
+	rows, err := ms.dbconn.QueryContext(ctx, `SELECT m.id, i.project_id, m.issue_id, m.comment_id, m.mentioned_by, m.created_at FROM mentions m
+		JOIN issues i ON i.id = m.issue_id AND i.customer_id = m.customer_id
+		WHERE m.customer_id=$1 AND m.user_id=$2 ORDER BY m.created_at DESC, m.id ASC `+opt.SQL().Query(sqlf.PostgresBindVar), customerId, userId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list mentions by customerId: %s userId: %s", customerId, userId)
+	}
+
+	mentions := []api.Mention{}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			mention   api.Mention
+			commentId sql.NullString
+		)
+
+		if err := rows.Scan(&mention.Id, &mention.ProjectId, &mention.IssueId, &commentId, &mention.MentionedBy, &mention.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		if commentId.Valid {
+			mention.CommentId = &commentId.String
+		}
+
+		mentions = append(mentions, mention)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return mentions, nil
+}
+
+// parseMentions returns the distinct logins mentioned in content, ignoring case and code.
+func parseMentions(content string) []string {
+	content = codePattern.ReplaceAllString(content, " ")
+
+	seen := map[string]bool{}
+	logins := []string{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		login := strings.ToLower(match[1])
+		if seen[login] {
+			continue
+		}
+
+		seen[login] = true
+		logins = append(logins, login)
+	}
+
+	return logins
+}
+
+// recordMentions updates the mentions of an issue or comment in the transaction which changed its
+// content, mentions which were removed by an edit are dropped and newly mentioned users watch the issue.
+// Logins which don't match a user of the customer are ignored.
+func recordMentions(ctx context.Context, tx db.Transaction, content, issueId string, commentId *string, customerId, mentionedBy string) error {
+	sourceId := issueId
+	if commentId != nil {
+		sourceId = *commentId
+	}
+
+	userIds := []string{}
+
+	logins := parseMentions(content)
+	if len(logins) > 0 {
+		rows, err := tx.QueryContext(ctx, "SELECT id::text FROM users WHERE customer_id=$1 AND login = ANY($2::citext[])", customerId, pq.Array(logins))
+		if err != nil {
+			return err
+		}
+
+		defer rows.Close()
+		for rows.Next() {
+			var userId string
+			if err := rows.Scan(&userId); err != nil {
+				return err
+			}
+
+			userIds = append(userIds, userId)
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+	}
+
+	_, err := tx.ExecContext(ctx, "DELETE FROM mentions WHERE customer_id=$1 AND source_id=$2 AND NOT (user_id = ANY($3::text[]))", customerId, sourceId, pq.Array(userIds))
+	if err != nil {
+		return err
+	}
+
+	for _, userId := range userIds {
+		res, err := tx.ExecContext(ctx, `INSERT INTO mentions(customer_id, source_id, issue_id, comment_id, user_id, mentioned_by) VALUES($1, $2, $3, $4, $5, $6)
+			ON CONFLICT DO NOTHING`, customerId, sourceId, issueId, commentId, userId, mentionedBy)
+		if err != nil {
+			return err
+		}
+
+		if err := addWatcher(ctx, tx, issueId, customerId, userId, api.WatcherReasonMentioned); err != nil {
+			return err
+		}
+
+		// users are only notified the first time they are mentioned in an issue or comment
+		n, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			continue
+		}
+
+		if err := notifyUsers(ctx, tx, api.NotificationTypeMentioned, issueId, commentId, customerId, mentionedBy, nil, []string{userId}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestMentions_IssuesAndComments(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	alice, err := stores.Users.Create(ctx, &api.NewUser{Login: "alice", Name: "Alice", Email: "alice@example.com"}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create user")
+	}
+
+	bob, err := stores.Users.Create(ctx, &api.NewUser{Login: "bob", Name: "Bob", Email: "bob@example.com"}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create user")
+	}
+
+	_, err = stores.Users.Create(ctx, &api.NewUser{Login: "Alice", Name: "Alice", Email: "alice@example.com"}, testCustomerId)
+	assert.Equal(store.ErrUserLoginAlreadyExists, err)
+
+	_, err = stores.Users.Create(ctx, &api.NewUser{Login: "@alice", Name: "Alice", Email: "alice@example.com"}, testCustomerId)
+	assert.IsType(&store.UserValidationError{}, err)
+
+	newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "mentions", Content: "cc @Alice and @nobody", Labels: []string{}}, testProjectId, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	// email addresses and code aren't mentions
+	newComment, err := stores.Comments.Create(ctx, &api.NewComment{Content: "@alice mail bob@example.com or run `@bob`"}, newIssue.Id, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	mentions, err := stores.Mentions.List(ctx, &store.LimitOffset{Limit: 10}, testCustomerId, alice.Id)
+	if err != nil {
+		t.Fatal("failed to list mentions")
+	}
+
+	assert.Len(mentions, 2)
+	assert.Equal(newComment.Id, *mentions[0].CommentId)
+	assert.Equal(testAuthor, mentions[0].MentionedBy)
+	assert.Nil(mentions[1].CommentId)
+	assert.Equal(testProjectId, mentions[1].ProjectId)
+
+	mentions, err = stores.Mentions.List(ctx, &store.LimitOffset{Limit: 10}, testCustomerId, bob.Id)
+	if err != nil {
+		t.Fatal("failed to list mentions")
+	}
+
+	assert.Empty(mentions)
+
+	_, err = stores.Comments.Update(ctx, &api.UpdatedComment{NewComment: api.NewComment{Content: "actually @bob"}}, newComment.Id, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update comment")
+	}
+
+	mentions, err = stores.Mentions.List(ctx, &store.LimitOffset{Limit: 10}, testCustomerId, alice.Id)
+	if err != nil {
+		t.Fatal("failed to list mentions")
+	}
+
+	assert.Len(mentions, 1)
+
+	userIds, err := stores.Watchers.UserIDs(ctx, newIssue.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to resolve watchers")
+	}
+
+	assert.Subset(userIds, []string{alice.Id, bob.Id})
+}
+
+func TestMentions_ListBySubject(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	// subjects of access tokens aren't always uuids
+	subject := "auth0|5d1f2c3b4a"
+
+	carol, err := stores.Users.Create(ctx, &api.NewUser{Login: "carol", Name: "Carol", Email: "carol@example.com", Subject: &subject}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create user")
+	}
+
+	_, err = stores.Users.Create(ctx, &api.NewUser{Login: "carol2", Name: "Carol", Email: "carol@example.com", Subject: &subject}, testCustomerId)
+	assert.Equal(store.ErrUserSubjectAlreadyExists, err)
+
+	_, err = stores.Users.GetBySubject(ctx, "auth0|unknown", testCustomerId)
+	assert.IsType(&store.UserNotFoundError{}, err)
+
+	// the handler resolves the subject of the access token to the user the mention is recorded for
+	user, err := stores.Users.GetBySubject(ctx, subject, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to get user by subject")
+	}
+	assert.Equal(carol.Id, user.Id)
+
+	newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "mentions by subject", Content: "cc @carol", Labels: []string{}}, testProjectId, testCustomerId, user.Id)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	mentions, err := stores.Mentions.List(ctx, &store.LimitOffset{Limit: 10}, testCustomerId, user.Id)
+	if err != nil {
+		t.Fatal("failed to list mentions")
+	}
+
+	assert.Len(mentions, 1)
+	assert.Equal(newIssue.Id, mentions[0].IssueId)
+	assert.Equal(user.Id, mentions[0].MentionedBy)
+
+	watched, err := stores.Issues.ListWatched(ctx, &store.LimitOffset{Limit: 10}, testCustomerId, user.Id)
+	if err != nil {
+		t.Fatal("failed to list watched issues")
+	}
+
+	assert.Len(watched, 1)
+	assert.Equal(newIssue.Id, watched[0].Id)
+}
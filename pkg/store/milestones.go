@@ -0,0 +1,343 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+var (
+	// ErrMilestoneTitleAlreadyExists milestone title is already taken.
+	ErrMilestoneTitleAlreadyExists = errors.New("milestone title is already taken")
+
+	// ErrMilestoneHasOpenIssues milestone can't be closed without moving its open issues.
+	ErrMilestoneHasOpenIssues = errors.New("milestone has open issues, move them to another milestone to close it")
+)
+
+// MilestoneNotFoundError occurs when a milestone is not found.
+type MilestoneNotFoundError struct {
+	Message string
+}
+
+func (e *MilestoneNotFoundError) Error() string {
+	return fmt.Sprintf("milestone not found: %s", e.Message)
+}
+
+// MilestoneValidationError occurs when a milestone or a change to its state is invalid.
+type MilestoneValidationError struct {
+	Message string
+}
+
+func (e *MilestoneValidationError) Error() string {
+	return fmt.Sprintf("invalid milestone: %s", e.Message)
+}
+
+// Milestones provides a milestones store.
+type Milestones interface {
+	GetByID(ctx context.Context, id, projectId, customerId string) (*api.Milestone, error)
+	Create(ctx context.Context, newMilestone *api.NewMilestone, projectId, customerId string) (*api.Milestone, error)
+	Update(ctx context.Context, updatedMilestone *api.UpdatedMilestone, id, projectId, customerId string) (*api.Milestone, error)
+	Transition(ctx context.Context, transition *api.MilestoneTransition, id, projectId, customerId string) (*api.Milestone, error)
+	List(ctx context.Context, state, projectId, customerId string) ([]api.Milestone, error)
+}
+
+// MilestonesPG provides a milestones store for postgresql.
+type MilestonesPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewMilestones new milestones store.
+func NewMilestones(dbconn *sql.DB, cfg *conf.Config) Milestones {
+	return &MilestonesPG{dbconn: dbconn, cfg: cfg}
+}
+
+// GetByID get milestone by id.
+func (ms *MilestonesPG) GetByID(ctx context.Context, id, projectId, customerId string) (*api.Milestone, error) {
+	if _, err := uuid.FromString(id); err != nil {
+		return nil, &MilestoneNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
+	}
+
+	milestones, err := ms.getBySQL(ctx, sqlf.Sprintf("WHERE m.id=%s AND m.project_id=%s AND m.customer_id=%s LIMIT 1", id, projectId, customerId))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get milestone by id: %s projectId: %s customerId: %s", id, projectId, customerId)
+	}
+
+	if len(milestones) == 0 {
+		return nil, &MilestoneNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
+	}
+
+	return &milestones[0], nil
+}
+
+// Create create an open milestone.
+func (ms *MilestonesPG) Create(ctx context.Context, newMilestone *api.NewMilestone, projectId, customerId string) (*api.Milestone, error) {
+	if err := validateMilestone(newMilestone); err != nil {
+		return nil, err
+	}
+
+	var id string
+
+	err := db.WithTransaction(ctx, ms.dbconn, func(tx db.Transaction) error {
+		return tx.QueryRowContext(ctx, "INSERT INTO milestones(project_id, customer_id, title, description, due_at) VALUES($1, $2, $3, $4, $5) RETURNING id",
+			projectId, customerId, strings.TrimSpace(newMilestone.Title), newMilestone.Description, newMilestone.DueAt).Scan(&id)
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Constraint {
+			case "milestones_customer_id_project_id_title_key":
+				return nil, ErrMilestoneTitleAlreadyExists
+			}
+		}
+		return nil, errors.Wrapf(err, "failed to create milestone with title: %s projectId: %s customerId: %s", newMilestone.Title, projectId, customerId)
+	}
+
+	return ms.GetByID(ctx, id, projectId, customerId)
+}
+
+// Update update the title, description and due date of a milestone.
+func (ms *MilestonesPG) Update(ctx context.Context, updatedMilestone *api.UpdatedMilestone, id, projectId, customerId string) (*api.Milestone, error) {
+	if err := validateMilestone(&updatedMilestone.NewMilestone); err != nil {
+		return nil, err
+	}
+
+	if _, err := ms.GetByID(ctx, id, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	err := db.WithTransaction(ctx, ms.dbconn, func(tx db.Transaction) error {
+		res, err := tx.ExecContext(ctx, "UPDATE milestones SET title=$1, description=$2, due_at=$3, updated_at=$4 WHERE id=$5 AND project_id=$6 AND customer_id=$7",
+			strings.TrimSpace(updatedMilestone.Title), updatedMilestone.Description, updatedMilestone.DueAt, time.Now(), id, projectId, customerId)
+		if err != nil {
+			return err
+		}
+
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return &MilestoneNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Constraint {
+			case "milestones_customer_id_project_id_title_key":
+				return nil, ErrMilestoneTitleAlreadyExists
+			}
+		}
+		if _, ok := err.(*MilestoneNotFoundError); ok {
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to update milestone by id: %s projectId: %s customerId: %s", id, projectId, customerId)
+	}
+
+	return ms.GetByID(ctx, id, projectId, customerId)
+}
+
+// Transition close or reopen a milestone. Closing a milestone with open issues requires another
+// open milestone to move them to, the resolved and closed issues stay with the closed milestone.
+func (ms *MilestonesPG) Transition(ctx context.Context, transition *api.MilestoneTransition, id, projectId, customerId string) (*api.Milestone, error) {
+	moveTo := ""
+	if transition.MoveOpenIssuesTo != nil {
+		moveTo = *transition.MoveOpenIssuesTo
+	}
+
+	switch {
+	case transition.State != api.MilestoneTransitionStateOpen && transition.State != api.MilestoneTransitionStateClosed:
+		return nil, &MilestoneValidationError{fmt.Sprintf("unknown state %s", transition.State)}
+	case moveTo != "" && transition.State != api.MilestoneTransitionStateClosed:
+		return nil, &MilestoneValidationError{"open issues can only be moved when closing a milestone"}
+	case moveTo == id:
+		return nil, &MilestoneValidationError{"open issues can't be moved to the milestone being closed"}
+	}
+
+	if _, err := ms.GetByID(ctx, id, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	if moveTo != "" {
+		if _, err := uuid.FromString(moveTo); err != nil {
+			return nil, &MilestoneValidationError{fmt.Sprintf("milestone %s to move open issues to not found", moveTo)}
+		}
+	}
+
+	err := db.WithTransaction(ctx, ms.dbconn, func(tx db.Transaction) error {
+		ids := []string{id}
+		if moveTo != "" {
+			ids = append(ids, moveTo)
+		}
+
+		// both milestones are locked in the same order so concurrent moves between them can't
+		// deadlock, issues can't be added to a milestone while it is locked
+		states, err := lockMilestones(ctx, tx, ids, projectId, customerId)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := states[id]; !ok {
+			return &MilestoneNotFoundError{fmt.Sprintf("id %s project_id %s", id, projectId)}
+		}
+
+		if states[id] == string(transition.State) {
+			return nil
+		}
+
+		now := time.Now()
+
+		if transition.State == api.MilestoneTransitionStateOpen {
+			_, err := tx.ExecContext(ctx, "UPDATE milestones SET state=$1, closed_at=NULL, updated_at=$2 WHERE id=$3 AND project_id=$4 AND customer_id=$5",
+				string(api.MilestoneStateOpen), now, id, projectId, customerId)
+			return err
+		}
+
+		if moveTo != "" {
+			state, ok := states[moveTo]
+			switch {
+			case !ok:
+				return &MilestoneValidationError{fmt.Sprintf("milestone %s to move open issues to not found", moveTo)}
+			case state != string(api.MilestoneStateOpen):
+				return &MilestoneValidationError{fmt.Sprintf("milestone %s to move open issues to is closed", moveTo)}
+			}
+
+			_, err := tx.ExecContext(ctx, "UPDATE issues SET milestone_id=$1, updated_at=$2 WHERE milestone_id=$3 AND customer_id=$4 AND state <> ALL($5)",
+				moveTo, now, id, customerId, pq.Array(doneStates))
+			if err != nil {
+				return err
+			}
+		} else {
+			var open bool
+
+			err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM issues WHERE milestone_id=$1 AND customer_id=$2 AND state <> ALL($3))",
+				id, customerId, pq.Array(doneStates)).Scan(&open)
+			if err != nil {
+				return err
+			}
+
+			if open {
+				return ErrMilestoneHasOpenIssues
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE milestones SET state=$1, closed_at=$2, updated_at=$2 WHERE id=$3 AND project_id=$4 AND customer_id=$5",
+			string(api.MilestoneStateClosed), now, id, projectId, customerId)
+		return err
+	})
+	if err != nil {
+		if err == ErrMilestoneHasOpenIssues {
+			return nil, err
+		}
+		switch err.(type) {
+		case *MilestoneNotFoundError, *MilestoneValidationError:
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to transition milestone by id: %s to state: %s customerId: %s", id, transition.State, customerId)
+	}
+
+	return ms.GetByID(ctx, id, projectId, customerId)
+}
+
+// List list the milestones in a project ordered by due date, the state is optional.
+func (ms *MilestonesPG) List(ctx context.Context, state, projectId, customerId string) ([]api.Milestone, error) {
+	conds := []*sqlf.Query{sqlf.Sprintf("m.project_id=%s AND m.customer_id=%s", projectId, customerId)}
+	if state != "" {
+		conds = append(conds, sqlf.Sprintf("m.state=%s", state))
+	}
+
+	return ms.getBySQL(ctx, sqlf.Sprintf("WHERE %s ORDER BY m.due_at ASC NULLS LAST, m.title ASC", sqlf.Join(conds, "AND")))
+}
+
+// getBySQL selects milestones along with the count of open and closed issues in each.
+func (ms *MilestonesPG) getBySQL(ctx context.Context, where *sqlf.Query) ([]api.Milestone, error) {
+	qry := sqlf.Sprintf(`SELECT m.id, m.project_id, m.title, m.description, m.due_at, m.state, m.closed_at, m.created_at, m.updated_at, p.open_issues, p.closed_issues
+		FROM milestones m
+		LEFT JOIN LATERAL (
+			SELECT count(*) FILTER (WHERE i.state <> ALL(%s)) AS open_issues, count(*) FILTER (WHERE i.state = ANY(%s)) AS closed_issues
+			FROM issues i WHERE i.milestone_id = m.id AND i.customer_id = m.customer_id
+		) p ON true
+		%s`, pq.Array(doneStates), pq.Array(doneStates), where)
+
+	rows, err := ms.dbconn.QueryContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones := []api.Milestone{}
+	defer rows.Close()
+	for rows.Next() {
+		m := api.Milestone{}
+		err := rows.Scan(&m.Id, &m.ProjectId, &m.Title, &m.Description, &m.DueAt, &m.State, &m.ClosedAt, &m.CreatedAt, &m.UpdatedAt, &m.Progress.OpenIssues, &m.Progress.ClosedIssues)
+		if err != nil {
+			return nil, err
+		}
+
+		milestones = append(milestones, m)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return milestones, nil
+}
+
+// lockMilestones lock milestones in the project for update, returning the state of those found.
+func lockMilestones(ctx context.Context, tx db.Transaction, ids []string, projectId, customerId string) (map[string]string, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT id, state FROM milestones WHERE id = ANY($1::uuid[]) AND project_id=$2 AND customer_id=$3 ORDER BY id FOR UPDATE",
+		pq.Array(ids), projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	states := map[string]string{}
+	defer rows.Close()
+	for rows.Next() {
+		var id, state string
+		if err := rows.Scan(&id, &state); err != nil {
+			return nil, err
+		}
+		states[id] = state
+	}
+
+	return states, rows.Err()
+}
+
+// checkIssueMilestone check an issue can be added to the milestone, the milestone is locked so
+// it can't be closed until the issue is saved.
+func checkIssueMilestone(ctx context.Context, tx db.Transaction, milestoneId, projectId, customerId string) error {
+	if _, err := uuid.FromString(milestoneId); err != nil {
+		return &IssueValidationError{fmt.Sprintf("milestone %s not found", milestoneId)}
+	}
+
+	var state string
+
+	err := tx.QueryRowContext(ctx, "SELECT state FROM milestones WHERE id=$1 AND project_id=$2 AND customer_id=$3 FOR SHARE", milestoneId, projectId, customerId).Scan(&state)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &IssueValidationError{fmt.Sprintf("milestone %s not found", milestoneId)}
+		}
+		return err
+	}
+
+	if state != string(api.MilestoneStateOpen) {
+		return &IssueValidationError{fmt.Sprintf("milestone %s is closed", milestoneId)}
+	}
+
+	return nil
+}
+
+func validateMilestone(newMilestone *api.NewMilestone) error {
+	if strings.TrimSpace(newMilestone.Title) == "" {
+		return &MilestoneValidationError{"title is required"}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestMilestones_Validation(t *testing.T) {
+	assert := require.New(t)
+
+	mstore := store.NewMilestones(nil, &conf.Config{})
+	id := "9b2f0c4e-6d1a-4e8b-9c3d-2f1e0a9b8c7d"
+
+	_, err := mstore.Create(context.Background(), &api.NewMilestone{Title: "  "}, testProjectId, testCustomerId)
+	assert.IsType(&store.MilestoneValidationError{}, err)
+
+	for _, transition := range []*api.MilestoneTransition{
+		{State: "archived"},
+		{State: api.MilestoneTransitionStateOpen, MoveOpenIssuesTo: strPtr("e1c6a7d2-3b4f-4a5e-8d9c-0b1a2f3e4d5c")},
+		{State: api.MilestoneTransitionStateClosed, MoveOpenIssuesTo: &id},
+	} {
+		_, err := mstore.Transition(context.Background(), transition, id, testProjectId, testCustomerId)
+		assert.IsType(&store.MilestoneValidationError{}, err, transition.State)
+	}
+}
+
+func TestMilestones_Close(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "milestones", Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	release, err := stores.Milestones.Create(ctx, &api.NewMilestone{Title: "v1.0.0"}, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.MilestoneStateOpen, release.State)
+	assert.Equal(api.MilestoneProgress{}, release.Progress)
+
+	_, err = stores.Milestones.Create(ctx, &api.NewMilestone{Title: "v1.0.0"}, proj.Id, testCustomerId)
+	assert.Equal(store.ErrMilestoneTitleAlreadyExists, err)
+
+	next, err := stores.Milestones.Create(ctx, &api.NewMilestone{Title: "v1.1.0"}, proj.Id, testCustomerId)
+	assert.NoError(err)
+
+	done, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "shipped", Labels: []string{}, MilestoneId: &release.Id}, proj.Id, testCustomerId, testReporter)
+	assert.NoError(err)
+	assert.Equal(release.Id, *done.MilestoneId)
+
+	_, err = stores.Issues.Transition(ctx, &api.IssueTransition{State: api.IssueTransitionStateResolved}, done.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+
+	open, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "slipped", Labels: []string{}, MilestoneId: &release.Id}, proj.Id, testCustomerId, testReporter)
+	assert.NoError(err)
+
+	release, err = stores.Milestones.GetByID(ctx, release.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.MilestoneProgress{OpenIssues: 1, ClosedIssues: 1}, release.Progress)
+
+	opt := store.NewIssueListOptions("", 0, 10)
+	opt.IssueFilterOptions, err = store.NewIssueFilterOptions([]string{"milestone_id:" + release.Id})
+	assert.NoError(err)
+
+	issues, err := stores.Issues.List(ctx, opt, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Len(issues, 2)
+
+	// closing with open issues needs a milestone to move them to
+	_, err = stores.Milestones.Transition(ctx, &api.MilestoneTransition{State: api.MilestoneTransitionStateClosed}, release.Id, proj.Id, testCustomerId)
+	assert.Equal(store.ErrMilestoneHasOpenIssues, err)
+
+	release, err = stores.Milestones.Transition(ctx, &api.MilestoneTransition{State: api.MilestoneTransitionStateClosed, MoveOpenIssuesTo: &next.Id}, release.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.MilestoneStateClosed, release.State)
+	assert.NotNil(release.ClosedAt)
+	assert.Equal(api.MilestoneProgress{ClosedIssues: 1}, release.Progress)
+
+	open, err = stores.Issues.GetByID(ctx, open.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(next.Id, *open.MilestoneId)
+
+	// issues can't be added to a closed milestone or a milestone of another project
+	_, err = stores.Issues.Create(ctx, &api.NewIssue{Subject: "late", Labels: []string{}, MilestoneId: &release.Id}, proj.Id, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	_, err = stores.Issues.Create(ctx, &api.NewIssue{Subject: "elsewhere", Labels: []string{}, MilestoneId: &next.Id}, testProjectId, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	// open issues can't be moved to a closed milestone
+	_, err = stores.Milestones.Transition(ctx, &api.MilestoneTransition{State: api.MilestoneTransitionStateClosed, MoveOpenIssuesTo: &release.Id}, next.Id, proj.Id, testCustomerId)
+	assert.IsType(&store.MilestoneValidationError{}, err)
+
+	open, err = stores.Issues.Update(ctx, &api.UpdatedIssue{NewIssue: api.NewIssue{Subject: "slipped", Labels: []string{}, MilestoneId: strPtr("")}, Version: 1}, open.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Nil(open.MilestoneId)
+
+	next, err = stores.Milestones.Transition(ctx, &api.MilestoneTransition{State: api.MilestoneTransitionStateClosed}, next.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.MilestoneStateClosed, next.State)
+
+	release, err = stores.Milestones.Transition(ctx, &api.MilestoneTransition{State: api.MilestoneTransitionStateOpen}, release.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.MilestoneStateOpen, release.State)
+	assert.Nil(release.ClosedAt)
+
+	milestones, err := stores.Milestones.List(ctx, string(api.MilestoneStateClosed), proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Len(milestones, 1)
+	assert.Equal(next.Id, milestones[0].Id)
+}
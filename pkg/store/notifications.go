@@ -0,0 +1,286 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/lib/pq/hstore"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// NotificationValidationError occurs when notification preferences have values which aren't allowed.
+type NotificationValidationError struct {
+	Message string
+}
+
+func (e *NotificationValidationError) Error() string {
+	return fmt.Sprintf("invalid notification preferences: %s", e.Message)
+}
+
+// NotificationNotFoundError occurs when a notification is not found.
+type NotificationNotFoundError struct {
+	Message string
+}
+
+func (e *NotificationNotFoundError) Error() string {
+	return fmt.Sprintf("notification not found: %s", e.Message)
+}
+
+// Notifications provides a store for the notification inbox of each user.
+type Notifications interface {
+	List(ctx context.Context, opt *NotificationListOptions, customerId, userId string) ([]api.Notification, error)
+	CountUnread(ctx context.Context, customerId, userId string) (int, error)
+	MarkRead(ctx context.Context, read bool, id, customerId, userId string) error
+	MarkAllRead(ctx context.Context, customerId, userId string) error
+	GetPreferences(ctx context.Context, customerId, userId string) (*api.NotificationPreferences, error)
+	UpdatePreferences(ctx context.Context, prefs *api.NotificationPreferences, customerId, userId string) (*api.NotificationPreferences, error)
+}
+
+// NotificationListOptions specifies the options for listing notifications.
+type NotificationListOptions struct {
+	// Unread only list notifications which haven't been read.
+	Unread bool
+	*LimitOffset
+}
+
+// NotificationsPG provides a notifications store for postgresql.
+type NotificationsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewNotifications new notifications store.
+func NewNotifications(dbconn *sql.DB, cfg *conf.Config) Notifications {
+	return &NotificationsPG{dbconn: dbconn, cfg: cfg}
+}
+
+// List list the notifications of a user, newest first.
+func (ns *NotificationsPG) List(ctx context.Context, opt *NotificationListOptions, customerId, userId string) ([]api.Notification, error) {
+	if opt == nil {
+		opt = &NotificationListOptions{}
+	}
+
+	conds := []*sqlf.Query{sqlf.Sprintf("n.customer_id = %s AND n.user_id = %s", customerId, userId)}
+	if opt.Unread {
+		conds = append(conds, sqlf.Sprintf("n.read_at IS NULL"))
+	}
+
+	qry := sqlf.Sprintf(`SELECT n.id, n.type, i.project_id, n.issue_id, n.comment_id, n.actor, n.details, n.read_at IS NOT NULL, n.created_at FROM notifications n
+		JOIN issues i ON i.id = n.issue_id AND i.customer_id = n.customer_id
+		WHERE %s ORDER BY n.created_at DESC, n.id ASC %s`, sqlf.Join(conds, "AND"), opt.LimitOffset.SQL())
+
+	rows, err := ns.dbconn.QueryContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list notifications by customerId: %s userId: %s", customerId, userId)
+	}
+
+	notifications := []api.Notification{}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			notification     api.Notification
+			commentId, actor sql.NullString
+			details          = hstore.Hstore{}
+		)
+
+		err := rows.Scan(&notification.Id, &notification.Type, &notification.ProjectId, &notification.IssueId, &commentId, &actor, &details, &notification.Read, &notification.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if commentId.Valid {
+			notification.CommentId = &commentId.String
+		}
+
+		if actor.Valid {
+			notification.Actor = &actor.String
+		}
+
+		notification.Details = api.NotificationDetails{}
+		for k, v := range details.Map {
+			notification.Details.Set(k, v.String)
+		}
+
+		notifications = append(notifications, notification)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+// CountUnread count the notifications of a user which haven't been read.
+func (ns *NotificationsPG) CountUnread(ctx context.Context, customerId, userId string) (int, error) {
+	var count int
+
+	err := ns.dbconn.QueryRowContext(ctx, "SELECT count(*) FROM notifications WHERE customer_id=$1 AND user_id=$2 AND read_at IS NULL", customerId, userId).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to count unread notifications by customerId: %s userId: %s", customerId, userId)
+	}
+
+	return count, nil
+}
+
+// MarkRead mark a notification of a user as read or unread.
+func (ns *NotificationsPG) MarkRead(ctx context.Context, read bool, id, customerId, userId string) error {
+	var readAt *time.Time
+	if read {
+		now := time.Now()
+		readAt = &now
+	}
+
+	res, err := ns.dbconn.ExecContext(ctx, "UPDATE notifications SET read_at=$1 WHERE id::text=$2 AND customer_id=$3 AND user_id=$4", readAt, id, customerId, userId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to mark notification by id: %s customerId: %s userId: %s", id, customerId, userId)
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return &NotificationNotFoundError{fmt.Sprintf("id %s", id)}
+	}
+
+	return nil
+}
+
+// MarkAllRead mark every unread notification of a user as read.
+func (ns *NotificationsPG) MarkAllRead(ctx context.Context, customerId, userId string) error {
+	_, err := ns.dbconn.ExecContext(ctx, "UPDATE notifications SET read_at=$1 WHERE customer_id=$2 AND user_id=$3 AND read_at IS NULL", time.Now(), customerId, userId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to mark all notifications read by customerId: %s userId: %s", customerId, userId)
+	}
+
+	return nil
+}
+
+// GetPreferences get which types of notification are recorded for a user.
+func (ns *NotificationsPG) GetPreferences(ctx context.Context, customerId, userId string) (*api.NotificationPreferences, error) {
+	rows, err := ns.dbconn.QueryContext(ctx, "SELECT type FROM notification_preferences WHERE customer_id=$1 AND user_id=$2 AND NOT enabled", customerId, userId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get notification preferences by customerId: %s userId: %s", customerId, userId)
+	}
+
+	disabled := map[api.NotificationType]bool{}
+	defer rows.Close()
+	for rows.Next() {
+		var typ api.NotificationType
+		if err := rows.Scan(&typ); err != nil {
+			return nil, err
+		}
+
+		disabled[typ] = true
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	email := api.NotificationPreferencesEmailImmediate
+
+	err = ns.dbconn.QueryRowContext(ctx, "SELECT mode FROM email_preferences WHERE customer_id=$1 AND user_id=$2", customerId, userId).Scan(&email)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errors.Wrapf(err, "failed to get email preferences by customerId: %s userId: %s", customerId, userId)
+	}
+
+	return &api.NotificationPreferences{
+		Assigned:     !disabled[api.NotificationTypeAssigned],
+		Mentioned:    !disabled[api.NotificationTypeMentioned],
+		StateChanged: !disabled[api.NotificationTypeStateChanged],
+		Commented:    !disabled[api.NotificationTypeCommented],
+		Sla:          boolPtr(!disabled[api.NotificationTypeSla]),
+		Due:          boolPtr(!disabled[api.NotificationTypeDue]),
+		Email:        &email,
+	}, nil
+}
+
+// UpdatePreferences update which types of notification are recorded for a user.
+func (ns *NotificationsPG) UpdatePreferences(ctx context.Context, prefs *api.NotificationPreferences, customerId, userId string) (*api.NotificationPreferences, error) {
+	enabled := map[api.NotificationType]bool{
+		api.NotificationTypeAssigned:     prefs.Assigned,
+		api.NotificationTypeMentioned:    prefs.Mentioned,
+		api.NotificationTypeStateChanged: prefs.StateChanged,
+		api.NotificationTypeCommented:    prefs.Commented,
+	}
+
+	// sla and due notifications are left as is when they aren't provided
+	if prefs.Sla != nil {
+		enabled[api.NotificationTypeSla] = *prefs.Sla
+	}
+
+	if prefs.Due != nil {
+		enabled[api.NotificationTypeDue] = *prefs.Due
+	}
+
+	if prefs.Email != nil {
+		switch *prefs.Email {
+		case api.NotificationPreferencesEmailOff, api.NotificationPreferencesEmailImmediate, api.NotificationPreferencesEmailDaily:
+		default:
+			return nil, &NotificationValidationError{fmt.Sprintf("unknown email mode %s", *prefs.Email)}
+		}
+	}
+
+	err := db.WithTransaction(ctx, ns.dbconn, func(tx db.Transaction) error {
+		for typ, on := range enabled {
+			_, err := tx.ExecContext(ctx, `INSERT INTO notification_preferences(customer_id, user_id, type, enabled) VALUES($1, $2, $3, $4)
+				ON CONFLICT (customer_id, user_id, type) DO UPDATE SET enabled=EXCLUDED.enabled, updated_at=now()`, customerId, userId, typ, on)
+			if err != nil {
+				return err
+			}
+		}
+
+		// the email mode is left as is when it isn't provided
+		if prefs.Email == nil {
+			return nil
+		}
+
+		_, err := tx.ExecContext(ctx, `INSERT INTO email_preferences(customer_id, user_id, mode) VALUES($1, $2, $3)
+			ON CONFLICT (customer_id, user_id) DO UPDATE SET mode=EXCLUDED.mode, updated_at=now()`, customerId, userId, *prefs.Email)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update notification preferences by customerId: %s userId: %s", customerId, userId)
+	}
+
+	return ns.GetPreferences(ctx, customerId, userId)
+}
+
+// notificationInsertSQL records a notification for each recipient returned by the select, the
+// actor isn't notified of their own changes and recipients who disabled the type are skipped.
+// Notifications for users with an email address are queued in the email outbox unless they
+// turned email off.
+const notificationInsertSQL = `WITH n AS (
+		INSERT INTO notifications(customer_id, user_id, type, issue_id, comment_id, actor, details)
+		SELECT $1, r.user_id, $2, $3, $4, NULLIF($5, ''), $6 FROM (%s) r
+		WHERE r.user_id <> $5 AND NOT EXISTS (
+			SELECT 1 FROM notification_preferences p WHERE p.customer_id = $1 AND p.user_id = r.user_id AND p.type = $2 AND NOT p.enabled
+		)
+		RETURNING id, customer_id, user_id
+	)
+	INSERT INTO email_outbox(customer_id, notification_id, user_id, mode)
+	SELECT n.customer_id, n.id, n.user_id, COALESCE(e.mode, 'immediate') FROM n
+	LEFT JOIN email_preferences e ON e.customer_id = n.customer_id AND e.user_id = n.user_id
+	WHERE COALESCE(e.mode, 'immediate') <> 'off'
+		AND EXISTS (SELECT 1 FROM users u WHERE u.customer_id = n.customer_id AND u.id::text = n.user_id)`
+
+// notifyUsers records a notification for each of the users in the transaction which caused the event.
+func notifyUsers(ctx context.Context, tx db.Transaction, typ api.NotificationType, issueId string, commentId *string, customerId, actor string, details map[string]string, userIds []string) error {
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(notificationInsertSQL, "SELECT DISTINCT unnest($7::text[]) AS user_id"),
+		customerId, typ, issueId, commentId, actor, toHstore(details), pq.Array(userIds))
+	return err
+}
+
+// notifyWatchers records a notification for each watcher of the issue in the transaction which caused the event.
+func notifyWatchers(ctx context.Context, tx db.Transaction, typ api.NotificationType, issueId string, commentId *string, customerId, actor string, details map[string]string) error {
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(notificationInsertSQL, "SELECT user_id FROM issue_watchers WHERE customer_id = $1 AND issue_id = $3"),
+		customerId, typ, issueId, commentId, actor, toHstore(details))
+	return err
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
@@ -0,0 +1,128 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestNotifications_Inbox(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	assignee := "0d6c3f4e-2a1b-4c5d-8e9f-a0b1c2d3e4f5"
+
+	newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "notify", Labels: []string{}, AssigneeId: &assignee}, testProjectId, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	// the watcher is unique to this issue so the inbox isn't affected by other tests
+	watcher := "watcher-" + newIssue.Id
+
+	err = stores.Watchers.Watch(ctx, newIssue.Id, testProjectId, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to watch issue")
+	}
+
+	_, err = stores.Comments.Create(ctx, &api.NewComment{Content: "looking"}, newIssue.Id, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	_, err = stores.Issues.Transition(ctx, &api.IssueTransition{State: api.IssueTransitionStateOpen}, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to transition issue")
+	}
+
+	notifications, err := stores.Notifications.List(ctx, &store.NotificationListOptions{LimitOffset: &store.LimitOffset{Limit: 10}}, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to list notifications")
+	}
+
+	assert.Len(notifications, 2)
+	assert.Equal(api.NotificationTypeStateChanged, notifications[0].Type)
+	assert.Equal(map[string]string{"from": "created", "to": "open"}, notifications[0].Details.AdditionalProperties)
+	assert.Equal(api.NotificationTypeCommented, notifications[1].Type)
+	assert.Equal(testAuthor, *notifications[1].Actor)
+
+	// the author isn't notified of their own comment
+	authored, err := stores.Notifications.List(ctx, &store.NotificationListOptions{LimitOffset: &store.LimitOffset{Limit: 100}}, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to list notifications")
+	}
+
+	for _, n := range authored {
+		assert.False(n.IssueId == newIssue.Id && n.Type == api.NotificationTypeCommented)
+	}
+
+	assigned, err := stores.Notifications.List(ctx, &store.NotificationListOptions{Unread: true, LimitOffset: &store.LimitOffset{Limit: 100}}, testCustomerId, assignee)
+	if err != nil {
+		t.Fatal("failed to list notifications")
+	}
+
+	assert.Equal(api.NotificationTypeAssigned, assigned[len(assigned)-1].Type)
+
+	prefs, err := stores.Notifications.UpdatePreferences(ctx, &api.NotificationPreferences{Assigned: true, Mentioned: true, StateChanged: true}, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to update preferences")
+	}
+
+	assert.False(prefs.Commented)
+
+	_, err = stores.Comments.Create(ctx, &api.NewComment{Content: "still looking"}, newIssue.Id, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	unread, err := stores.Notifications.CountUnread(ctx, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to count notifications")
+	}
+
+	assert.Equal(2, unread)
+
+	err = stores.Notifications.MarkRead(ctx, true, notifications[0].Id, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to mark notification read")
+	}
+
+	notifications, err = stores.Notifications.List(ctx, &store.NotificationListOptions{Unread: true}, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to list notifications")
+	}
+
+	assert.Len(notifications, 1)
+
+	err = stores.Notifications.MarkAllRead(ctx, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to mark notifications read")
+	}
+
+	unread, err = stores.Notifications.CountUnread(ctx, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to count notifications")
+	}
+
+	assert.Zero(unread)
+
+	err = stores.Notifications.MarkRead(ctx, true, notifications[0].Id, testCustomerId, assignee)
+	assert.IsType(&store.NotificationNotFoundError{}, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/keegancsmith/sqlf"
@@ -14,8 +15,15 @@ import (
 	"github.com/wolfeidau/exitus/pkg/db"
 )
 
-// ErrProjectNameAlreadyExists project name is already taken.
-var ErrProjectNameAlreadyExists = errors.New("project name is already taken")
+var (
+	// ErrProjectNameAlreadyExists project name is already taken.
+	ErrProjectNameAlreadyExists = errors.New("project name is already taken")
+
+	// ErrProjectKeyAlreadyExists project key is already taken.
+	ErrProjectKeyAlreadyExists = errors.New("project key is already taken")
+)
+
+var projectKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{0,9}$`)
 
 // ProjectNotFoundError occurs when an project is not found.
 type ProjectNotFoundError struct {
@@ -26,6 +34,15 @@ func (e *ProjectNotFoundError) Error() string {
 	return fmt.Sprintf("project not found: %s", e.Message)
 }
 
+// ProjectValidationError occurs when a project has values which aren't allowed.
+type ProjectValidationError struct {
+	Message string
+}
+
+func (e *ProjectValidationError) Error() string {
+	return fmt.Sprintf("invalid project: %s", e.Message)
+}
+
 // Projects provides a projects store.
 type Projects interface {
 	GetByID(ctx context.Context, id string, customerId string) (*api.Project, error)
@@ -72,8 +89,13 @@ func (ps *ProjectsPG) GetByID(ctx context.Context, id string, customerId string)
 	return &projs[0], nil
 }
 
-// Update update a project.
+// Update update a project, the key can only be set once at which point existing issues without
+// a key are numbered in the order they were created.
 func (ps *ProjectsPG) Update(ctx context.Context, updatedProject *api.UpdatedProject, id string, customerId string) (*api.Project, error) {
+	if err := validateProjectKey(updatedProject.Key); err != nil {
+		return nil, err
+	}
+
 	fields := []*sqlf.Query{sqlf.Sprintf("name=%s, labels=%s, updated_at=%s", updatedProject.Name, pq.Array(updatedProject.Labels), time.Now())}
 
 	if updatedProject.Description != nil {
@@ -82,7 +104,56 @@ func (ps *ProjectsPG) Update(ctx context.Context, updatedProject *api.UpdatedPro
 
 	qry := sqlf.Sprintf("UPDATE projects SET %s WHERE id=%s AND customer_id=%s", sqlf.Join(fields, ","), id, customerId)
 
-	if _, err := ps.dbconn.ExecContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...); err != nil {
+	err := db.WithTransaction(ctx, ps.dbconn, func(tx db.Transaction) error {
+		if _, err := tx.ExecContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...); err != nil {
+			return err
+		}
+
+		if updatedProject.Key == nil {
+			return nil
+		}
+
+		var key sql.NullString
+
+		err := tx.QueryRowContext(ctx, "SELECT key FROM projects WHERE id=$1 AND customer_id=$2 FOR UPDATE", id, customerId).Scan(&key)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &ProjectNotFoundError{fmt.Sprintf("id %s", id)}
+			}
+			return err
+		}
+
+		if key.Valid {
+			if key.String != *updatedProject.Key {
+				return &ProjectValidationError{fmt.Sprintf("key %s can't be changed", key.String)}
+			}
+			return nil
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE issues i SET key = $1 || '-' || n.num
+			FROM (SELECT id, row_number() OVER (ORDER BY created_at, id) AS num FROM issues WHERE project_id=$2 AND customer_id=$3 AND key IS NULL) n
+			WHERE i.id = n.id AND i.project_id=$2 AND i.customer_id=$3`, *updatedProject.Key, id, customerId)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE projects SET key=$1, issue_seq=(SELECT count(*) FROM issues WHERE project_id=$2 AND customer_id=$3 AND key LIKE $1 || '-%')
+			WHERE id=$2 AND customer_id=$3`, *updatedProject.Key, id, customerId)
+		return err
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Constraint {
+			case "projects_customer_id_name_key":
+				return nil, ErrProjectNameAlreadyExists
+			case "projects_customer_id_key_key", "issues_customer_id_key_key":
+				return nil, ErrProjectKeyAlreadyExists
+			}
+		}
+		switch err.(type) {
+		case *ProjectNotFoundError, *ProjectValidationError:
+			return nil, err
+		}
 		return nil, errors.Wrapf(err, "failed to update project by id: %s customerId: %s", id, customerId)
 	}
 
@@ -91,21 +162,27 @@ func (ps *ProjectsPG) Update(ctx context.Context, updatedProject *api.UpdatedPro
 
 // Create create a project.
 func (ps *ProjectsPG) Create(ctx context.Context, newProj *api.NewProject, customerId string) (*api.Project, error) {
+	if err := validateProjectKey(newProj.Key); err != nil {
+		return nil, err
+	}
+
 	resProj := api.Project{}
 
-	qry := sqlf.Sprintf("INSERT INTO projects(customer_id, name, description, labels) VALUES(%s, %s, %s, %s)",
-		customerId, newProj.Name, newProj.Description, pq.Array(newProj.Labels))
+	qry := sqlf.Sprintf("INSERT INTO projects(customer_id, name, description, key, labels) VALUES(%s, %s, %s, %s, %s)",
+		customerId, newProj.Name, newProj.Description, newProj.Key, pq.Array(newProj.Labels))
 
 	err := db.WithTransaction(ctx, ps.dbconn, func(tx db.Transaction) error {
 		return tx.QueryRowContext(
-			ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING id, name, description, labels, created_at, updated_at", qry.Args()...,
-		).Scan(&resProj.Id, &resProj.Name, &resProj.Description, pq.Array(&resProj.Labels), &resProj.CreatedAt, &resProj.UpdatedAt)
+			ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING id, name, description, key, labels, created_at, updated_at", qry.Args()...,
+		).Scan(&resProj.Id, &resProj.Name, &resProj.Description, &resProj.Key, pq.Array(&resProj.Labels), &resProj.CreatedAt, &resProj.UpdatedAt)
 	})
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Constraint {
 			case "projects_customer_id_name_key":
 				return nil, ErrProjectNameAlreadyExists
+			case "projects_customer_id_key_key":
+				return nil, ErrProjectKeyAlreadyExists
 			}
 		}
 		return nil, errors.Wrapf(err, "failed to create project with name: %s customerId: %s", newProj.Name, customerId)
@@ -129,7 +206,7 @@ func (ps *ProjectsPG) List(ctx context.Context, opt *ProjectsListOptions, custom
 }
 
 func (ps *ProjectsPG) getBySQL(ctx context.Context, query string, args ...interface{}) ([]api.Project, error) {
-	rows, err := ps.dbconn.QueryContext(ctx, "SELECT id, name, description, labels, created_at, updated_at FROM projects "+query, args...)
+	rows, err := ps.dbconn.QueryContext(ctx, "SELECT id, name, description, key, labels, created_at, updated_at FROM projects "+query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +215,7 @@ func (ps *ProjectsPG) getBySQL(ctx context.Context, query string, args ...interf
 	defer rows.Close()
 	for rows.Next() {
 		proj := api.Project{}
-		err := rows.Scan(&proj.Id, &proj.Name, &proj.Description, pq.Array(&proj.Labels), &proj.CreatedAt, &proj.UpdatedAt)
+		err := rows.Scan(&proj.Id, &proj.Name, &proj.Description, &proj.Key, pq.Array(&proj.Labels), &proj.CreatedAt, &proj.UpdatedAt)
 		if err != nil {
 			return nil, err
 		}
@@ -151,3 +228,11 @@ func (ps *ProjectsPG) getBySQL(ctx context.Context, query string, args ...interf
 
 	return projs, nil
 }
+
+func validateProjectKey(key *string) error {
+	if key != nil && !projectKeyPattern.MatchString(*key) {
+		return &ProjectValidationError{fmt.Sprintf("key %q must be one to ten upper case letters or digits starting with a letter", *key)}
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// The lengths of the periods in a report.
+const (
+	ReportIntervalDay   = "day"
+	ReportIntervalWeek  = "week"
+	ReportIntervalMonth = "month"
+)
+
+// maxReportPeriods the most periods a report can be split into.
+const maxReportPeriods = 400
+
+// doneStates issues in these states are finished, moving into them from any other state resolves
+// the issue.
+var doneStates = []string{StateResolved, StateClosed}
+
+// ageingDays the boundaries in days of the ageing buckets.
+var ageingDays = []int{1, 7, 30, 90}
+
+// ReportValidationError occurs when the range or interval of a report is invalid.
+type ReportValidationError struct {
+	Message string
+}
+
+func (e *ReportValidationError) Error() string {
+	return fmt.Sprintf("invalid report: %s", e.Message)
+}
+
+// ReportOptions the range of a report, from is inclusive and to is exclusive.
+type ReportOptions struct {
+	From     time.Time
+	To       time.Time
+	Interval string
+}
+
+// Reports provides a store for reports on how issues flow through a project.
+type Reports interface {
+	Get(ctx context.Context, opt *ReportOptions, projectId, customerId string) (*api.ProjectReport, error)
+	GetSLA(ctx context.Context, projectId, customerId string) (*api.SlaReport, error)
+}
+
+// ReportsPG provides a reports store for postgresql.
+type ReportsPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewReports new reports store.
+func NewReports(dbconn *sql.DB, cfg *conf.Config) Reports {
+	return &ReportsPG{dbconn: dbconn, cfg: cfg}
+}
+
+// Get compute the report of a project, each part is aggregated in the database from the issues
+// and the history of their states. The parts are read in one read only transaction so they are
+// consistent with each other.
+func (rs *ReportsPG) Get(ctx context.Context, opt *ReportOptions, projectId, customerId string) (*api.ProjectReport, error) {
+	periods, err := reportPeriods(opt)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &api.ProjectReport{
+		ProjectId: projectId,
+		From:      opt.From,
+		To:        opt.To,
+		Interval:  opt.Interval,
+	}
+
+	err = db.WithTransaction(ctx, rs.dbconn, func(tx db.Transaction) error {
+		if _, err := tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY"); err != nil {
+			return err
+		}
+
+		var exists bool
+		err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM projects WHERE id=$1 AND customer_id=$2)", projectId, customerId).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return &ProjectNotFoundError{fmt.Sprintf("id %s", projectId)}
+		}
+
+		if report.Throughput, err = reportThroughput(ctx, tx, opt, periods, projectId, customerId); err != nil {
+			return errors.Wrap(err, "failed to report throughput")
+		}
+
+		if report.Open, err = reportOpen(ctx, tx, projectId, customerId); err != nil {
+			return errors.Wrap(err, "failed to report open issues")
+		}
+
+		if report.Resolution, err = reportResolution(ctx, tx, opt, projectId, customerId); err != nil {
+			return errors.Wrap(err, "failed to report resolution")
+		}
+
+		if report.Ageing, err = reportAgeing(ctx, tx, projectId, customerId); err != nil {
+			return errors.Wrap(err, "failed to report ageing")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// reportPeriods fill in the defaults of the options and return the start of each period in the
+// report, the first period starts at or before from.
+func reportPeriods(opt *ReportOptions) ([]time.Time, error) {
+	if opt.Interval == "" {
+		opt.Interval = ReportIntervalDay
+	}
+
+	current, err := truncatePeriod(time.Now(), opt.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	// the default range ends with the current period so it doesn't change until the period ends
+	if opt.To.IsZero() {
+		opt.To = nextPeriod(current, opt.Interval)
+	}
+
+	if opt.From.IsZero() {
+		opt.From = opt.To.AddDate(0, 0, -30)
+	}
+
+	if !opt.From.Before(opt.To) {
+		return nil, &ReportValidationError{"from must be before to"}
+	}
+
+	start, _ := truncatePeriod(opt.From, opt.Interval)
+
+	periods := []time.Time{}
+	for t := start; t.Before(opt.To); t = nextPeriod(t, opt.Interval) {
+		if len(periods) == maxReportPeriods {
+			return nil, &ReportValidationError{fmt.Sprintf("the report can't have more than %d periods", maxReportPeriods)}
+		}
+		periods = append(periods, t)
+	}
+
+	return periods, nil
+}
+
+// truncatePeriod the start of the period containing t, periods are in UTC to match date_trunc in
+// the database and weeks start on Monday.
+func truncatePeriod(t time.Time, interval string) (time.Time, error) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch interval {
+	case ReportIntervalDay:
+		return day, nil
+	case ReportIntervalWeek:
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7)), nil
+	case ReportIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+	}
+
+	return time.Time{}, &ReportValidationError{fmt.Sprintf("unknown interval %s", interval)}
+}
+
+// nextPeriod the start of the period after the one starting at t.
+func nextPeriod(t time.Time, interval string) time.Time {
+	switch interval {
+	case ReportIntervalWeek:
+		return t.AddDate(0, 0, 7)
+	case ReportIntervalMonth:
+		return t.AddDate(0, 1, 0)
+	}
+
+	return t.AddDate(0, 0, 1)
+}
+
+func reportThroughput(ctx context.Context, tx db.Transaction, opt *ReportOptions, periods []time.Time, projectId, customerId string) ([]api.ReportPeriod, error) {
+	created, err := countByPeriod(ctx, tx, `SELECT date_trunc($1, created_at AT TIME ZONE 'UTC'), count(*) FROM issues
+		WHERE project_id=$2 AND customer_id=$3 AND created_at >= $4 AND created_at < $5
+		GROUP BY 1`, opt.Interval, projectId, customerId, opt.From, opt.To)
+	if err != nil {
+		return nil, err
+	}
+
+	// moving between resolved and closed doesn't resolve the issue again
+	resolved, err := countByPeriod(ctx, tx, `SELECT date_trunc($1, sc.created_at AT TIME ZONE 'UTC'), count(*) FROM issue_state_changes sc
+		JOIN issues i ON i.id = sc.issue_id AND i.customer_id = sc.customer_id
+		WHERE i.project_id=$2 AND sc.customer_id=$3 AND sc.created_at >= $4 AND sc.created_at < $5
+		AND sc.to_state = ANY($6) AND NOT sc.from_state = ANY($6)
+		GROUP BY 1`, opt.Interval, projectId, customerId, opt.From, opt.To, pq.Array(doneStates))
+	if err != nil {
+		return nil, err
+	}
+
+	throughput := []api.ReportPeriod{}
+	for _, start := range periods {
+		throughput = append(throughput, api.ReportPeriod{Start: start, Created: created[start.Unix()], Resolved: resolved[start.Unix()]})
+	}
+
+	return throughput, nil
+}
+
+// countByPeriod the counts of a query grouped by period keyed by the unix time of the period.
+func countByPeriod(ctx context.Context, q queryer, query string, args ...interface{}) (map[int64]int, error) {
+	rows, err := q.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[int64]int{}
+	defer rows.Close()
+	for rows.Next() {
+		var period time.Time
+		var count int
+
+		if err := rows.Scan(&period, &count); err != nil {
+			return nil, err
+		}
+
+		// the period has no time zone, it is read as UTC
+		counts[time.Date(period.Year(), period.Month(), period.Day(), 0, 0, 0, 0, time.UTC).Unix()] = count
+	}
+
+	return counts, rows.Err()
+}
+
+func reportOpen(ctx context.Context, tx db.Transaction, projectId, customerId string) (api.ReportOpenIssues, error) {
+	open := api.ReportOpenIssues{
+		ByState:    []api.ReportCount{},
+		BySeverity: []api.ReportCount{},
+		ByCategory: []api.ReportCount{},
+		ByAssignee: []api.ReportCount{},
+	}
+
+	// each grouping set counts the open issues by one column, the total is the empty set
+	rows, err := tx.QueryContext(ctx, `SELECT GROUPING(state, severity, category, assignee), COALESCE(state, severity, category, assignee::text), count(*)
+		FROM issues WHERE project_id=$1 AND customer_id=$2 AND NOT state = ANY($3)
+		GROUP BY GROUPING SETS ((state), (severity), (category), (assignee), ())
+		ORDER BY 1, 3 DESC, 2`, projectId, customerId, pq.Array(doneStates))
+	if err != nil {
+		return open, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var grouping int
+		var value sql.NullString
+		count := api.ReportCount{}
+
+		if err := rows.Scan(&grouping, &value, &count.Count); err != nil {
+			return open, err
+		}
+
+		if value.Valid {
+			count.Value = &value.String
+		}
+
+		// the bits of the grouping are set for the columns which aren't grouped, state is the highest
+		switch grouping {
+		case 0b0111:
+			open.ByState = append(open.ByState, count)
+		case 0b1011:
+			open.BySeverity = append(open.BySeverity, count)
+		case 0b1101:
+			open.ByCategory = append(open.ByCategory, count)
+		case 0b1110:
+			open.ByAssignee = append(open.ByAssignee, count)
+		case 0b1111:
+			open.Total = count.Count
+		}
+	}
+
+	return open, rows.Err()
+}
+
+func reportResolution(ctx context.Context, tx db.Transaction, opt *ReportOptions, projectId, customerId string) (api.ReportResolution, error) {
+	resolution := api.ReportResolution{}
+
+	var meanResolve, medianResolve, meanCycle, medianCycle sql.NullFloat64
+
+	err := tx.QueryRowContext(ctx, `WITH resolved AS (
+			SELECT sc.issue_id, min(sc.created_at) AS resolved_at FROM issue_state_changes sc
+			WHERE sc.customer_id=$2 AND sc.to_state = ANY($5) GROUP BY sc.issue_id
+		), started AS (
+			SELECT sc.issue_id, min(sc.created_at) AS started_at FROM issue_state_changes sc
+			WHERE sc.customer_id=$2 AND sc.to_state = $6 GROUP BY sc.issue_id
+		), durations AS (
+			SELECT extract(epoch FROM r.resolved_at - i.created_at)::float8 AS resolve,
+				CASE WHEN s.started_at < r.resolved_at THEN extract(epoch FROM r.resolved_at - s.started_at)::float8 END AS cycle
+			FROM issues i
+			JOIN resolved r ON r.issue_id = i.id
+			LEFT JOIN started s ON s.issue_id = i.id
+			WHERE i.project_id=$1 AND i.customer_id=$2 AND r.resolved_at >= $3 AND r.resolved_at < $4
+		)
+		SELECT count(*), avg(resolve), percentile_cont(0.5) WITHIN GROUP (ORDER BY resolve),
+			count(cycle), avg(cycle), percentile_cont(0.5) WITHIN GROUP (ORDER BY cycle)
+		FROM durations`, projectId, customerId, opt.From, opt.To, pq.Array(doneStates), StateInProgress,
+	).Scan(&resolution.Resolved, &meanResolve, &medianResolve, &resolution.Started, &meanCycle, &medianCycle)
+	if err != nil {
+		return resolution, err
+	}
+
+	resolution.MeanTimeToResolve = float64Ptr(meanResolve)
+	resolution.MedianTimeToResolve = float64Ptr(medianResolve)
+	resolution.MeanCycleTime = float64Ptr(meanCycle)
+	resolution.MedianCycleTime = float64Ptr(medianCycle)
+
+	return resolution, nil
+}
+
+func reportAgeing(ctx context.Context, tx db.Transaction, projectId, customerId string) ([]api.ReportAgeingBucket, error) {
+	ageing := []api.ReportAgeingBucket{{MinDays: 0, MaxDays: &ageingDays[0]}}
+	for i, days := range ageingDays {
+		bucket := api.ReportAgeingBucket{MinDays: days}
+		if i+1 < len(ageingDays) {
+			bucket.MaxDays = &ageingDays[i+1]
+		}
+		ageing = append(ageing, bucket)
+	}
+
+	// width_bucket numbers the buckets from 0 for issues younger than the first boundary
+	rows, err := tx.QueryContext(ctx, `SELECT width_bucket((extract(epoch FROM now() - created_at) / 86400)::float8, $3::float8[]), count(*)
+		FROM issues WHERE project_id=$1 AND customer_id=$2 AND NOT state = ANY($4)
+		GROUP BY 1`, projectId, customerId, pq.Array(ageingDays), pq.Array(doneStates))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var bucket, count int
+
+		if err := rows.Scan(&bucket, &count); err != nil {
+			return nil, err
+		}
+
+		ageing[bucket].Count = count
+	}
+
+	return ageing, rows.Err()
+}
+
+func float64Ptr(v sql.NullFloat64) *float64 {
+	if !v.Valid {
+		return nil
+	}
+
+	return &v.Float64
+}
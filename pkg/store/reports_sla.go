@@ -0,0 +1,122 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// maxSLAReportIssues the most at risk and breached issues listed in an SLA report.
+const maxSLAReportIssues = 500
+
+// GetSLA compute the SLA report of a project, the counts and issues are read in one read only
+// transaction so they are consistent with each other.
+func (rs *ReportsPG) GetSLA(ctx context.Context, projectId, customerId string) (*api.SlaReport, error) {
+	report := &api.SlaReport{ProjectId: projectId, Issues: []api.SlaReportIssue{}}
+
+	err := db.WithTransaction(ctx, rs.dbconn, func(tx db.Transaction) error {
+		if _, err := tx.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY"); err != nil {
+			return err
+		}
+
+		var exists bool
+		err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM projects WHERE id=$1 AND customer_id=$2)", projectId, customerId).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return &ProjectNotFoundError{fmt.Sprintf("id %s", projectId)}
+		}
+
+		if err := reportSLACounts(ctx, tx, report, projectId, customerId); err != nil {
+			return errors.Wrap(err, "failed to report sla counts")
+		}
+
+		if report.Issues, err = reportSLAIssues(ctx, tx, projectId, customerId); err != nil {
+			return errors.Wrap(err, "failed to report sla issues")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// reportSLACounts count the clocks of the issues in the project by status.
+func reportSLACounts(ctx context.Context, tx db.Transaction, report *api.SlaReport, projectId, customerId string) error {
+	rows, err := tx.QueryContext(ctx, `SELECT s.first_response_status, s.resolution_status, count(*) FROM issue_sla s
+		JOIN issues i ON i.id = s.issue_id AND i.customer_id = s.customer_id
+		WHERE i.project_id=$1 AND i.customer_id=$2
+		GROUP BY 1, 2`, projectId, customerId)
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			firstResponse, resolution sql.NullString
+			count                     int
+		)
+
+		if err := rows.Scan(&firstResponse, &resolution, &count); err != nil {
+			return err
+		}
+
+		countSLAStatus(&report.FirstResponse, firstResponse, count)
+		countSLAStatus(&report.Resolution, resolution, count)
+	}
+
+	return rows.Err()
+}
+
+func countSLAStatus(counts *api.SlaStatusCounts, status sql.NullString, count int) {
+	switch api.SlaClockStatus(status.String) {
+	case api.SlaClockStatusOk:
+		counts.Ok += count
+	case api.SlaClockStatusAtRisk:
+		counts.AtRisk += count
+	case api.SlaClockStatusBreached:
+		counts.Breached += count
+	case api.SlaClockStatusMet:
+		counts.Met += count
+	}
+}
+
+// reportSLAIssues the open issues with a clock which is at risk or breached, the soonest due first.
+func reportSLAIssues(ctx context.Context, tx db.Transaction, projectId, customerId string) ([]api.SlaReportIssue, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT i.id, i.key, i.subject, i.state, i.severity, i.assignee::text, `+issueSLAColumns+` FROM issue_sla s
+		JOIN issues i ON i.id = s.issue_id AND i.customer_id = s.customer_id
+		WHERE i.project_id=$1 AND i.customer_id=$2 AND i.state <> ALL($3)
+			AND (s.first_response_status = ANY($4) OR s.resolution_status = ANY($4))
+		ORDER BY LEAST(s.first_response_due_at, s.resolution_due_at) NULLS LAST, i.created_at
+		LIMIT $5`, projectId, customerId, pq.Array(doneStates), pq.Array([]string{string(api.SlaClockStatusAtRisk), string(api.SlaClockStatusBreached)}), maxSLAReportIssues)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := []api.SlaReportIssue{}
+
+	defer rows.Close()
+	for rows.Next() {
+		issue := api.SlaReportIssue{}
+
+		sla, err := scanIssueSLA(rows, &issue.IssueId, &issue.Key, &issue.Subject, &issue.State, &issue.Severity, &issue.AssigneeId)
+		if err != nil {
+			return nil, err
+		}
+
+		issue.Sla = *sla
+		issues = append(issues, issue)
+	}
+
+	return issues, rows.Err()
+}
@@ -0,0 +1,102 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestReports_Validation(t *testing.T) {
+	assert := require.New(t)
+
+	rstore := store.NewReports(nil, &conf.Config{})
+	now := time.Now()
+
+	for _, opt := range []*store.ReportOptions{
+		{Interval: "year"},
+		{From: now, To: now.Add(-time.Hour), Interval: store.ReportIntervalDay},
+		{From: now.AddDate(-2, 0, 0), To: now, Interval: store.ReportIntervalDay},
+	} {
+		_, err := rstore.Get(context.Background(), opt, testProjectId, testCustomerId)
+		assert.IsType(&store.ReportValidationError{}, err)
+	}
+}
+
+func TestReports_Get(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "reports", Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	issueIds := []string{}
+	for _, subject := range []string{"started", "resolved", "waiting"} {
+		issue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: subject, Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+		if err != nil {
+			t.Fatal("failed to create issue")
+		}
+		issueIds = append(issueIds, issue.Id)
+	}
+
+	for _, tr := range []struct {
+		issue int
+		state api.IssueTransitionState
+	}{
+		{0, api.IssueTransitionStateInProgress},
+		{0, api.IssueTransitionStateResolved},
+		{0, api.IssueTransitionStateClosed},
+		{1, api.IssueTransitionStateResolved},
+		{2, api.IssueTransitionStateOpen},
+	} {
+		_, err := stores.Issues.Transition(ctx, &api.IssueTransition{State: tr.state}, issueIds[tr.issue], proj.Id, testCustomerId)
+		if err != nil {
+			t.Fatal("failed to transition issue")
+		}
+	}
+
+	report, err := stores.Reports.Get(ctx, &store.ReportOptions{Interval: store.ReportIntervalWeek}, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(store.ReportIntervalWeek, report.Interval)
+	assert.Equal(time.Monday, report.To.Weekday())
+
+	// all the issues were created and resolved in the current week, moving to closed doesn't count
+	current := report.Throughput[len(report.Throughput)-1]
+	assert.Equal(3, current.Created)
+	assert.Equal(2, current.Resolved)
+
+	assert.Equal(1, report.Open.Total)
+	assert.Equal([]api.ReportCount{{Value: strPtr(store.StateOpen), Count: 1}}, report.Open.ByState)
+	assert.Equal([]api.ReportCount{{Count: 1}}, report.Open.ByAssignee)
+
+	assert.Equal(2, report.Resolution.Resolved)
+	assert.Equal(1, report.Resolution.Started)
+	assert.NotNil(report.Resolution.MeanTimeToResolve)
+	assert.NotNil(report.Resolution.MeanCycleTime)
+
+	assert.Len(report.Ageing, 5)
+	assert.Equal(1, report.Ageing[0].Count)
+
+	_, err = stores.Reports.Get(ctx, &store.ReportOptions{}, testProjectId, testCustomerId)
+	assert.IsType(&store.ProjectNotFoundError{}, err)
+}
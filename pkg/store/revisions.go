@@ -0,0 +1,90 @@
+package store
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// ListRevisions list every revision of the subject and content of an issue, oldest first.
+func (is *IssuesPG) ListRevisions(ctx context.Context, id, projectId, customerId string) ([]api.IssueRevision, error) {
+	if err := issueExists(ctx, is.dbconn, id, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	rows, err := is.dbconn.QueryContext(ctx, "SELECT revision, subject, content, created_at FROM issue_revisions WHERE issue_id=$1 AND customer_id=$2 ORDER BY revision ASC", id, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list revisions of issue by id: %s customerId: %s", id, customerId)
+	}
+
+	revisions := []api.IssueRevision{}
+	defer rows.Close()
+	for rows.Next() {
+		rev := api.IssueRevision{}
+		if err := rows.Scan(&rev.Revision, &rev.Subject, &rev.Content, &rev.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// ListRevisions list every revision of the content of a comment, oldest first.
+func (cs *CommentsPG) ListRevisions(ctx context.Context, id, issueId, projectId, customerId string) ([]api.CommentRevision, error) {
+	if _, err := cs.GetByID(ctx, id, issueId, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	rows, err := cs.dbconn.QueryContext(ctx, "SELECT revision, content, created_at FROM comment_revisions WHERE comment_id=$1 AND customer_id=$2 ORDER BY revision ASC", id, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list revisions of comment by id: %s customerId: %s", id, customerId)
+	}
+
+	revisions := []api.CommentRevision{}
+	defer rows.Close()
+	for rows.Next() {
+		rev := api.CommentRevision{}
+		if err := rows.Scan(&rev.Revision, &rev.Content, &rev.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		revisions = append(revisions, rev)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+// recordIssueRevision adds a revision when the subject or content of an issue differs from the
+// latest revision, this is called in the transaction which changed the issue so the issue row is
+// locked while the next revision number is allocated.
+func recordIssueRevision(ctx context.Context, tx db.Transaction, id, customerId string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO issue_revisions(customer_id, issue_id, revision, subject, content)
+		SELECT i.customer_id, i.id, COALESCE(r.revision, 0) + 1, i.subject, i.content FROM issues i
+		LEFT JOIN LATERAL (
+			SELECT revision, subject, content FROM issue_revisions WHERE customer_id = i.customer_id AND issue_id = i.id ORDER BY revision DESC LIMIT 1
+		) r ON true
+		WHERE i.id=$1 AND i.customer_id=$2 AND (r.revision IS NULL OR (r.subject, r.content) IS DISTINCT FROM (i.subject, i.content))`, id, customerId)
+	return err
+}
+
+// recordCommentRevision adds a revision when the content of a comment differs from the latest
+// revision, this is called in the transaction which changed the comment.
+func recordCommentRevision(ctx context.Context, tx db.Transaction, id, customerId string) error {
+	_, err := tx.ExecContext(ctx, `INSERT INTO comment_revisions(customer_id, comment_id, revision, content)
+		SELECT c.customer_id, c.id, COALESCE(r.revision, 0) + 1, c.content FROM comments c
+		LEFT JOIN LATERAL (
+			SELECT revision, content FROM comment_revisions WHERE customer_id = c.customer_id AND comment_id = c.id ORDER BY revision DESC LIMIT 1
+		) r ON true
+		WHERE c.id=$1 AND c.customer_id=$2 AND (r.revision IS NULL OR r.content IS DISTINCT FROM c.content)`, id, customerId)
+	return err
+}
@@ -0,0 +1,78 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestRevisions_IssuesAndComments(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "first subject", Content: "first content", Labels: []string{}}, testProjectId, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	for _, subject := range []string{"second subject", "second subject"} {
+		_, err = stores.Issues.Update(ctx, &api.UpdatedIssue{NewIssue: api.NewIssue{Subject: subject, Content: "first content", Labels: []string{"updated"}}}, newIssue.Id, testProjectId, testCustomerId)
+		if err != nil {
+			t.Fatal("failed to update issue")
+		}
+	}
+
+	issueRevisions, err := stores.Issues.ListRevisions(ctx, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list issue revisions")
+	}
+
+	// changes which don't touch the subject or content aren't revisions
+	assert.Len(issueRevisions, 2)
+	assert.Equal("first subject", issueRevisions[0].Subject)
+	assert.Equal("second subject", issueRevisions[1].Subject)
+	assert.Equal(2, issueRevisions[1].Revision)
+
+	newComment, err := stores.Comments.Create(ctx, &api.NewComment{Content: "first"}, newIssue.Id, testProjectId, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	assert.False(newComment.Edited)
+
+	updated, err := stores.Comments.Update(ctx, &api.UpdatedComment{NewComment: api.NewComment{Content: "second"}}, newComment.Id, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to update comment")
+	}
+
+	assert.True(updated.Edited)
+
+	commentRevisions, err := stores.Comments.ListRevisions(ctx, newComment.Id, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list comment revisions")
+	}
+
+	assert.Len(commentRevisions, 2)
+	assert.Equal("first", commentRevisions[0].Content)
+	assert.Equal("second", commentRevisions[1].Content)
+
+	_, err = stores.Issues.ListRevisions(ctx, testIssueId, testProjectId, testCustomerId)
+	assert.IsType(&store.IssueNotFoundError{}, err)
+}
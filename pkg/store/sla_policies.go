@@ -0,0 +1,481 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/calendar"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// defaultAtRiskPercent the percentage of a target which elapses before an issue is at risk.
+const defaultAtRiskPercent = 75
+
+// slaClocks the names of the clocks tracked for each issue, these prefix the issue_sla columns.
+var slaClocks = []string{"first_response", "resolution"}
+
+// ErrSLAPolicyAlreadyExists a policy already exists for the project or customer.
+var ErrSLAPolicyAlreadyExists = errors.New("sla policy already exists")
+
+// SLAPolicyNotFoundError occurs when an SLA policy is not found.
+type SLAPolicyNotFoundError struct {
+	Message string
+}
+
+func (e *SLAPolicyNotFoundError) Error() string {
+	return fmt.Sprintf("sla policy not found: %s", e.Message)
+}
+
+// SLAPolicyValidationError occurs when an SLA policy is invalid.
+type SLAPolicyValidationError struct {
+	Message string
+}
+
+func (e *SLAPolicyValidationError) Error() string {
+	return fmt.Sprintf("invalid sla policy: %s", e.Message)
+}
+
+// SLACheckResult the number of clocks flagged by a check.
+type SLACheckResult struct {
+	AtRisk   int
+	Breached int
+}
+
+// SLAPolicies provides an SLA policies store.
+type SLAPolicies interface {
+	GetByID(ctx context.Context, id, customerId string) (*api.SlaPolicy, error)
+	Create(ctx context.Context, newPolicy *api.NewSlaPolicy, customerId string) (*api.SlaPolicy, error)
+	Update(ctx context.Context, updatedPolicy *api.UpdatedSlaPolicy, id, customerId string) (*api.SlaPolicy, error)
+	Delete(ctx context.Context, id, customerId string) error
+	List(ctx context.Context, customerId string) ([]api.SlaPolicy, error)
+	Check(ctx context.Context, now time.Time) (*SLACheckResult, error)
+}
+
+// SLAPoliciesPG provides an SLA policies store for postgresql.
+type SLAPoliciesPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewSLAPolicies new SLA policies store.
+func NewSLAPolicies(dbconn *sql.DB, cfg *conf.Config) SLAPolicies {
+	return &SLAPoliciesPG{dbconn: dbconn, cfg: cfg}
+}
+
+// GetByID get SLA policy by id.
+func (sp *SLAPoliciesPG) GetByID(ctx context.Context, id, customerId string) (*api.SlaPolicy, error) {
+	policies, err := getSLAPoliciesBySQL(ctx, sp.dbconn, "WHERE id=$1 AND customer_id=$2 LIMIT 1", id, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sla policy by id: %s customerId: %s", id, customerId)
+	}
+
+	if len(policies) == 0 {
+		return nil, &SLAPolicyNotFoundError{fmt.Sprintf("id %s", id)}
+	}
+
+	return &policies[0].SlaPolicy, nil
+}
+
+// Create create an SLA policy, the clocks of the open issues it applies to are recomputed.
+func (sp *SLAPoliciesPG) Create(ctx context.Context, newPolicy *api.NewSlaPolicy, customerId string) (*api.SlaPolicy, error) {
+	policy, err := newSLAPolicy(newPolicy.Name, newPolicy.Calendar, newPolicy.Targets, newPolicy.AtRiskPercent)
+	if err != nil {
+		return nil, err
+	}
+
+	var projectId *string
+	if newPolicy.ProjectId != nil && *newPolicy.ProjectId != "" {
+		projectId = newPolicy.ProjectId
+	}
+
+	var id string
+
+	err = db.WithTransaction(ctx, sp.dbconn, func(tx db.Transaction) error {
+		if projectId != nil {
+			var exists bool
+			err := tx.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM projects WHERE id=$1 AND customer_id=$2)", *projectId, customerId).Scan(&exists)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return &ProjectNotFoundError{fmt.Sprintf("id %s", *projectId)}
+			}
+		}
+
+		err := tx.QueryRowContext(ctx, "INSERT INTO sla_policies(customer_id, project_id, name, calendar, targets, at_risk_percent) VALUES($1, $2, $3, $4, $5, $6) RETURNING id",
+			customerId, projectId, policy.Name, policy.calendarJSON, policy.targetsJSON, policy.AtRiskPercent).Scan(&id)
+		if err != nil {
+			return err
+		}
+
+		return resyncSLAs(ctx, tx, projectId, customerId)
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Constraint {
+			case "sla_policies_project_idx", "sla_policies_default_idx":
+				return nil, ErrSLAPolicyAlreadyExists
+			}
+		}
+		switch err.(type) {
+		case *ProjectNotFoundError, *SLAPolicyValidationError:
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to create sla policy with name: %s customerId: %s", newPolicy.Name, customerId)
+	}
+
+	return sp.GetByID(ctx, id, customerId)
+}
+
+// Update update an SLA policy, the clocks of the open issues it applies to are recomputed.
+func (sp *SLAPoliciesPG) Update(ctx context.Context, updatedPolicy *api.UpdatedSlaPolicy, id, customerId string) (*api.SlaPolicy, error) {
+	policy, err := newSLAPolicy(updatedPolicy.Name, updatedPolicy.Calendar, updatedPolicy.Targets, updatedPolicy.AtRiskPercent)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.WithTransaction(ctx, sp.dbconn, func(tx db.Transaction) error {
+		var projectId *string
+
+		err := tx.QueryRowContext(ctx, "UPDATE sla_policies SET name=$1, calendar=$2, targets=$3, at_risk_percent=$4, updated_at=$5 WHERE id=$6 AND customer_id=$7 RETURNING project_id",
+			policy.Name, policy.calendarJSON, policy.targetsJSON, policy.AtRiskPercent, time.Now(), id, customerId).Scan(&projectId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &SLAPolicyNotFoundError{fmt.Sprintf("id %s", id)}
+			}
+			return err
+		}
+
+		return resyncSLAs(ctx, tx, projectId, customerId)
+	})
+	if err != nil {
+		switch err.(type) {
+		case *SLAPolicyNotFoundError, *SLAPolicyValidationError:
+			return nil, err
+		}
+		return nil, errors.Wrapf(err, "failed to update sla policy by id: %s customerId: %s", id, customerId)
+	}
+
+	return sp.GetByID(ctx, id, customerId)
+}
+
+// Delete delete an SLA policy, open issues fall back to the customer default and closed issues
+// keep the clocks they finished with.
+func (sp *SLAPoliciesPG) Delete(ctx context.Context, id, customerId string) error {
+	err := db.WithTransaction(ctx, sp.dbconn, func(tx db.Transaction) error {
+		var projectId *string
+
+		err := tx.QueryRowContext(ctx, "DELETE FROM sla_policies WHERE id=$1 AND customer_id=$2 RETURNING project_id", id, customerId).Scan(&projectId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &SLAPolicyNotFoundError{fmt.Sprintf("id %s", id)}
+			}
+			return err
+		}
+
+		return resyncSLAs(ctx, tx, projectId, customerId)
+	})
+	if err != nil {
+		if _, ok := err.(*SLAPolicyNotFoundError); ok {
+			return err
+		}
+		return errors.Wrapf(err, "failed to delete sla policy by id: %s customerId: %s", id, customerId)
+	}
+
+	return nil
+}
+
+// List list the SLA policies of a customer, the default policy is first.
+func (sp *SLAPoliciesPG) List(ctx context.Context, customerId string) ([]api.SlaPolicy, error) {
+	policies, err := getSLAPoliciesBySQL(ctx, sp.dbconn, "WHERE customer_id=$1 ORDER BY project_id NULLS FIRST, name", customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list sla policies customerId: %s", customerId)
+	}
+
+	res := make([]api.SlaPolicy, len(policies))
+	for i := range policies {
+		res[i] = policies[i].SlaPolicy
+	}
+
+	return res, nil
+}
+
+// Check flag the clocks of every customer which passed their at risk or due time and notify the
+// watchers of those issues. Statuses only move forward and are changed with a conditional update,
+// so checks can run at the same time on every replica and each issue is only flagged once.
+func (sp *SLAPoliciesPG) Check(ctx context.Context, now time.Time) (*SLACheckResult, error) {
+	res := &SLACheckResult{}
+
+	err := db.WithTransaction(ctx, sp.dbconn, func(tx db.Transaction) error {
+		for _, clock := range slaClocks {
+			// breaches are flagged first so a clock which passed both is only flagged once
+			n, err := flagSLAClocks(ctx, tx, clock, api.SlaClockStatusBreached,
+				fmt.Sprintf("%[1]s_breached_at=%[1]s_due_at", clock),
+				fmt.Sprintf("%[1]s_status IN ('ok', 'at_risk') AND %[1]s_due_at <= $1", clock), now)
+			if err != nil {
+				return err
+			}
+			res.Breached += n
+
+			n, err = flagSLAClocks(ctx, tx, clock, api.SlaClockStatusAtRisk, "",
+				fmt.Sprintf("%[1]s_status = 'ok' AND %[1]s_at_risk_at <= $1", clock), now)
+			if err != nil {
+				return err
+			}
+			res.AtRisk += n
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to check sla clocks")
+	}
+
+	return res, nil
+}
+
+// flagSLAClocks move the clocks matching the condition to the status and notify the watchers of
+// the issues, this returns the number of clocks flagged.
+func flagSLAClocks(ctx context.Context, tx db.Transaction, clock string, status api.SlaClockStatus, set, cond string, now time.Time) (int, error) {
+	fields := []string{fmt.Sprintf("%s_status='%s'", clock, status), "updated_at=$1"}
+	if set != "" {
+		fields = append(fields, set)
+	}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("UPDATE issue_sla SET %s WHERE %s RETURNING customer_id, issue_id", strings.Join(fields, ", "), cond), now)
+	if err != nil {
+		return 0, err
+	}
+
+	flagged := [][2]string{}
+
+	defer rows.Close()
+	for rows.Next() {
+		var customerId, issueId string
+		if err := rows.Scan(&customerId, &issueId); err != nil {
+			return 0, err
+		}
+		flagged = append(flagged, [2]string{customerId, issueId})
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, f := range flagged {
+		err := notifyWatchers(ctx, tx, api.NotificationTypeSla, f[1], nil, f[0], "", map[string]string{"clock": clock, "status": string(status)})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(flagged), nil
+}
+
+// resyncSLAs recompute the clocks of the open issues a policy applies to, project policies apply
+// to the issues of the project and the default applies to projects without their own policy.
+func resyncSLAs(ctx context.Context, tx db.Transaction, projectId *string, customerId string) error {
+	qry := `SELECT id FROM issues WHERE customer_id=$1 AND project_id=$2 AND state <> ALL($3) FOR UPDATE`
+	args := []interface{}{customerId, projectId, pq.Array(doneStates)}
+
+	if projectId == nil {
+		qry = `SELECT id FROM issues WHERE customer_id=$1 AND state <> ALL($2) AND project_id NOT IN (
+				SELECT project_id FROM sla_policies WHERE customer_id=$1 AND project_id IS NOT NULL
+			) FOR UPDATE`
+		args = []interface{}{customerId, pq.Array(doneStates)}
+	}
+
+	rows, err := tx.QueryContext(ctx, qry, args...)
+	if err != nil {
+		return err
+	}
+
+	ids := []string{}
+
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, id := range ids {
+		if _, err := syncIssueSLA(ctx, tx, id, customerId, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// slaPolicy a policy along with the encoded calendar and targets which are stored as json.
+type slaPolicy struct {
+	api.SlaPolicy
+	calendarJSON []byte
+	targetsJSON  []byte
+}
+
+// newSLAPolicy validates a new or updated policy and encodes it for storage.
+func newSLAPolicy(name string, cal *api.SlaCalendar, targets []api.SlaTarget, atRiskPercent *int) (*slaPolicy, error) {
+	policy := &slaPolicy{SlaPolicy: api.SlaPolicy{Name: strings.TrimSpace(name), Targets: targets, AtRiskPercent: defaultAtRiskPercent}}
+
+	if policy.Name == "" {
+		return nil, &SLAPolicyValidationError{"name is required"}
+	}
+
+	if atRiskPercent != nil {
+		if *atRiskPercent < 1 || *atRiskPercent > 99 {
+			return nil, &SLAPolicyValidationError{"at_risk_percent must be between 1 and 99"}
+		}
+		policy.AtRiskPercent = *atRiskPercent
+	}
+
+	policy.Calendar = api.SlaCalendar{Timezone: "UTC", Hours: []api.SlaBusinessHours{}, Holidays: []string{}}
+	if cal != nil {
+		policy.Calendar = *cal
+		if policy.Calendar.Timezone == "" {
+			policy.Calendar.Timezone = "UTC"
+		}
+		if policy.Calendar.Hours == nil {
+			policy.Calendar.Hours = []api.SlaBusinessHours{}
+		}
+		if policy.Calendar.Holidays == nil {
+			policy.Calendar.Holidays = []string{}
+		}
+	}
+
+	if _, err := policy.calendar(); err != nil {
+		return nil, &SLAPolicyValidationError{err.Error()}
+	}
+
+	if len(targets) == 0 {
+		return nil, &SLAPolicyValidationError{"at least one target is required"}
+	}
+
+	seen := map[string]bool{}
+	for _, target := range targets {
+		if target.Severity == "" {
+			return nil, &SLAPolicyValidationError{"target severity is required"}
+		}
+		if seen[target.Severity] {
+			return nil, &SLAPolicyValidationError{fmt.Sprintf("duplicate target for severity %s", target.Severity)}
+		}
+		seen[target.Severity] = true
+
+		if target.FirstResponseMinutes == nil && target.ResolutionMinutes == nil {
+			return nil, &SLAPolicyValidationError{fmt.Sprintf("target for severity %s requires first_response_minutes or resolution_minutes", target.Severity)}
+		}
+		if (target.FirstResponseMinutes != nil && *target.FirstResponseMinutes < 1) || (target.ResolutionMinutes != nil && *target.ResolutionMinutes < 1) {
+			return nil, &SLAPolicyValidationError{fmt.Sprintf("targets for severity %s must be at least one minute", target.Severity)}
+		}
+	}
+
+	var err error
+
+	if policy.calendarJSON, err = json.Marshal(policy.Calendar); err != nil {
+		return nil, err
+	}
+
+	if policy.targetsJSON, err = json.Marshal(policy.Targets); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// target the target of a severity, nil when issues with the severity aren't tracked.
+func (p *slaPolicy) target(severity string) *api.SlaTarget {
+	for i := range p.Targets {
+		if p.Targets[i].Severity == severity {
+			return &p.Targets[i]
+		}
+	}
+
+	return nil
+}
+
+// calendar the business hours targets are measured in.
+func (p *slaPolicy) calendar() (*calendar.Calendar, error) {
+	hours := make([]calendar.Hours, len(p.Calendar.Hours))
+	for i, h := range p.Calendar.Hours {
+		day, err := calendar.ParseWeekday(string(h.Day))
+		if err != nil {
+			return nil, err
+		}
+
+		start, err := calendar.ParseClock(h.Start)
+		if err != nil {
+			return nil, err
+		}
+
+		end, err := calendar.ParseClock(h.End)
+		if err != nil {
+			return nil, err
+		}
+
+		hours[i] = calendar.Hours{Day: day, Start: start, End: end}
+	}
+
+	return calendar.New(p.Calendar.Timezone, hours, p.Calendar.Holidays)
+}
+
+// loadSLAPolicy the policy which applies to issues in the project, this is the project policy
+// or the customer default, nil when neither exists.
+func loadSLAPolicy(ctx context.Context, q queryer, projectId, customerId string) (*slaPolicy, error) {
+	policies, err := getSLAPoliciesBySQL(ctx, q, "WHERE customer_id=$1 AND (project_id=$2 OR project_id IS NULL) ORDER BY project_id NULLS LAST LIMIT 1", customerId, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(policies) == 0 {
+		return nil, nil
+	}
+
+	return &policies[0], nil
+}
+
+func getSLAPoliciesBySQL(ctx context.Context, q queryer, query string, args ...interface{}) ([]slaPolicy, error) {
+	rows, err := q.QueryContext(ctx, "SELECT id, project_id, name, calendar, targets, at_risk_percent, created_at, updated_at FROM sla_policies "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := []slaPolicy{}
+	defer rows.Close()
+	for rows.Next() {
+		var policy slaPolicy
+
+		err := rows.Scan(&policy.Id, &policy.ProjectId, &policy.Name, &policy.calendarJSON, &policy.targetsJSON, &policy.AtRiskPercent, &policy.CreatedAt, &policy.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(policy.calendarJSON, &policy.Calendar); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(policy.targetsJSON, &policy.Targets); err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, policy)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
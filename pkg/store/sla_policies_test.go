@@ -0,0 +1,151 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestSLAPolicies_Validation(t *testing.T) {
+	assert := require.New(t)
+
+	sstore := store.NewSLAPolicies(nil, &conf.Config{})
+	targets := []api.SlaTarget{{Severity: "critical", FirstResponseMinutes: intPtr(60)}}
+
+	for _, newPolicy := range []*api.NewSlaPolicy{
+		{Name: "", Targets: targets},
+		{Name: "no targets", Targets: []api.SlaTarget{}},
+		{Name: "empty target", Targets: []api.SlaTarget{{Severity: "critical"}}},
+		{Name: "duplicate", Targets: append(targets, targets...)},
+		{Name: "at risk", Targets: targets, AtRiskPercent: intPtr(100)},
+		{Name: "timezone", Targets: targets, Calendar: &api.SlaCalendar{Timezone: "Mars/Olympus"}},
+		{Name: "hours", Targets: targets, Calendar: &api.SlaCalendar{Hours: []api.SlaBusinessHours{{Day: api.SlaBusinessHoursDayMonday, Start: "17:00", End: "09:00"}}}},
+	} {
+		_, err := sstore.Create(context.Background(), newPolicy, testCustomerId)
+		assert.IsType(&store.SLAPolicyValidationError{}, err, newPolicy.Name)
+	}
+}
+
+func TestSLAPolicies_Track(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "sla", Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	policy, err := stores.SLAPolicies.Create(ctx, &api.NewSlaPolicy{
+		Name:      "support",
+		ProjectId: &proj.Id,
+		Targets:   []api.SlaTarget{{Severity: "critical", FirstResponseMinutes: intPtr(60), ResolutionMinutes: intPtr(240)}},
+	}, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(75, policy.AtRiskPercent)
+	assert.Equal("UTC", policy.Calendar.Timezone)
+
+	_, err = stores.SLAPolicies.Create(ctx, &api.NewSlaPolicy{Name: "again", ProjectId: &proj.Id, Targets: policy.Targets}, testCustomerId)
+	assert.Equal(store.ErrSLAPolicyAlreadyExists, err)
+
+	// issues with a severity without a target aren't tracked
+	untracked, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "minor", Severity: "low", Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+	assert.NoError(err)
+	assert.Nil(untracked.Sla)
+
+	issue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "outage", Severity: "critical", Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+	assert.NoError(err)
+	assert.NotNil(issue.Sla)
+	assert.Equal(policy.Id, issue.Sla.PolicyId)
+	assert.Equal(api.SlaClockStatusOk, issue.Sla.FirstResponse.Status)
+	assert.Equal(issue.CreatedAt.Add(time.Hour).Unix(), issue.Sla.FirstResponse.DueAt.Unix())
+
+	// the reporter commenting isn't a response
+	_, err = stores.Comments.Create(ctx, &api.NewComment{Content: "any news?"}, issue.Id, proj.Id, testCustomerId, testReporter)
+	assert.NoError(err)
+
+	issue, err = stores.Issues.GetByID(ctx, issue.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.SlaClockStatusOk, issue.Sla.FirstResponse.Status)
+
+	_, err = stores.Comments.Create(ctx, &api.NewComment{Content: "looking into it"}, issue.Id, proj.Id, testCustomerId, testAuthor)
+	assert.NoError(err)
+
+	issue, err = stores.Issues.GetByID(ctx, issue.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.SlaClockStatusMet, issue.Sla.FirstResponse.Status)
+	assert.NotNil(issue.Sla.FirstResponse.CompletedAt)
+	assert.Equal(api.SlaClockStatusOk, issue.Sla.Resolution.Status)
+
+	// the checker flags the resolution clock once it is past due, only the first check flags it
+	future := time.Now().Add(5 * time.Hour)
+
+	res, err := stores.SLAPolicies.Check(ctx, future)
+	assert.NoError(err)
+	assert.GreaterOrEqual(res.Breached, 1)
+
+	res, err = stores.SLAPolicies.Check(ctx, future)
+	assert.NoError(err)
+	assert.Equal(&store.SLACheckResult{}, res)
+
+	issue, err = stores.Issues.GetByID(ctx, issue.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.SlaClockStatusBreached, issue.Sla.Resolution.Status)
+	assert.Equal(issue.Sla.Resolution.DueAt, issue.Sla.Resolution.BreachedAt)
+
+	report, err := stores.Reports.GetSLA(ctx, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.SlaStatusCounts{Met: 1}, report.FirstResponse)
+	assert.Equal(api.SlaStatusCounts{Breached: 1}, report.Resolution)
+	assert.Len(report.Issues, 1)
+	assert.Equal(issue.Id, report.Issues[0].IssueId)
+
+	// resolving stops the resolution clock
+	_, err = stores.Issues.Transition(ctx, &api.IssueTransition{State: api.IssueTransitionStateResolved}, issue.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+
+	issue, err = stores.Issues.GetByID(ctx, issue.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Equal(api.SlaClockStatusMet, issue.Sla.Resolution.Status)
+	assert.NotNil(issue.Sla.Resolution.CompletedAt)
+
+	report, err = stores.Reports.GetSLA(ctx, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Empty(report.Issues)
+
+	// open issues stop being tracked when the policy is removed
+	open, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "still down", Severity: "critical", Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+	assert.NoError(err)
+	assert.NotNil(open.Sla)
+
+	assert.NoError(stores.SLAPolicies.Delete(ctx, policy.Id, testCustomerId))
+
+	open, err = stores.Issues.GetByID(ctx, open.Id, proj.Id, testCustomerId)
+	assert.NoError(err)
+	assert.Nil(open.Sla)
+
+	err = stores.SLAPolicies.Delete(ctx, policy.Id, testCustomerId)
+	assert.IsType(&store.SLAPolicyNotFoundError{}, err)
+}
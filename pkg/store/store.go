@@ -1,30 +1,86 @@
 package store
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/keegancsmith/sqlf"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/blob"
 	"github.com/wolfeidau/exitus/pkg/conf"
 )
 
 // Stores one stop for stores.
 type Stores struct {
-	Projects  Projects
-	Customers Customers
-	Issues    Issues
-	Comments  Comments
+	Projects      Projects
+	Customers     Customers
+	Issues        Issues
+	Comments      Comments
+	Taxonomies    Taxonomies
+	CustomFields  CustomFields
+	IssueLinks    IssueLinks
+	Activity      Activity
+	Watchers      Watchers
+	Users         Users
+	Mentions      Mentions
+	Notifications Notifications
+	Outbox        Outbox
+	InboundEmails InboundEmails
+	Attachments   Attachments
+	Jobs          Jobs
+	Exports       Exports
+	ExternalIDs   ExternalIDs
+	Reports       Reports
+	SLAPolicies   SLAPolicies
+	DueReminders  DueReminders
+	Milestones    Milestones
+	ContentHTML   ContentHTML
 }
 
 // New create all the stores.
 func New(dbconn *sql.DB, cfg *conf.Config) (*Stores, error) {
+	// blob storage is created as attachments are used, check it is configured up front
+	if _, err := blob.New(cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to configure blob storage")
+	}
+
 	return &Stores{
-		Projects:  NewProjects(dbconn, cfg),
-		Customers: NewCustomers(dbconn, cfg),
-		Issues:    NewIssues(dbconn, cfg),
-		Comments:  NewComments(dbconn, cfg),
+		Projects:      NewProjects(dbconn, cfg),
+		Customers:     NewCustomers(dbconn, cfg),
+		Issues:        NewIssues(dbconn, cfg),
+		Comments:      NewComments(dbconn, cfg),
+		Taxonomies:    NewTaxonomies(dbconn, cfg),
+		CustomFields:  NewCustomFields(dbconn, cfg),
+		IssueLinks:    NewIssueLinks(dbconn, cfg),
+		Activity:      NewActivity(dbconn, cfg),
+		Watchers:      NewWatchers(dbconn, cfg),
+		Users:         NewUsers(dbconn, cfg),
+		Mentions:      NewMentions(dbconn, cfg),
+		Notifications: NewNotifications(dbconn, cfg),
+		Outbox:        NewOutbox(dbconn, cfg),
+		InboundEmails: NewInboundEmails(dbconn, cfg),
+		Attachments:   NewAttachments(dbconn, cfg),
+		Jobs:          NewJobs(dbconn, cfg),
+		Exports:       NewExports(dbconn, cfg),
+		ExternalIDs:   NewExternalIDs(dbconn, cfg),
+		Reports:       NewReports(dbconn, cfg),
+		SLAPolicies:   NewSLAPolicies(dbconn, cfg),
+		DueReminders:  NewDueReminders(dbconn, cfg),
+		Milestones:    NewMilestones(dbconn, cfg),
+		ContentHTML:   NewContentHTML(dbconn, cfg),
 	}, nil
 }
 
+// queryRower is satisfied by both *sql.DB and db.Transaction.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// queryer is satisfied by both *sql.DB and db.Transaction.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
 // LimitOffset specifies SQL LIMIT and OFFSET counts. A pointer to it is typically embedded in other options
 // structures that need to perform SQL queries with LIMIT and OFFSET.
 type LimitOffset struct {
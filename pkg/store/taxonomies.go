@@ -0,0 +1,227 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+)
+
+// TaxonomyValidationError occurs when an updated taxonomy is invalid.
+type TaxonomyValidationError struct {
+	Message string
+}
+
+func (e *TaxonomyValidationError) Error() string {
+	return fmt.Sprintf("invalid taxonomy: %s", e.Message)
+}
+
+// Taxonomies provides a store for the severities and categories allowed on issues.
+type Taxonomies interface {
+	GetByCustomerID(ctx context.Context, customerId string) (*api.Taxonomy, error)
+	UpdateByCustomerID(ctx context.Context, updatedTaxonomy *api.UpdatedTaxonomy, customerId string) (*api.Taxonomy, error)
+	GetByProjectID(ctx context.Context, projectId, customerId string) (*api.Taxonomy, error)
+	UpdateByProjectID(ctx context.Context, updatedTaxonomy *api.UpdatedTaxonomy, projectId, customerId string) (*api.Taxonomy, error)
+}
+
+// TaxonomiesPG provides a taxonomies store for postgresql.
+type TaxonomiesPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewTaxonomies new taxonomies store.
+func NewTaxonomies(dbconn *sql.DB, cfg *conf.Config) Taxonomies {
+	return &TaxonomiesPG{dbconn: dbconn, cfg: cfg}
+}
+
+// GetByCustomerID get the default taxonomy for projects of a customer.
+func (ts *TaxonomiesPG) GetByCustomerID(ctx context.Context, customerId string) (*api.Taxonomy, error) {
+	tax := &taxonomy{}
+
+	err := ts.dbconn.QueryRowContext(ctx, "SELECT severities, categories FROM customers WHERE id=$1", customerId).
+		Scan(pq.Array(&tax.severities), pq.Array(&tax.categories))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &CustomerNotFoundError{fmt.Sprintf("id %s", customerId)}
+		}
+		return nil, errors.Wrapf(err, "failed to get taxonomy by customerId: %s", customerId)
+	}
+
+	return tax.toAPI(), nil
+}
+
+// UpdateByCustomerID update the default taxonomy for projects of a customer.
+func (ts *TaxonomiesPG) UpdateByCustomerID(ctx context.Context, updatedTaxonomy *api.UpdatedTaxonomy, customerId string) (*api.Taxonomy, error) {
+	tax, err := newTaxonomy(updatedTaxonomy)
+	if err != nil {
+		return nil, err
+	}
+
+	qry := sqlf.Sprintf("UPDATE customers SET severities=%s, categories=%s, updated_at=%s WHERE id=%s",
+		pq.Array(tax.severities), pq.Array(tax.categories), time.Now(), customerId)
+
+	res, err := ts.dbconn.ExecContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update taxonomy by customerId: %s", customerId)
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return nil, &CustomerNotFoundError{fmt.Sprintf("id %s", customerId)}
+	}
+
+	return ts.GetByCustomerID(ctx, customerId)
+}
+
+// GetByProjectID get the taxonomy for a project, severities or categories which are not set
+// on the project are inherited from the customer.
+func (ts *TaxonomiesPG) GetByProjectID(ctx context.Context, projectId, customerId string) (*api.Taxonomy, error) {
+	tax := &taxonomy{}
+
+	err := ts.dbconn.QueryRowContext(ctx, `SELECT COALESCE(p.severities, c.severities), COALESCE(p.categories, c.categories)
+		FROM projects p LEFT JOIN customers c ON c.id = p.customer_id WHERE p.id=$1 AND p.customer_id=$2`, projectId, customerId).
+		Scan(pq.Array(&tax.severities), pq.Array(&tax.categories))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, &ProjectNotFoundError{fmt.Sprintf("id %s", projectId)}
+		}
+		return nil, errors.Wrapf(err, "failed to get taxonomy by projectId: %s customerId: %s", projectId, customerId)
+	}
+
+	return tax.toAPI(), nil
+}
+
+// UpdateByProjectID update the taxonomy for a project.
+func (ts *TaxonomiesPG) UpdateByProjectID(ctx context.Context, updatedTaxonomy *api.UpdatedTaxonomy, projectId, customerId string) (*api.Taxonomy, error) {
+	tax, err := newTaxonomy(updatedTaxonomy)
+	if err != nil {
+		return nil, err
+	}
+
+	qry := sqlf.Sprintf("UPDATE projects SET severities=%s, categories=%s, updated_at=%s WHERE id=%s AND customer_id=%s",
+		pq.Array(tax.severities), pq.Array(tax.categories), time.Now(), projectId, customerId)
+
+	res, err := ts.dbconn.ExecContext(ctx, qry.Query(sqlf.PostgresBindVar), qry.Args()...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update taxonomy by projectId: %s customerId: %s", projectId, customerId)
+	}
+
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return nil, &ProjectNotFoundError{fmt.Sprintf("id %s", projectId)}
+	}
+
+	return ts.GetByProjectID(ctx, projectId, customerId)
+}
+
+// taxonomy the severities and categories allowed on issues, an empty list is unrestricted.
+type taxonomy struct {
+	severities []string
+	categories []string
+}
+
+func newTaxonomy(updatedTaxonomy *api.UpdatedTaxonomy) (*taxonomy, error) {
+	tax := &taxonomy{}
+
+	// empty lists are stored as NULL so they fall back to the customer, or are unrestricted
+	if updatedTaxonomy.Severities != nil && len(*updatedTaxonomy.Severities) > 0 {
+		if err := validateTaxonomyNames("severity", *updatedTaxonomy.Severities); err != nil {
+			return nil, err
+		}
+		tax.severities = *updatedTaxonomy.Severities
+	}
+
+	if updatedTaxonomy.Categories != nil && len(*updatedTaxonomy.Categories) > 0 {
+		if err := validateTaxonomyNames("category", *updatedTaxonomy.Categories); err != nil {
+			return nil, err
+		}
+		tax.categories = *updatedTaxonomy.Categories
+	}
+
+	return tax, nil
+}
+
+func validateTaxonomyNames(kind string, names []string) error {
+	seen := map[string]bool{}
+
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			return &TaxonomyValidationError{fmt.Sprintf("%s must not be empty", kind)}
+		}
+		if seen[name] {
+			return &TaxonomyValidationError{fmt.Sprintf("duplicate %s %s", kind, name)}
+		}
+		seen[name] = true
+	}
+
+	return nil
+}
+
+// loadTaxonomy resolves the taxonomy used to validate issues in a project, this doesn't
+// require the project or customer to exist.
+func loadTaxonomy(ctx context.Context, dbconn *sql.DB, projectId, customerId string) (*taxonomy, error) {
+	tax := &taxonomy{}
+
+	err := dbconn.QueryRowContext(ctx, `SELECT
+		COALESCE((SELECT severities FROM projects WHERE id=$1 AND customer_id=$2), (SELECT severities FROM customers WHERE id=$2)),
+		COALESCE((SELECT categories FROM projects WHERE id=$1 AND customer_id=$2), (SELECT categories FROM customers WHERE id=$2))`,
+		projectId, customerId).Scan(pq.Array(&tax.severities), pq.Array(&tax.categories))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load taxonomy for projectId: %s customerId: %s", projectId, customerId)
+	}
+
+	return tax, nil
+}
+
+// validate check the severity and category of an issue are allowed.
+func (t *taxonomy) validate(severity, category string) error {
+	if err := t.validateSeverity(severity); err != nil {
+		return err
+	}
+
+	if len(t.categories) > 0 && !containsString(t.categories, category) {
+		return &IssueValidationError{fmt.Sprintf("unknown category %s", category)}
+	}
+
+	return nil
+}
+
+// validateSeverity check the severity of an issue is allowed.
+func (t *taxonomy) validateSeverity(severity string) error {
+	if len(t.severities) > 0 && !containsString(t.severities, severity) {
+		return &IssueValidationError{fmt.Sprintf("unknown severity %s", severity)}
+	}
+
+	return nil
+}
+
+func (t *taxonomy) toAPI() *api.Taxonomy {
+	res := &api.Taxonomy{
+		Severities: []api.Severity{},
+		Categories: []string{},
+	}
+
+	for i, name := range t.severities {
+		res.Severities = append(res.Severities, api.Severity{Name: name, Rank: i + 1})
+	}
+
+	res.Categories = append(res.Categories, t.categories...)
+
+	return res
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
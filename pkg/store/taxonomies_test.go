@@ -0,0 +1,103 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestTaxonomies_UpdateValidateSort(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	cust, err := stores.Customers.Create(ctx, &api.NewCustomer{Name: "test customer", Labels: []string{}})
+	if err != nil {
+		t.Fatal("failed to create customer")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "test project", Labels: []string{}}, cust.Id)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	_, err = stores.Taxonomies.UpdateByCustomerID(ctx, &api.UpdatedTaxonomy{
+		Severities: &[]string{"critical", "major", "minor"},
+		Categories: &[]string{"bug", "feature"},
+	}, cust.Id)
+	if err != nil {
+		t.Fatal("failed to update customer taxonomy")
+	}
+
+	// the project overrides categories and inherits severities from the customer
+	projTax, err := stores.Taxonomies.UpdateByProjectID(ctx, &api.UpdatedTaxonomy{
+		Categories: &[]string{"bug", "support"},
+	}, proj.Id, cust.Id)
+	if err != nil {
+		t.Fatal("failed to update project taxonomy")
+	}
+
+	assert.Equal([]api.Severity{{Name: "critical", Rank: 1}, {Name: "major", Rank: 2}, {Name: "minor", Rank: 3}}, projTax.Severities)
+	assert.Equal([]string{"bug", "support"}, projTax.Categories)
+
+	_, err = stores.Taxonomies.UpdateByProjectID(ctx, &api.UpdatedTaxonomy{
+		Categories: &[]string{"bug", "bug"},
+	}, proj.Id, cust.Id)
+	assert.IsType(&store.TaxonomyValidationError{}, err)
+
+	_, err = stores.Issues.Create(ctx, &api.NewIssue{
+		Subject:  "test issue",
+		Severity: "critical",
+		Category: "feature",
+		Labels:   []string{},
+	}, proj.Id, cust.Id, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	for _, severity := range []string{"minor", "critical", "major"} {
+		_, err = stores.Issues.Create(ctx, &api.NewIssue{
+			Subject:  "test issue " + severity,
+			Severity: severity,
+			Category: "bug",
+			Labels:   []string{},
+		}, proj.Id, cust.Id, testReporter)
+		if err != nil {
+			t.Fatal("failed to create issue")
+		}
+	}
+
+	opt := store.NewIssueListOptions("", 0, 100)
+	opt.IssueSortOptions, err = store.NewIssueSortOptions("-severity")
+	if err != nil {
+		t.Fatal("failed to parse sort")
+	}
+
+	listIssue, err := stores.Issues.List(ctx, opt, proj.Id, cust.Id)
+	if err != nil {
+		t.Fatal("failed to list issues")
+	}
+
+	// the most severe issues are first
+	assert.Len(listIssue, 3)
+	assert.Equal("critical", listIssue[0].Severity)
+	assert.Equal("major", listIssue[1].Severity)
+	assert.Equal("minor", listIssue[2].Severity)
+
+	_, err = store.NewIssueSortOptions("reporter")
+	assert.IsType(&store.SortFieldError{}, err)
+}
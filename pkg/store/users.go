@@ -0,0 +1,197 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/mail"
+	"regexp"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+var (
+	// ErrUserLoginAlreadyExists user login is already taken.
+	ErrUserLoginAlreadyExists = errors.New("user login is already taken")
+
+	// ErrUserSubjectAlreadyExists user subject is already linked to another user.
+	ErrUserSubjectAlreadyExists = errors.New("user subject is already linked to another user")
+)
+
+// UserNotFoundError occurs when a user is not found.
+type UserNotFoundError struct {
+	Message string
+}
+
+func (e *UserNotFoundError) Error() string {
+	return fmt.Sprintf("user not found: %s", e.Message)
+}
+
+// UserValidationError occurs when a user has values which aren't allowed.
+type UserValidationError struct {
+	Message string
+}
+
+func (e *UserValidationError) Error() string {
+	return fmt.Sprintf("invalid user: %s", e.Message)
+}
+
+// userLoginPattern logins are the names used in @mentions.
+var userLoginPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,38}$`)
+
+// Users provides a users store.
+type Users interface {
+	GetByID(ctx context.Context, id, customerId string) (*api.User, error)
+	GetBySubject(ctx context.Context, subject, customerId string) (*api.User, error)
+	Create(ctx context.Context, newUser *api.NewUser, customerId string) (*api.User, error)
+	List(ctx context.Context, opt *UsersListOptions, customerId string) ([]api.User, error)
+}
+
+// UsersListOptions specifies the options for listing users.
+type UsersListOptions struct {
+	*NameLikeOptions
+	*LimitOffset
+}
+
+// NewUsersListOptions create a new opts.
+func NewUsersListOptions(query string, offset int, limit int) *UsersListOptions {
+	return &UsersListOptions{
+		NameLikeOptions: &NameLikeOptions{query},
+		LimitOffset:     &LimitOffset{Limit: limit, Offset: offset},
+	}
+}
+
+// UsersPG provides a users store using postgresql.
+type UsersPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewUsers new users store.
+func NewUsers(dbconn *sql.DB, cfg *conf.Config) Users {
+	return &UsersPG{dbconn: dbconn, cfg: cfg}
+}
+
+// GetByID get user by id.
+func (us *UsersPG) GetByID(ctx context.Context, id, customerId string) (*api.User, error) {
+	if _, err := uuid.FromString(id); err != nil {
+		return nil, &UserNotFoundError{fmt.Sprintf("id %s", id)}
+	}
+
+	users, err := us.getBySQL(ctx, "WHERE id=$1 AND customer_id=$2 LIMIT 1", id, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user by id: %s customerId: %s", id, customerId)
+	}
+
+	if len(users) == 0 {
+		return nil, &UserNotFoundError{fmt.Sprintf("id %s", id)}
+	}
+
+	return &users[0], nil
+}
+
+// GetBySubject get user by the subject of their access token.
+func (us *UsersPG) GetBySubject(ctx context.Context, subject, customerId string) (*api.User, error) {
+	if subject == "" {
+		return nil, &UserNotFoundError{"empty subject"}
+	}
+
+	users, err := us.getBySQL(ctx, "WHERE subject=$1 AND customer_id=$2 LIMIT 1", subject, customerId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user by subject: %s customerId: %s", subject, customerId)
+	}
+
+	if len(users) == 0 {
+		return nil, &UserNotFoundError{fmt.Sprintf("subject %s", subject)}
+	}
+
+	return &users[0], nil
+}
+
+// Create create a user, logins are unique to a customer ignoring case.
+func (us *UsersPG) Create(ctx context.Context, newUser *api.NewUser, customerId string) (*api.User, error) {
+	if !userLoginPattern.MatchString(newUser.Login) {
+		return nil, &UserValidationError{fmt.Sprintf("login %s must be letters, numbers, _ or - and at most 39 characters", newUser.Login)}
+	}
+
+	// the email is used in mail headers so only a plain address is accepted
+	if addr, err := mail.ParseAddress(newUser.Email); err != nil || addr.Address != newUser.Email {
+		return nil, &UserValidationError{fmt.Sprintf("email %q must be a valid email address", newUser.Email)}
+	}
+
+	if newUser.Subject != nil && *newUser.Subject == "" {
+		newUser.Subject = nil
+	}
+
+	resUser := &api.User{}
+
+	qry := sqlf.Sprintf("INSERT INTO users(customer_id, login, name, email, subject) VALUES(%s, %s, %s, %s, %s)",
+		customerId, newUser.Login, newUser.Name, newUser.Email, newUser.Subject)
+
+	err := db.WithTransaction(ctx, us.dbconn, func(tx db.Transaction) error {
+		return tx.QueryRowContext(
+			ctx, qry.Query(sqlf.PostgresBindVar)+" RETURNING id, login, name, email, subject, created_at, updated_at", qry.Args()...,
+		).Scan(&resUser.Id, &resUser.Login, &resUser.Name, &resUser.Email, &resUser.Subject, &resUser.CreatedAt, &resUser.UpdatedAt)
+	})
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok {
+			switch pqErr.Constraint {
+			case "users_customer_id_login_key":
+				return nil, ErrUserLoginAlreadyExists
+			case "users_customer_id_subject_key":
+				return nil, ErrUserSubjectAlreadyExists
+			}
+		}
+
+		return nil, errors.Wrapf(err, "failed to create user with login: %s customerId: %s", newUser.Login, customerId)
+	}
+
+	return resUser, nil
+}
+
+// List list users, the query matches the name or login.
+func (us *UsersPG) List(ctx context.Context, opt *UsersListOptions, customerId string) ([]api.User, error) {
+	if opt == nil {
+		opt = &UsersListOptions{}
+	}
+
+	conds := []*sqlf.Query{sqlf.Sprintf("customer_id = %s", customerId)}
+	if opt.NameLikeOptions != nil && opt.Query != "" {
+		query := "%" + opt.Query + "%"
+		conds = append(conds, sqlf.Sprintf("(name ILIKE %s OR login ILIKE %s)", query, query))
+	}
+
+	q := sqlf.Sprintf("WHERE %s ORDER BY login ASC %s", sqlf.Join(conds, "AND"), opt.LimitOffset.SQL())
+
+	return us.getBySQL(ctx, q.Query(sqlf.PostgresBindVar), q.Args()...)
+}
+
+func (us *UsersPG) getBySQL(ctx context.Context, query string, args ...interface{}) ([]api.User, error) {
+	rows, err := us.dbconn.QueryContext(ctx, "SELECT id, login, name, email, subject, created_at, updated_at FROM users "+query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	users := []api.User{}
+	defer rows.Close()
+	for rows.Next() {
+		user := api.User{}
+		err := rows.Scan(&user.Id, &user.Login, &user.Name, &user.Email, &user.Subject, &user.CreatedAt, &user.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, user)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
@@ -0,0 +1,22 @@
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestUsers_EmailValidation(t *testing.T) {
+	assert := require.New(t)
+
+	ustore := store.NewUsers(nil, &conf.Config{})
+
+	for _, email := range []string{"", "alice", "alice@example.com\r\nBcc: mallory@example.com", "Alice <alice@example.com>"} {
+		_, err := ustore.Create(context.Background(), &api.NewUser{Login: "alice", Name: "Alice", Email: email}, testCustomerId)
+		assert.IsType(&store.UserValidationError{}, err, email)
+	}
+}
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// reactionNames the reactions which can be added to comments.
+var reactionNames = []string{"+1", "-1", "laugh", "confused", "heart", "hooray", "rocket", "eyes"}
+
+// Vote add the vote of a user to an issue, voting more than once has no effect.
+func (is *IssuesPG) Vote(ctx context.Context, id, projectId, customerId, userId string) (*api.Issue, error) {
+	return is.updateVote(ctx, `INSERT INTO issue_votes(customer_id, issue_id, user_id) VALUES($1, $2, $3) ON CONFLICT DO NOTHING`, 1, id, projectId, customerId, userId)
+}
+
+// Unvote remove the vote of a user from an issue, this has no effect if the user hasn't voted.
+func (is *IssuesPG) Unvote(ctx context.Context, id, projectId, customerId, userId string) (*api.Issue, error) {
+	return is.updateVote(ctx, `DELETE FROM issue_votes WHERE customer_id=$1 AND issue_id=$2 AND user_id=$3`, -1, id, projectId, customerId, userId)
+}
+
+// updateVote applies the vote change and adjusts the count on the issue when it changed anything.
+func (is *IssuesPG) updateVote(ctx context.Context, query string, delta int, id, projectId, customerId, userId string) (*api.Issue, error) {
+	issue, err := is.GetByID(ctx, id, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.WithTransaction(ctx, is.dbconn, func(tx db.Transaction) error {
+		res, err := tx.ExecContext(ctx, query, customerId, issue.Id, userId)
+		if err != nil {
+			return err
+		}
+
+		if n, err := res.RowsAffected(); err != nil || n == 0 {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE issues SET votes = votes + $1 WHERE id=$2 AND customer_id=$3", delta, issue.Id, customerId)
+		return err
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update vote on issue by id: %s customerId: %s userId: %s", issue.Id, customerId, userId)
+	}
+
+	return is.GetByID(ctx, issue.Id, projectId, customerId)
+}
+
+// AddReaction add a reaction from a user to a comment, reacting more than once has no effect.
+func (cs *CommentsPG) AddReaction(ctx context.Context, reaction, id, issueId, projectId, customerId, userId string) (*api.Comment, error) {
+	return cs.updateReaction(ctx, `INSERT INTO comment_reactions(customer_id, comment_id, user_id, reaction) VALUES($1, $2, $3, $4) ON CONFLICT DO NOTHING`,
+		reaction, id, issueId, projectId, customerId, userId)
+}
+
+// RemoveReaction remove a reaction of a user from a comment, this has no effect if the user hasn't reacted.
+func (cs *CommentsPG) RemoveReaction(ctx context.Context, reaction, id, issueId, projectId, customerId, userId string) (*api.Comment, error) {
+	return cs.updateReaction(ctx, `DELETE FROM comment_reactions WHERE customer_id=$1 AND comment_id=$2 AND user_id=$3 AND reaction=$4`,
+		reaction, id, issueId, projectId, customerId, userId)
+}
+
+func (cs *CommentsPG) updateReaction(ctx context.Context, query, reaction, id, issueId, projectId, customerId, userId string) (*api.Comment, error) {
+	if !containsString(reactionNames, reaction) {
+		return nil, &CommentValidationError{fmt.Sprintf("unknown reaction %s", reaction)}
+	}
+
+	comment, err := cs.GetByID(ctx, id, issueId, projectId, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.Deleted {
+		return nil, &CommentNotFoundError{fmt.Sprintf("id %s issueId: %s project_id %s", id, issueId, projectId)}
+	}
+
+	if _, err := cs.dbconn.ExecContext(ctx, query, customerId, id, userId, reaction); err != nil {
+		return nil, errors.Wrapf(err, "failed to update reaction on comment by id: %s customerId: %s userId: %s", id, customerId, userId)
+	}
+
+	return cs.GetByID(ctx, id, issueId, projectId, customerId)
+}
+
+// loadReactions counts the reactions for a page of comments using a single query.
+func loadReactions(ctx context.Context, q queryer, comments []api.Comment, customerId string) error {
+	if len(comments) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(comments))
+	idx := map[string]*api.Comment{}
+	for i := range comments {
+		ids[i] = comments[i].Id
+		idx[comments[i].Id] = &comments[i]
+		comments[i].Reactions = []api.Reaction{}
+	}
+
+	rows, err := q.QueryContext(ctx, `SELECT comment_id, reaction, count(*) FROM comment_reactions WHERE customer_id=$1 AND comment_id = ANY($2::uuid[])
+		GROUP BY comment_id, reaction ORDER BY min(created_at) ASC, reaction ASC`, customerId, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			commentId string
+			reaction  api.Reaction
+		)
+
+		if err := rows.Scan(&commentId, &reaction.Reaction, &reaction.Count); err != nil {
+			return err
+		}
+
+		if comment, ok := idx[commentId]; ok {
+			comment.Reactions = append(comment.Reactions, reaction)
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,102 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestVotes_IssuesAndReactions(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	proj, err := stores.Projects.Create(ctx, &api.NewProject{Name: "votes", Labels: []string{}}, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to create project")
+	}
+
+	quiet, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "quiet", Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	popular, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "popular", Labels: []string{}}, proj.Id, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	// voting twice counts once
+	for _, userId := range []string{"user-a", "user-a", "user-b"} {
+		_, err = stores.Issues.Vote(ctx, popular.Id, proj.Id, testCustomerId, userId)
+		if err != nil {
+			t.Fatal("failed to vote for issue")
+		}
+	}
+
+	voted, err := stores.Issues.Unvote(ctx, popular.Id, proj.Id, testCustomerId, "user-c")
+	if err != nil {
+		t.Fatal("failed to remove vote for issue")
+	}
+
+	assert.Equal(2, voted.Votes)
+
+	opt := store.NewIssueListOptions("", 0, 100)
+	opt.IssueSortOptions, err = store.NewIssueSortOptions("-votes")
+	if err != nil {
+		t.Fatal("failed to parse sort")
+	}
+
+	listIssues, err := stores.Issues.List(ctx, opt, proj.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list issues")
+	}
+
+	assert.Equal(popular.Id, listIssues[0].Id)
+	assert.Equal(quiet.Id, listIssues[1].Id)
+
+	newComment, err := stores.Comments.Create(ctx, &api.NewComment{Content: "ship it"}, popular.Id, proj.Id, testCustomerId, testAuthor)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	assert.Empty(newComment.Reactions)
+
+	for _, userId := range []string{"user-a", "user-b"} {
+		_, err = stores.Comments.AddReaction(ctx, "+1", newComment.Id, popular.Id, proj.Id, testCustomerId, userId)
+		if err != nil {
+			t.Fatal("failed to add reaction")
+		}
+	}
+
+	_, err = stores.Comments.AddReaction(ctx, "rocket", newComment.Id, popular.Id, proj.Id, testCustomerId, "user-a")
+	if err != nil {
+		t.Fatal("failed to add reaction")
+	}
+
+	reacted, err := stores.Comments.RemoveReaction(ctx, "rocket", newComment.Id, popular.Id, proj.Id, testCustomerId, "user-a")
+	if err != nil {
+		t.Fatal("failed to remove reaction")
+	}
+
+	assert.Equal([]api.Reaction{{Reaction: "+1", Count: 2}}, reacted.Reactions)
+
+	_, err = stores.Comments.AddReaction(ctx, "shrug", newComment.Id, popular.Id, proj.Id, testCustomerId, "user-a")
+	assert.IsType(&store.CommentValidationError{}, err)
+}
@@ -0,0 +1,156 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/keegancsmith/sqlf"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// Watchers provides a store for the users subscribed to issues.
+type Watchers interface {
+	Watch(ctx context.Context, issueId, projectId, customerId, userId string) error
+	Unwatch(ctx context.Context, issueId, projectId, customerId, userId string) error
+	List(ctx context.Context, issueId, projectId, customerId string) ([]api.Watcher, error)
+	UserIDs(ctx context.Context, issueId, customerId string) ([]string, error)
+}
+
+// WatchersPG provides a watchers store for postgresql.
+type WatchersPG struct {
+	dbconn *sql.DB
+	cfg    *conf.Config
+}
+
+// NewWatchers new watchers store.
+func NewWatchers(dbconn *sql.DB, cfg *conf.Config) Watchers {
+	return &WatchersPG{dbconn: dbconn, cfg: cfg}
+}
+
+// Watch subscribe a user to an issue, watching again has no effect.
+func (ws *WatchersPG) Watch(ctx context.Context, issueId, projectId, customerId, userId string) error {
+	err := db.WithTransaction(ctx, ws.dbconn, func(tx db.Transaction) error {
+		if err := issueExists(ctx, tx, issueId, projectId, customerId); err != nil {
+			return err
+		}
+
+		return addWatcher(ctx, tx, issueId, customerId, userId, api.WatcherReasonManual)
+	})
+	if err != nil {
+		if _, ok := err.(*IssueNotFoundError); ok {
+			return err
+		}
+		return errors.Wrapf(err, "failed to watch issue by id: %s customerId: %s userId: %s", issueId, customerId, userId)
+	}
+
+	return nil
+}
+
+// Unwatch unsubscribe a user from an issue, this has no effect if the user isn't watching.
+func (ws *WatchersPG) Unwatch(ctx context.Context, issueId, projectId, customerId, userId string) error {
+	if err := issueExists(ctx, ws.dbconn, issueId, projectId, customerId); err != nil {
+		return err
+	}
+
+	_, err := ws.dbconn.ExecContext(ctx, "DELETE FROM issue_watchers WHERE customer_id=$1 AND issue_id=$2 AND user_id=$3", customerId, issueId, userId)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unwatch issue by id: %s customerId: %s userId: %s", issueId, customerId, userId)
+	}
+
+	return nil
+}
+
+// List list the watchers of an issue, oldest first.
+func (ws *WatchersPG) List(ctx context.Context, issueId, projectId, customerId string) ([]api.Watcher, error) {
+	if err := issueExists(ctx, ws.dbconn, issueId, projectId, customerId); err != nil {
+		return nil, err
+	}
+
+	rows, err := ws.dbconn.QueryContext(ctx, "SELECT user_id, reason, created_at FROM issue_watchers WHERE customer_id=$1 AND issue_id=$2 ORDER BY created_at ASC, user_id ASC", customerId, issueId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list watchers of issue by id: %s customerId: %s", issueId, customerId)
+	}
+
+	watchers := []api.Watcher{}
+	defer rows.Close()
+	for rows.Next() {
+		watcher := api.Watcher{}
+		if err := rows.Scan(&watcher.UserId, &watcher.Reason, &watcher.CreatedAt); err != nil {
+			return nil, err
+		}
+
+		watchers = append(watchers, watcher)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return watchers, nil
+}
+
+// UserIDs the ids of the users watching an issue, this is used to resolve the recipients of
+// notifications so it is a single lookup on the primary key without checking the issue.
+func (ws *WatchersPG) UserIDs(ctx context.Context, issueId, customerId string) ([]string, error) {
+	rows, err := ws.dbconn.QueryContext(ctx, "SELECT user_id FROM issue_watchers WHERE customer_id=$1 AND issue_id=$2", customerId, issueId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list watchers of issue by id: %s customerId: %s", issueId, customerId)
+	}
+
+	userIds := []string{}
+	defer rows.Close()
+	for rows.Next() {
+		var userId string
+		if err := rows.Scan(&userId); err != nil {
+			return nil, err
+		}
+
+		userIds = append(userIds, userId)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return userIds, nil
+}
+
+// ListWatched list the issues a user is watching across all projects, most recently watched first.
+func (is *IssuesPG) ListWatched(ctx context.Context, opt *LimitOffset, customerId, userId string) ([]api.Issue, error) {
+	issues, err := is.getBySQL(ctx, `WHERE customer_id=$1 AND id IN (SELECT w.issue_id FROM issue_watchers w WHERE w.customer_id=$1 AND w.user_id=$2)
+		ORDER BY (SELECT w.created_at FROM issue_watchers w WHERE w.customer_id=issues.customer_id AND w.issue_id=issues.id AND w.user_id=$2) DESC, id ASC `+opt.SQL().Query(sqlf.PostgresBindVar),
+		customerId, userId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list watched issues by customerId: %s userId: %s", customerId, userId)
+	}
+
+	if err := loadChildCounts(ctx, is.dbconn, issues, customerId); err != nil {
+		return nil, errors.Wrapf(err, "failed to count children of watched issues by customerId: %s userId: %s", customerId, userId)
+	}
+
+	if err := loadSLAs(ctx, is.dbconn, issues, customerId); err != nil {
+		return nil, errors.Wrapf(err, "failed to load sla of watched issues by customerId: %s userId: %s", customerId, userId)
+	}
+
+	return issues, nil
+}
+
+// addWatcher subscribes a user to an issue in the transaction which involved them, the reason
+// they were first subscribed is kept.
+func addWatcher(ctx context.Context, tx db.Transaction, issueId, customerId, userId string, reason api.WatcherReason) error {
+	_, err := tx.ExecContext(ctx, "INSERT INTO issue_watchers(customer_id, issue_id, user_id, reason) VALUES($1, $2, $3, $4) ON CONFLICT DO NOTHING",
+		customerId, issueId, userId, reason)
+	return err
+}
+
+// validateAssignee checks the assignee is a user identifier.
+func validateAssignee(assigneeId string) error {
+	if _, err := uuid.FromString(assigneeId); err != nil {
+		return &IssueValidationError{fmt.Sprintf("assignee %s is not a valid user identifier", assigneeId)}
+	}
+
+	return nil
+}
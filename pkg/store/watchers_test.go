@@ -0,0 +1,134 @@
+package store_test
+
+import (
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/conf"
+	"github.com/wolfeidau/exitus/pkg/db"
+	"github.com/wolfeidau/exitus/pkg/store"
+)
+
+func TestWatchers_Subscriptions(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	assignee := "b1e0c3a4-7d8f-4c61-9a2e-0f5d6c7b8a90"
+
+	newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "watched", Labels: []string{}, AssigneeId: &assignee}, testProjectId, testCustomerId, testReporter)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	assert.Equal(testProjectId, newIssue.ProjectId)
+	assert.Equal(assignee, *newIssue.AssigneeId)
+
+	// watchers are unique to this issue so the watched list isn't affected by other tests
+	watcher := "watcher-" + newIssue.Id
+	commenter := "5c2f7e1a-3b4d-4e6f-8a9b-1c2d3e4f5a6b"
+
+	_, err = stores.Comments.Create(ctx, &api.NewComment{Content: "me too"}, newIssue.Id, testProjectId, testCustomerId, commenter)
+	if err != nil {
+		t.Fatal("failed to create comment")
+	}
+
+	// watching again keeps the original reason
+	for _, userId := range []string{watcher, watcher, commenter} {
+		err = stores.Watchers.Watch(ctx, newIssue.Id, testProjectId, testCustomerId, userId)
+		if err != nil {
+			t.Fatal("failed to watch issue")
+		}
+	}
+
+	watchers, err := stores.Watchers.List(ctx, newIssue.Id, testProjectId, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to list watchers")
+	}
+
+	reasons := map[string]api.WatcherReason{}
+	for _, w := range watchers {
+		reasons[w.UserId] = w.Reason
+	}
+
+	assert.Equal(map[string]api.WatcherReason{
+		testReporter: api.WatcherReasonReporter,
+		assignee:     api.WatcherReasonAssignee,
+		commenter:    api.WatcherReasonCommenter,
+		watcher:      api.WatcherReasonManual,
+	}, reasons)
+
+	err = stores.Watchers.Unwatch(ctx, newIssue.Id, testProjectId, testCustomerId, commenter)
+	if err != nil {
+		t.Fatal("failed to unwatch issue")
+	}
+
+	userIds, err := stores.Watchers.UserIDs(ctx, newIssue.Id, testCustomerId)
+	if err != nil {
+		t.Fatal("failed to resolve watchers")
+	}
+
+	assert.ElementsMatch([]string{testReporter, assignee, watcher}, userIds)
+
+	watched, err := stores.Issues.ListWatched(ctx, &store.LimitOffset{Limit: 10}, testCustomerId, watcher)
+	if err != nil {
+		t.Fatal("failed to list watched issues")
+	}
+
+	assert.Len(watched, 1)
+	assert.Equal(newIssue.Id, watched[0].Id)
+
+	_, err = stores.Issues.Create(ctx, &api.NewIssue{Subject: "invalid", Labels: []string{}, AssigneeId: strPtr("nobody")}, testProjectId, testCustomerId, testReporter)
+	assert.IsType(&store.IssueValidationError{}, err)
+
+	err = stores.Watchers.Watch(ctx, testIssueId, testProjectId, testCustomerId, watcher)
+	assert.IsType(&store.IssueNotFoundError{}, err)
+}
+
+func TestWatchers_ListWatchedAutoWatch(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	assert := require.New(t)
+	ctx := db.TestContext(t)
+
+	cfg, err := conf.NewDefaultConfig()
+	if err != nil {
+		t.Fatal("failed to load config")
+	}
+
+	stores, err := store.New(db.Global, cfg)
+	if err != nil {
+		t.Fatal("failed to create stores")
+	}
+
+	// the user is unique to this test so the watched list isn't affected by other tests
+	user := uuid.NewV4().String()
+
+	newIssue, err := stores.Issues.Create(ctx, &api.NewIssue{Subject: "auto watched", Labels: []string{}}, testProjectId, testCustomerId, user)
+	if err != nil {
+		t.Fatal("failed to create issue")
+	}
+
+	watched, err := stores.Issues.ListWatched(ctx, &store.LimitOffset{Limit: 10}, testCustomerId, user)
+	if err != nil {
+		t.Fatal("failed to list watched issues")
+	}
+
+	assert.Len(watched, 1)
+	assert.Equal(newIssue.Id, watched[0].Id)
+}
@@ -0,0 +1,85 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/wolfeidau/exitus/pkg/api"
+	"github.com/wolfeidau/exitus/pkg/db"
+)
+
+// The states an issue moves through, issues start in created.
+const (
+	StateCreated    = "created"
+	StateOpen       = "open"
+	StateInProgress = "in_progress"
+	StateResolved   = "resolved"
+	StateClosed     = "closed"
+)
+
+// issueTransitions the states an issue can move to from each state.
+var issueTransitions = map[string][]string{
+	StateCreated:    {StateOpen, StateInProgress, StateResolved, StateClosed},
+	StateOpen:       {StateInProgress, StateResolved, StateClosed},
+	StateInProgress: {StateOpen, StateResolved, StateClosed},
+	StateResolved:   {StateOpen, StateClosed},
+	StateClosed:     {StateOpen},
+}
+
+// InvalidTransitionError occurs when an issue can't move to the requested state.
+type InvalidTransitionError struct {
+	From string
+	To   string
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return fmt.Sprintf("invalid transition: issue can't move from %s to %s", e.From, e.To)
+}
+
+// transitionIssue moves an issue to a new state, this does nothing if the issue is already
+// in that state.
+func transitionIssue(ctx context.Context, tx db.Transaction, state, id, customerId string) error {
+	if _, ok := issueTransitions[state]; !ok {
+		return &IssueValidationError{fmt.Sprintf("unknown state %s", state)}
+	}
+
+	var current string
+
+	err := tx.QueryRowContext(ctx, "SELECT state FROM issues WHERE id=$1 AND customer_id=$2 FOR UPDATE", id, customerId).Scan(&current)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &IssueNotFoundError{fmt.Sprintf("id %s", id)}
+		}
+		return err
+	}
+
+	if current == state {
+		return nil
+	}
+
+	if !containsString(issueTransitions[current], state) {
+		return &InvalidTransitionError{From: current, To: state}
+	}
+
+	now := time.Now()
+
+	_, err = tx.ExecContext(ctx, "UPDATE issues SET state=$1, updated_at=$2 WHERE id=$3 AND customer_id=$4", state, now, id, customerId)
+	if err != nil {
+		return err
+	}
+
+	// the history of states is what the flow reports are computed from
+	_, err = tx.ExecContext(ctx, "INSERT INTO issue_state_changes(customer_id, issue_id, from_state, to_state, created_at) VALUES($1, $2, $3, $4, $5)", customerId, id, current, state, now)
+	if err != nil {
+		return err
+	}
+
+	// resolving stops the sla clocks and reopening restarts the resolution clock
+	if _, err := syncIssueSLA(ctx, tx, id, customerId, now); err != nil {
+		return err
+	}
+
+	return notifyWatchers(ctx, tx, api.NotificationTypeStateChanged, id, nil, customerId, "", map[string]string{"from": current, "to": state})
+}